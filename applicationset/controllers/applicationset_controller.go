@@ -18,9 +18,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
-	"strings"
 	"sync"
 	"time"
 
@@ -44,11 +45,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/progressivesync"
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/status"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -64,10 +67,7 @@ import (
 )
 
 const (
-	// Rather than importing the whole argocd-notifications controller, just copying the const here
-	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/subscriptions.go#L12
-	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
-	NotifiedAnnotationKey             = "notified.notifications.argoproj.io"
+	NotifiedAnnotationKey             = utils.NotifiedAnnotationKey
 	ReconcileRequeueOnValidationError = time.Minute * 3
 	ReverseDeletionOrder              = "Reverse"
 	AllAtOnceDeletionOrder            = "AllAtOnce"
@@ -76,17 +76,6 @@ const (
 	specChangedMsg                    = "Application has pending changes (spec differs), setting status to Waiting"
 )
 
-var defaultPreservedFinalizers = []string{
-	argov1alpha1.PreDeleteFinalizerName,
-	argov1alpha1.PostDeleteFinalizerName,
-}
-
-var defaultPreservedAnnotations = []string{
-	NotifiedAnnotationKey,
-	argov1alpha1.AnnotationKeyRefresh,
-	argov1alpha1.AnnotationKeyHydrate,
-}
-
 // ApplicationSetReconciler reconciles a ApplicationSet object
 type ApplicationSetReconciler struct {
 	client.Client
@@ -109,12 +98,14 @@ type ApplicationSetReconciler struct {
 	ClusterInformer              *settings.ClusterInformer
 	ConcurrentApplicationUpdates int
 	ProgressiveSyncManager       *progressivesync.Manager
+	Repos                        services.Repos
 }
 
 var _ progressivesync.Dependencies = (*ApplicationSetReconciler)(nil)
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=generatortemplates,verbs=get;list;watch
 
 func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	startReconcile := time.Now()
@@ -195,8 +186,29 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// Log a warning if there are unrecognized generators
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
+
+	// The GeneratorTemplates are imported in a copy of the ApplicationSet, which is only used to generate the
+	// Applications, so that the imported generators and template are never persisted in the ApplicationSet.
+	importedAppSet, err := utils.ImportGeneratorTemplates(ctx, r.Client, &applicationSetInfo)
+	if err != nil {
+		logCtx.Errorf("unable to import generator templates: %v", err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			[]argov1alpha1.ApplicationSetCondition{
+				{
+					Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+					Message: err.Error(),
+					Reason:  argov1alpha1.ApplicationSetReasonImportError,
+					Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+				},
+			}, parametersGenerated,
+		)
+		// In order for the controller SDK to respect RequeueAfter, the error must be nil
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
-	generatedApplications, applicationSetReason, err := template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+	generatedApplications, applicationSetReason, err := template.GenerateApplications(logCtx, *importedAppSet, r.Generators, r.Renderer, r.Client)
 	if err != nil {
 		logCtx.Errorf("unable to generate applications: %v", err)
 		_ = r.setApplicationSetStatusCondition(ctx,
@@ -246,8 +258,37 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("failed to get current applications for application set: %w", err)
 	}
 
+	// expiredApps are the generated Applications deleted by the expiration policy, they are neither created nor updated
+	var expiredApps []argov1alpha1.ApplicationSetExpiredApplication
+	var expirationRequeueAfter time.Duration
+	if applicationSetInfo.Spec.SyncPolicy != nil && applicationSetInfo.Spec.SyncPolicy.Expiration != nil &&
+		utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
+		expiredApps, expirationRequeueAfter, err = r.getExpiredApplications(ctx, logCtx, &applicationSetInfo, generatedApplications, currentApplications, time.Now())
+		if err != nil {
+			logCtx.Errorf("error occurred during application expiration: %s", err.Error())
+			_ = r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				[]argov1alpha1.ApplicationSetCondition{
+					{
+						Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+						Message: err.Error(),
+						Reason:  argov1alpha1.ApplicationSetReasonExpirationError,
+						Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+					},
+				}, parametersGenerated,
+			)
+			return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+		}
+		generatedApplications, _ = splitExpiredApplications(generatedApplications, expiredApps)
+	}
+	// The expired Applications are recorded before they are deleted, so that they are not created again
+	if err := r.setExpiredApplicationsStatus(ctx, logCtx, &applicationSetInfo, expiredApps); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update expired applications status for application set: %w", err)
+	}
+
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
+	var analysisRequeueAfter time.Duration
 
 	if r.EnableProgressiveSyncs {
 		if !progressivesync.IsRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
@@ -274,7 +315,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				)
 				return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
 			}
-			appSyncMap, err = r.ProgressiveSyncManager.PerformProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
+			appSyncMap, analysisRequeueAfter, err = r.ProgressiveSyncManager.PerformProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
 			}
@@ -375,7 +416,33 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
 		// Delete the generatedApplications instead of the validApps because we want to be able to delete applications in error/invalid state
-		err = r.deleteInCluster(ctx, logCtx, applicationSetInfo, generatedApplications)
+		desiredApplications := generatedApplications
+		if len(expiredApps) > 0 && r.EnableProgressiveSyncs && progressivesync.IsDeletionOrderReversed(&applicationSetInfo) {
+			_, expiredCurrentApps := splitExpiredApplications(currentApplications, expiredApps)
+			requeueTime, err := r.ProgressiveSyncManager.PerformReverseDeletion(ctx, logCtx, applicationSetInfo, expiredCurrentApps)
+			if err != nil {
+				_ = r.setApplicationSetStatusCondition(ctx,
+					&applicationSetInfo,
+					[]argov1alpha1.ApplicationSetCondition{
+						{
+							Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+							Message: err.Error(),
+							Reason:  argov1alpha1.ApplicationSetReasonDeleteApplicationError,
+							Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+						},
+					}, parametersGenerated,
+				)
+				return ctrl.Result{}, err
+			}
+			if requeueTime > 0 {
+				// the expired Applications which are not deleted yet are deleted in order by the next reconciliations
+				desiredApplications = append(slices.Clone(generatedApplications), expiredCurrentApps...)
+				if expirationRequeueAfter == 0 || requeueTime < expirationRequeueAfter {
+					expirationRequeueAfter = requeueTime
+				}
+			}
+		}
+		err = r.deleteInCluster(ctx, logCtx, applicationSetInfo, desiredApplications)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
 				&applicationSetInfo,
@@ -422,7 +489,15 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	requeueAfter := r.getMinRequeueAfter(importedAppSet)
+	if analysisRequeueAfter > 0 && (requeueAfter == 0 || analysisRequeueAfter < requeueAfter) {
+		// reconcile again once a running rollout analysis gate may have completed
+		requeueAfter = analysisRequeueAfter
+	}
+	if expirationRequeueAfter > 0 && (requeueAfter == 0 || expirationRequeueAfter < requeueAfter) {
+		// reconcile again once the next Application may have expired
+		requeueAfter = expirationRequeueAfter
+	}
 
 	if len(validateErrors) == 0 {
 		if err := r.setApplicationSetStatusCondition(ctx,
@@ -632,6 +707,10 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 func (r *ApplicationSetReconciler) getMinRequeueAfter(applicationSetInfo *argov1alpha1.ApplicationSet) time.Duration {
 	var res time.Duration
 	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
+		// the requeue duration of a referenced generator definition is the one of the generators it defines
+		if resolved, err := generators.ResolveGeneratorRef(requestedGenerator, applicationSetInfo); err == nil {
+			requestedGenerator = resolved
+		}
 		relevantGenerators := generators.GetRelevantGenerators(&requestedGenerator, r.Generators)
 
 		for _, g := range relevantGenerators {
@@ -683,6 +762,9 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 	}).For(&argov1alpha1.ApplicationSet{}, builder.WithPredicates(appSetOwnsHandler)).
 		Owns(&argov1alpha1.Application{}, builder.WithPredicates(appOwnsHandler)).
 		WithEventFilter(ignoreNotAllowedNamespaces(r.ApplicationSetNamespaces)).
+		Watches(
+			&argov1alpha1.GeneratorTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.getImportingApplicationSets)).
 		Watches(
 			&corev1.Secret{},
 			&clusterSecretEventHandler{
@@ -693,6 +775,24 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 		Complete(r)
 }
 
+// getImportingApplicationSets returns the requests to reconcile the ApplicationSets which import a GeneratorTemplate
+func (r *ApplicationSetReconciler) getImportingApplicationSets(ctx context.Context, object client.Object) []reconcile.Request {
+	appSetList := &argov1alpha1.ApplicationSetList{}
+	if err := r.List(ctx, appSetList, client.InNamespace(object.GetNamespace())); err != nil {
+		log.WithError(err).Error("unable to list ApplicationSets")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range appSetList.Items {
+		appSet := &appSetList.Items[i]
+		if utils.IsGeneratorTemplateImported(appSet, object.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: appSet.Namespace, Name: appSet.Name}})
+		}
+	}
+	return requests
+}
+
 // createOrUpdateInCluster will create / update application resources in the cluster.
 // - For new applications, it will call create
 // - For existing application, it will call update
@@ -731,65 +831,7 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 
 			action, err := utils.CreateOrUpdate(ctx, appLog, r.Client, diffConfig, found, func() error {
 				// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
-				found.Spec = generatedApp.Spec
-
-				// allow setting the Operation field to trigger a sync operation on an Application
-				if generatedApp.Operation != nil {
-					found.Operation = generatedApp.Operation
-				}
-
-				preservedAnnotations := make([]string, 0)
-				preservedLabels := make([]string, 0)
-
-				if applicationSet.Spec.PreservedFields != nil {
-					preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
-					preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
-				}
-
-				if len(r.GlobalPreservedAnnotations) > 0 {
-					preservedAnnotations = append(preservedAnnotations, r.GlobalPreservedAnnotations...)
-				}
-
-				if len(r.GlobalPreservedLabels) > 0 {
-					preservedLabels = append(preservedLabels, r.GlobalPreservedLabels...)
-				}
-
-				// Preserve specially treated argo cd annotations:
-				// * https://github.com/argoproj/applicationset/issues/180
-				// * https://github.com/argoproj/argo-cd/issues/10500
-				preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
-
-				for _, key := range preservedAnnotations {
-					if state, exists := found.Annotations[key]; exists {
-						if generatedApp.Annotations == nil {
-							generatedApp.Annotations = map[string]string{}
-						}
-						generatedApp.Annotations[key] = state
-					}
-				}
-
-				for _, key := range preservedLabels {
-					if state, exists := found.Labels[key]; exists {
-						if generatedApp.Labels == nil {
-							generatedApp.Labels = map[string]string{}
-						}
-						generatedApp.Labels[key] = state
-					}
-				}
-
-				// Preserve deleting finalizers and avoid diff conflicts
-				for _, finalizer := range defaultPreservedFinalizers {
-					for _, f := range found.Finalizers {
-						// For finalizers, use prefix matching in case it contains "/" stages
-						if strings.HasPrefix(f, finalizer) {
-							generatedApp.Finalizers = append(generatedApp.Finalizers, f)
-						}
-					}
-				}
-
-				found.Annotations = generatedApp.Annotations
-				found.Labels = generatedApp.Labels
-				found.Finalizers = generatedApp.Finalizers
+				utils.ApplyGeneratedApplication(found, &generatedApp, applicationSet.Spec.PreservedFields, r.GlobalPreservedAnnotations, r.GlobalPreservedLabels)
 
 				return controllerutil.SetControllerReference(&applicationSet, found, r.Scheme)
 			})
@@ -1136,6 +1178,25 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 	return nil
 }
 
+// preserveRolloutApprovals copies rollout step approvals which were recorded by the API server while the
+// ApplicationSet was being reconciled into the desired application statuses, unless the status of the Application
+// was reset after the approval was taken.
+func preserveRolloutApprovals(current, desired []argov1alpha1.ApplicationSetApplicationStatus) {
+	for i := range desired {
+		if desired[i].Approval != nil {
+			continue
+		}
+		idx := utils.FindApplicationStatusIndex(current, desired[i].Application)
+		if idx == -1 || current[idx].Approval == nil {
+			continue
+		}
+		if desired[i].LastTransitionTime != nil && desired[i].LastTransitionTime.After(current[idx].Approval.Time.Time) {
+			continue
+		}
+		desired[i].Approval = current[idx].Approval.DeepCopy()
+	}
+}
+
 // setAppSetApplicationStatus updates the ApplicationSet's status field
 // with any new/changed Application statuses.
 func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
@@ -1160,8 +1221,10 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 			statusChanged := currentStatus.Status != appStatus.Status
 			stepChanged := currentStatus.Step != appStatus.Step
 			messageChanged := currentStatus.Message != appStatus.Message
+			analysisChanged := !reflect.DeepEqual(currentStatus.Analysis, appStatus.Analysis)
+			approvalChanged := !reflect.DeepEqual(currentStatus.Approval, appStatus.Approval)
 
-			if statusChanged || stepChanged || messageChanged {
+			if statusChanged || stepChanged || messageChanged || analysisChanged || approvalChanged {
 				if statusChanged {
 					logCtx.WithFields(log.Fields{"application": appStatus.Application, "previous_status": currentStatus.Status, "new_status": appStatus.Status}).
 						Debug("application status changed")
@@ -1173,6 +1236,12 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 				if messageChanged {
 					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application message changed")
 				}
+				if analysisChanged {
+					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application analysis changed")
+				}
+				if approvalChanged {
+					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application approval changed")
+				}
 				needToUpdateStatus = true
 				break
 			}
@@ -1197,6 +1266,7 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 				return fmt.Errorf("error fetching updated application set: %w", err)
 			}
 
+			preserveRolloutApprovals(updatedAppset.Status.ApplicationStatus, applicationSet.Status.ApplicationStatus)
 			updatedAppset.Status.ApplicationStatus = applicationSet.Status.ApplicationStatus
 
 			// Update the newly fetched object with new set of ApplicationStatus
@@ -4070,6 +4070,33 @@ func TestSetApplicationSetApplicationStatus(t *testing.T) {
 	}
 }
 
+func TestPreserveRolloutApprovals(t *testing.T) {
+	approvedAt := metav1.NewTime(time.Now())
+	approval := &v1alpha1.ApplicationSetRolloutApprovalStatus{Phase: v1alpha1.ApplicationSetRolloutApproved, User: "admin", Time: approvedAt}
+	before := metav1.NewTime(approvedAt.Add(-time.Minute))
+	after := metav1.NewTime(approvedAt.Add(time.Minute))
+
+	current := []v1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app1", Step: "2", Approval: approval},
+		{Application: "app2", Step: "2", Approval: approval},
+		{Application: "app3", Step: "2", Approval: approval},
+	}
+	desired := []v1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app1", Step: "2", LastTransitionTime: &before},
+		// the status of app2 was reset for a new rollout after the approval was taken
+		{Application: "app2", Step: "2", LastTransitionTime: &after},
+		{Application: "app3", Step: "2"},
+		{Application: "app4", Step: "2"},
+	}
+
+	preserveRolloutApprovals(current, desired)
+
+	assert.Equal(t, approval, desired[0].Approval)
+	assert.Nil(t, desired[1].Approval)
+	assert.Equal(t, approval, desired[2].Approval)
+	assert.Nil(t, desired[3].Approval)
+}
+
 func TestUpdateResourceStatus(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -5473,3 +5500,120 @@ func startAndSyncInformer(t *testing.T, informer cache.SharedIndexInformer) cont
 	}
 	return cancel
 }
+
+func TestReconcileImportsGeneratorTemplates(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	defaultProject := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}, Destinations: []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}}},
+	}
+	generatorTemplate := v1alpha1.GeneratorTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.GeneratorTemplateSpec{
+			GeneratorDefinitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"clusters": {List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "staging"}`)}}}},
+			},
+			Template: &v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "HEAD"},
+					Destination: v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr},
+				},
+			},
+		},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Imports:    []v1alpha1.ApplicationSetImport{{Name: "guestbook"}},
+			Generators: []v1alpha1.ApplicationSetGenerator{{GeneratorRef: &v1alpha1.GeneratorReference{Name: "clusters"}}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{cluster}}-guestbook", Namespace: "argocd"},
+				Spec: v1alpha1.ApplicationSpec{
+					Project:     "default",
+					Destination: v1alpha1.ApplicationDestination{Namespace: "guestbook"},
+				},
+			},
+		},
+	}
+
+	kubeclientset := getDefaultTestClientSet()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &generatorTemplate, &defaultProject).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+	clusterInformer, err := settings.NewClusterInformer(kubeclientset, "argocd")
+	require.NoError(t, err)
+	defer startAndSyncInformer(t, clusterInformer)()
+
+	appSetGenerators := map[string]generators.Generator{
+		"List": generators.NewListGenerator(),
+	}
+	appSetGenerators["GeneratorRef"] = generators.NewGeneratorRefGenerator(appSetGenerators)
+	r := ApplicationSetReconciler{
+		Client:          client,
+		Scheme:          scheme,
+		Renderer:        &utils.Render{},
+		Recorder:        record.NewFakeRecorder(10),
+		Generators:      appSetGenerators,
+		ArgoDB:          argodb,
+		ArgoCDNamespace: "argocd",
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+		ClusterInformer: clusterInformer,
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "guestbook"}}
+
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	var app v1alpha1.Application
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "staging-guestbook"}, &app))
+	assert.Equal(t, "default", app.Spec.Project)
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", app.Spec.GetSource().RepoURL)
+	assert.Equal(t, v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "guestbook"}, app.Spec.Destination)
+
+	// the imported generators and template are not persisted in the ApplicationSet
+	var retrievedAppSet v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedAppSet))
+	assert.Empty(t, retrievedAppSet.Spec.GeneratorDefinitions)
+	assert.Nil(t, retrievedAppSet.Spec.Template.Spec.Source)
+
+	// a missing GeneratorTemplate is reported in the conditions of the ApplicationSet
+	require.NoError(t, r.Delete(t.Context(), &generatorTemplate))
+	res, err := r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedAppSet))
+	require.NotEmpty(t, retrievedAppSet.Status.Conditions)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonImportError, retrievedAppSet.Status.Conditions[0].Reason)
+	assert.Contains(t, retrievedAppSet.Status.Conditions[0].Message, "error getting imported GeneratorTemplate guestbook")
+}
+
+func TestGetImportingApplicationSets(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	importing := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "importing", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSetSpec{Imports: []v1alpha1.ApplicationSetImport{{Name: "guestbook"}}},
+	}
+	notImporting := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-importing", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSetSpec{Imports: []v1alpha1.ApplicationSetImport{{Name: "other"}}},
+	}
+	otherNamespace := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "importing", Namespace: "other"},
+		Spec:       v1alpha1.ApplicationSetSpec{Imports: []v1alpha1.ApplicationSetImport{{Name: "guestbook"}}},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(importing, notImporting, otherNamespace).Build()
+	r := ApplicationSetReconciler{Client: client}
+
+	requests := r.getImportingApplicationSets(t.Context(), &v1alpha1.GeneratorTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+	})
+	assert.Equal(t, []ctrl.Request{{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "importing"}}}, requests)
+}
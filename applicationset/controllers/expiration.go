@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// expirationScheduleRequeueAfter is the interval at which the schedule windows of the expiration policy are evaluated
+const expirationScheduleRequeueAfter = time.Minute
+
+// getExpiredApplications returns the generated Applications which are expired according to the expiration policy of the
+// ApplicationSet, and the duration after which the expiration must be evaluated again.
+//
+// An Application expires when it is idle for longer than the idle TTL, or when it is outside of the schedule windows.
+// The expired Applications recorded in the status of the ApplicationSet remain expired until their generated spec
+// changes, e.g. a new commit is pushed to a pull request, or until a schedule window begins.
+func (r *ApplicationSetReconciler) getExpiredApplications(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, generatedApps []argov1alpha1.Application, currentApps []argov1alpha1.Application, now time.Time) ([]argov1alpha1.ApplicationSetExpiredApplication, time.Duration, error) {
+	expiration := appset.Spec.SyncPolicy.Expiration
+	ttl, err := expiration.GetIdleTTL()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var requeueAfter time.Duration
+	outsideSchedule := false
+	if len(expiration.Schedules) > 0 {
+		outsideSchedule = true
+		for _, schedule := range expiration.Schedules {
+			active, err := schedule.Active(now)
+			if err != nil {
+				return nil, 0, fmt.Errorf("error evaluating expiration schedule %q: %w", schedule.Schedule, err)
+			}
+			if active {
+				outsideSchedule = false
+			}
+		}
+		requeueAfter = expirationScheduleRequeueAfter
+	}
+
+	previous := map[string]argov1alpha1.ApplicationSetExpiredApplication{}
+	for _, expired := range appset.Status.ExpiredApplications {
+		previous[expired.Name] = expired
+	}
+	current := map[string]*argov1alpha1.Application{}
+	for i := range currentApps {
+		current[currentApps[i].Name] = &currentApps[i]
+	}
+
+	expiredApps := []argov1alpha1.ApplicationSetExpiredApplication{}
+	for i := range generatedApps {
+		app := &generatedApps[i]
+		specHash, err := expirationSpecHash(app)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error hashing the spec of application %s: %w", app.Name, err)
+		}
+
+		if expired, ok := previous[app.Name]; ok {
+			// an Application which expired outside of the schedule is generated again once a window begins, and an idle
+			// Application once its generated spec changes
+			if outsideSchedule || (expired.Reason == argov1alpha1.ApplicationSetExpirationReasonIdle && expired.SpecHash == specHash) {
+				expiredApps = append(expiredApps, expired)
+				continue
+			}
+		}
+
+		if outsideSchedule {
+			expiredApps = append(expiredApps, argov1alpha1.ApplicationSetExpiredApplication{
+				Name:      app.Name,
+				Reason:    argov1alpha1.ApplicationSetExpirationReasonSchedule,
+				SpecHash:  specHash,
+				ExpiredAt: metav1.NewTime(now),
+			})
+			continue
+		}
+
+		currentApp, ok := current[app.Name]
+		if ttl == 0 || !ok {
+			continue
+		}
+		lastActivity, err := r.getLastActivity(ctx, expiration.IdleSince, currentApp, now)
+		if err != nil {
+			// an Application is never deleted unless its last activity is known
+			logCtx.WithField("application", app.Name).WithError(err).Warn("unable to get the last activity of the application, skipping its expiration")
+			continue
+		}
+		idle := now.Sub(lastActivity)
+		if idle >= ttl {
+			logCtx.WithField("application", app.Name).Infof("application is idle since %s, expiring it", lastActivity.Format(time.RFC3339))
+			expiredApps = append(expiredApps, argov1alpha1.ApplicationSetExpiredApplication{
+				Name:      app.Name,
+				Reason:    argov1alpha1.ApplicationSetExpirationReasonIdle,
+				SpecHash:  specHash,
+				ExpiredAt: metav1.NewTime(now),
+			})
+			continue
+		}
+		if requeueAfter == 0 || ttl-idle < requeueAfter {
+			requeueAfter = ttl - idle
+		}
+	}
+
+	sort.Slice(expiredApps, func(i, j int) bool {
+		return expiredApps[i].Name < expiredApps[j].Name
+	})
+	return expiredApps, requeueAfter, nil
+}
+
+// getLastActivity returns the time of the last activity of an Application: the date of the last commit of its target
+// revisions, or the time of its last sync. The creation of the Application is its first activity.
+func (r *ApplicationSetReconciler) getLastActivity(ctx context.Context, idleSince string, app *argov1alpha1.Application, now time.Time) (time.Time, error) {
+	lastActivity := app.CreationTimestamp.Time
+
+	if idleSince == argov1alpha1.ApplicationSetIdleSinceSync {
+		if app.Operation != nil || (app.Status.OperationState != nil && app.Status.OperationState.FinishedAt == nil) {
+			// the Application is being synced
+			return now, nil
+		}
+		if app.Status.OperationState != nil && app.Status.OperationState.FinishedAt.After(lastActivity) {
+			lastActivity = app.Status.OperationState.FinishedAt.Time
+		}
+		for _, history := range app.Status.History {
+			if history.DeployedAt.After(lastActivity) {
+				lastActivity = history.DeployedAt.Time
+			}
+		}
+		return lastActivity, nil
+	}
+
+	if r.Repos == nil {
+		return time.Time{}, errors.New("the dates of the commits are not available")
+	}
+	revisions := app.Status.Sync.Revisions
+	if !app.Spec.HasMultipleSources() {
+		revisions = []string{app.Status.Sync.Revision}
+	}
+	for i, source := range app.Spec.GetSources() {
+		// only the revisions of Git repositories are commits
+		if i >= len(revisions) || revisions[i] == "" || source.IsHelm() || source.IsOCI() {
+			continue
+		}
+		metadata, err := r.Repos.GetRevisionMetadata(ctx, source.RepoURL, app.Spec.Project, revisions[i])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error getting the metadata of revision %s of %s: %w", revisions[i], source.RepoURL, err)
+		}
+		if metadata.Date != nil && metadata.Date.After(lastActivity) {
+			lastActivity = metadata.Date.Time
+		}
+	}
+	return lastActivity, nil
+}
+
+// expirationSpecHash returns the hash of the generated spec and metadata of an Application, which identifies the
+// version of the Application which expired
+func expirationSpecHash(app *argov1alpha1.Application) (string, error) {
+	data, err := json.Marshal([]any{app.Labels, app.Annotations, app.Spec})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// splitExpiredApplications splits the Applications into the ones which are not expired and the expired ones
+func splitExpiredApplications(apps []argov1alpha1.Application, expiredApps []argov1alpha1.ApplicationSetExpiredApplication) ([]argov1alpha1.Application, []argov1alpha1.Application) {
+	if len(expiredApps) == 0 {
+		return apps, nil
+	}
+	expired := map[string]bool{}
+	for _, expiredApp := range expiredApps {
+		expired[expiredApp.Name] = true
+	}
+	var kept, removed []argov1alpha1.Application
+	for _, app := range apps {
+		if expired[app.Name] {
+			removed = append(removed, app)
+		} else {
+			kept = append(kept, app)
+		}
+	}
+	return kept, removed
+}
+
+// setExpiredApplicationsStatus updates the expired Applications recorded in the status of the ApplicationSet
+func (r *ApplicationSetReconciler) setExpiredApplicationsStatus(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, expiredApps []argov1alpha1.ApplicationSetExpiredApplication) error {
+	if len(expiredApps) == 0 {
+		expiredApps = nil
+	}
+	if reflect.DeepEqual(appset.Status.ExpiredApplications, expiredApps) {
+		return nil
+	}
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.ExpiredApplications = expiredApps
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set status: %v", err)
+		return fmt.Errorf("unable to set application set status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	servicesmocks "github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func TestGetExpiredApplications(t *testing.T) {
+	t.Parallel()
+	// a Tuesday
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(now.Add(-d))
+	}
+	newApp := func(name, revision string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "default",
+				Source:  &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: revision},
+			},
+		}
+	}
+	generatedApps := []v1alpha1.Application{newApp("pr-1", "sha-1"), newApp("pr-2", "sha-2"), newApp("pr-3", "sha-3")}
+	hash := func(app v1alpha1.Application) string {
+		specHash, err := expirationSpecHash(&app)
+		require.NoError(t, err)
+		return specHash
+	}
+	syncedApp := func(name, revision string, created, deployed time.Duration) v1alpha1.Application {
+		app := newApp(name, revision)
+		app.CreationTimestamp = ago(created)
+		app.Status.Sync.Revision = revision
+		app.Status.History = v1alpha1.RevisionHistories{{Revision: revision, DeployedAt: ago(deployed)}}
+		return app
+	}
+
+	cases := []struct {
+		name                 string
+		expiration           v1alpha1.ApplicationSetExpiration
+		previous             []v1alpha1.ApplicationSetExpiredApplication
+		currentApps          []v1alpha1.Application
+		setupRepos           func(*servicesmocks.Repos)
+		expectedExpiredApps  []v1alpha1.ApplicationSetExpiredApplication
+		expectedRequeueAfter time.Duration
+		expectedErrMsg       string
+	}{
+		{
+			name:       "idle since the last sync",
+			expiration: v1alpha1.ApplicationSetExpiration{IdleTTL: "72h", IdleSince: v1alpha1.ApplicationSetIdleSinceSync},
+			currentApps: []v1alpha1.Application{
+				syncedApp("pr-1", "sha-1", 100*time.Hour, 80*time.Hour),
+				syncedApp("pr-2", "sha-2", 100*time.Hour, 70*time.Hour),
+			},
+			expectedExpiredApps: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonIdle, SpecHash: hash(generatedApps[0]), ExpiredAt: metav1.NewTime(now)},
+			},
+			expectedRequeueAfter: 2 * time.Hour,
+		},
+		{
+			name:       "applications being synced are not idle",
+			expiration: v1alpha1.ApplicationSetExpiration{IdleTTL: "72h", IdleSince: v1alpha1.ApplicationSetIdleSinceSync},
+			currentApps: func() []v1alpha1.Application {
+				app := syncedApp("pr-1", "sha-1", 100*time.Hour, 80*time.Hour)
+				app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+				return []v1alpha1.Application{app}
+			}(),
+			expectedExpiredApps:  []v1alpha1.ApplicationSetExpiredApplication{},
+			expectedRequeueAfter: 72 * time.Hour,
+		},
+		{
+			name:       "idle since the last commit",
+			expiration: v1alpha1.ApplicationSetExpiration{IdleTTL: "72h"},
+			currentApps: []v1alpha1.Application{
+				syncedApp("pr-1", "sha-1", 100*time.Hour, time.Hour),
+				syncedApp("pr-2", "sha-2", 100*time.Hour, time.Hour),
+				syncedApp("pr-3", "sha-3", 100*time.Hour, time.Hour),
+			},
+			setupRepos: func(repos *servicesmocks.Repos) {
+				commitDate := ago(90 * time.Hour)
+				repos.EXPECT().GetRevisionMetadata(mock.Anything, "https://github.com/argoproj/argocd-example-apps", "default", "sha-1").
+					Return(&v1alpha1.RevisionMetadata{Date: &commitDate}, nil)
+				recentCommitDate := ago(time.Hour)
+				repos.EXPECT().GetRevisionMetadata(mock.Anything, "https://github.com/argoproj/argocd-example-apps", "default", "sha-2").
+					Return(&v1alpha1.RevisionMetadata{Date: &recentCommitDate}, nil)
+				repos.EXPECT().GetRevisionMetadata(mock.Anything, "https://github.com/argoproj/argocd-example-apps", "default", "sha-3").
+					Return(nil, errors.New("revision not found"))
+			},
+			expectedExpiredApps: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonIdle, SpecHash: hash(generatedApps[0]), ExpiredAt: metav1.NewTime(now)},
+			},
+			expectedRequeueAfter: 71 * time.Hour,
+		},
+		{
+			name:       "expired applications are kept until their spec changes",
+			expiration: v1alpha1.ApplicationSetExpiration{IdleTTL: "72h", IdleSince: v1alpha1.ApplicationSetIdleSinceSync},
+			previous: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonIdle, SpecHash: hash(generatedApps[0]), ExpiredAt: ago(time.Hour)},
+				{Name: "pr-2", Reason: v1alpha1.ApplicationSetExpirationReasonIdle, SpecHash: hash(newApp("pr-2", "sha-0")), ExpiredAt: ago(time.Hour)},
+				{Name: "pr-9", Reason: v1alpha1.ApplicationSetExpirationReasonIdle, SpecHash: "removed", ExpiredAt: ago(time.Hour)},
+			},
+			expectedExpiredApps: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonIdle, SpecHash: hash(generatedApps[0]), ExpiredAt: ago(time.Hour)},
+			},
+		},
+		{
+			name:       "inside of a schedule window",
+			expiration: v1alpha1.ApplicationSetExpiration{Schedules: []v1alpha1.ApplicationSetExpirationSchedule{{Schedule: "0 8 * * 1-5", Duration: "10h"}}},
+			previous: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonSchedule, SpecHash: hash(generatedApps[0]), ExpiredAt: ago(time.Hour)},
+			},
+			currentApps:          []v1alpha1.Application{syncedApp("pr-2", "sha-2", 100*time.Hour, 80*time.Hour)},
+			expectedExpiredApps:  []v1alpha1.ApplicationSetExpiredApplication{},
+			expectedRequeueAfter: time.Minute,
+		},
+		{
+			name: "outside of the schedule windows",
+			expiration: v1alpha1.ApplicationSetExpiration{Schedules: []v1alpha1.ApplicationSetExpirationSchedule{
+				{Schedule: "0 8 * * 6", Duration: "10h"},
+				{Schedule: "0 12 * * *", Duration: "1h", TimeZone: "America/New_York"},
+			}},
+			previous: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonSchedule, SpecHash: "previous", ExpiredAt: ago(time.Hour)},
+			},
+			expectedExpiredApps: []v1alpha1.ApplicationSetExpiredApplication{
+				{Name: "pr-1", Reason: v1alpha1.ApplicationSetExpirationReasonSchedule, SpecHash: "previous", ExpiredAt: ago(time.Hour)},
+				{Name: "pr-2", Reason: v1alpha1.ApplicationSetExpirationReasonSchedule, SpecHash: hash(generatedApps[1]), ExpiredAt: metav1.NewTime(now)},
+				{Name: "pr-3", Reason: v1alpha1.ApplicationSetExpirationReasonSchedule, SpecHash: hash(generatedApps[2]), ExpiredAt: metav1.NewTime(now)},
+			},
+			expectedRequeueAfter: time.Minute,
+		},
+		{
+			name:           "invalid idle TTL",
+			expiration:     v1alpha1.ApplicationSetExpiration{IdleTTL: "3 days"},
+			expectedErrMsg: `error parsing idle TTL "3 days"`,
+		},
+		{
+			name:           "invalid schedule",
+			expiration:     v1alpha1.ApplicationSetExpiration{Schedules: []v1alpha1.ApplicationSetExpirationSchedule{{Schedule: "every day", Duration: "1h"}}},
+			expectedErrMsg: `error evaluating expiration schedule "every day"`,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			repos := servicesmocks.NewRepos(t)
+			if testCase.setupRepos != nil {
+				testCase.setupRepos(repos)
+			}
+			r := &ApplicationSetReconciler{Repos: repos}
+			appSet := &v1alpha1.ApplicationSet{
+				Spec:   v1alpha1.ApplicationSetSpec{SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{Expiration: &testCase.expiration}},
+				Status: v1alpha1.ApplicationSetStatus{ExpiredApplications: testCase.previous},
+			}
+
+			expiredApps, requeueAfter, err := r.getExpiredApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, generatedApps, testCase.currentApps, now)
+			if testCase.expectedErrMsg != "" {
+				require.ErrorContains(t, err, testCase.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedExpiredApps, expiredApps)
+			assert.Equal(t, testCase.expectedRequeueAfter, requeueAfter)
+		})
+	}
+}
+
+func TestReconcileExpiresIdleApplications(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	defaultProject := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}, Destinations: []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}}},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "previews", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"number": "1", "sha": "sha-1"}`)}}},
+			}},
+			SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{
+				Expiration: &v1alpha1.ApplicationSetExpiration{IdleTTL: "72h", IdleSince: v1alpha1.ApplicationSetIdleSinceSync},
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "pr-{{number}}", Namespace: "argocd"},
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "{{sha}}"},
+					Project:     "default",
+					Destination: v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "pr-{{number}}"},
+				},
+			},
+		},
+	}
+
+	kubeclientset := getDefaultTestClientSet()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &defaultProject).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+	clusterInformer, err := settings.NewClusterInformer(kubeclientset, "argocd")
+	require.NoError(t, err)
+	defer startAndSyncInformer(t, clusterInformer)()
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:          argodb,
+		ArgoCDNamespace: "argocd",
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+		ClusterInformer: clusterInformer,
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "previews"}}
+	getApp := func() (*v1alpha1.Application, error) {
+		var app v1alpha1.Application
+		err := r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "pr-1"}, &app)
+		return &app, err
+	}
+
+	// the Application is created, and is not idle yet
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	app, err := getApp()
+	require.NoError(t, err)
+
+	// the Application was last synced long ago
+	app.CreationTimestamp = metav1.NewTime(time.Now().Add(-100 * time.Hour))
+	app.Status.History = v1alpha1.RevisionHistories{{Revision: "sha-1", DeployedAt: metav1.NewTime(time.Now().Add(-80 * time.Hour))}}
+	require.NoError(t, r.Update(t.Context(), app))
+
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	app, err = getApp()
+	require.NoError(t, err)
+	assert.NotNil(t, app.DeletionTimestamp, "expected the expired application to be deleted")
+	// the resources of the Application are deleted by the Application controller
+	app.Finalizers = nil
+	require.NoError(t, r.Update(t.Context(), app))
+	var retrievedAppSet v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedAppSet))
+	require.Len(t, retrievedAppSet.Status.ExpiredApplications, 1)
+	assert.Equal(t, "pr-1", retrievedAppSet.Status.ExpiredApplications[0].Name)
+	assert.Equal(t, v1alpha1.ApplicationSetExpirationReasonIdle, retrievedAppSet.Status.ExpiredApplications[0].Reason)
+
+	// the expired Application is not created again
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	_, err = getApp()
+	assert.True(t, apierrors.IsNotFound(err), "expected the expired application not to be created again, got %v", err)
+
+	// a new commit generates the Application again
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedAppSet))
+	retrievedAppSet.Spec.Generators[0].List.Elements = []apiextensionsv1.JSON{{Raw: []byte(`{"number": "1", "sha": "sha-2"}`)}}
+	require.NoError(t, r.Update(t.Context(), &retrievedAppSet))
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	app, err = getApp()
+	require.NoError(t, err)
+	assert.Equal(t, "sha-2", app.Spec.Source.TargetRevision)
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedAppSet))
+	assert.Empty(t, retrievedAppSet.Status.ExpiredApplications)
+}
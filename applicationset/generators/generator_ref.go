@@ -0,0 +1,201 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*GeneratorRefGenerator)(nil)
+
+var ErrGeneratorRefWithOtherGenerators = errors.New("a generator referencing a generator definition cannot define another generator")
+
+// GeneratorRefGenerator generates the parameters of the generator definition referenced by a generator. The generator
+// references are usually resolved by Transform, which allows the referenced generators to be interpolated with the
+// parameters of the parent generators.
+type GeneratorRefGenerator struct {
+	// The generators supported by the generator definitions, which include the combination-type generators
+	supportedGenerators map[string]Generator
+}
+
+// NewGeneratorRefGenerator returns a GeneratorRefGenerator which allows the given supportedGenerators as generator
+// definitions.
+func NewGeneratorRefGenerator(supportedGenerators map[string]Generator) Generator {
+	return &GeneratorRefGenerator{
+		supportedGenerators: supportedGenerators,
+	}
+}
+
+func (g *GeneratorRefGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	if appSetGenerator.GeneratorRef == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	resolved, err := ResolveGeneratorRef(*appSetGenerator, appSet)
+	if err != nil {
+		return nil, err
+	}
+	t, err := Transform(resolved, g.supportedGenerators, argoprojiov1alpha1.ApplicationSetTemplate{}, appSet, nil, client)
+	if err != nil {
+		return nil, fmt.Errorf("generator definition %q returned an error on parameter generation: %w", appSetGenerator.GeneratorRef.Name, err)
+	}
+	if len(t) != 1 {
+		return nil, fmt.Errorf("generator definition %q must define exactly one generator", appSetGenerator.GeneratorRef.Name)
+	}
+	return t[0].Params, nil
+}
+
+// GetRequeueAfter returns the default requeue duration, as the referenced generator definition is not known without
+// the ApplicationSet.
+func (g *GeneratorRefGenerator) GetRequeueAfter(_ *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	return getDefaultRequeueAfter()
+}
+
+func (g *GeneratorRefGenerator) GetTemplate(_ *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &argoprojiov1alpha1.ApplicationSetTemplate{}
+}
+
+// ResolveGeneratorRef returns the generator definition referenced by the generator, following the references of the
+// generator definitions, or the generator itself if it does not reference a generator definition. The selectors of
+// the referencing generators are combined with the selector of the generator definition.
+func ResolveGeneratorRef(generator argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
+	if generator.GeneratorRef == nil {
+		return generator, nil
+	}
+	if err := checkGeneratorDefinitionCycles(appSet, []string{generator.GeneratorRef.Name}); err != nil {
+		return argoprojiov1alpha1.ApplicationSetGenerator{}, err
+	}
+
+	selector := generator.Selector
+	for generator.GeneratorRef != nil {
+		if len(GetRelevantGenerators(&generator, nil)) > 1 {
+			return argoprojiov1alpha1.ApplicationSetGenerator{}, ErrGeneratorRefWithOtherGenerators
+		}
+		name := generator.GeneratorRef.Name
+		definition, ok := appSet.Spec.GeneratorDefinitions[name]
+		if !ok {
+			return argoprojiov1alpha1.ApplicationSetGenerator{}, fmt.Errorf("generator definition %q not found", name)
+		}
+		resolved, err := nestedGeneratorToGenerator(definition)
+		if err != nil {
+			return argoprojiov1alpha1.ApplicationSetGenerator{}, fmt.Errorf("error reading generator definition %q: %w", name, err)
+		}
+		selector = combineSelectors(resolved.Selector, selector)
+		generator = resolved
+	}
+	generator.Selector = selector
+	return generator, nil
+}
+
+// checkGeneratorDefinitionCycles returns an error if the last generator definition of the path references, directly
+// or through the generators it combines, a generator definition of the path
+func checkGeneratorDefinitionCycles(appSet *argoprojiov1alpha1.ApplicationSet, path []string) error {
+	name := path[len(path)-1]
+	definition, ok := appSet.Spec.GeneratorDefinitions[name]
+	if !ok {
+		return fmt.Errorf("generator definition %q not found", name)
+	}
+	refs, err := generatorDefinitionRefs(definition)
+	if err != nil {
+		return fmt.Errorf("error reading generator definition %q: %w", name, err)
+	}
+	for _, ref := range refs {
+		if slices.Contains(path, ref) {
+			return fmt.Errorf("generator definitions reference each other in a cycle: %s -> %s", strings.Join(path, " -> "), ref)
+		}
+		if err := checkGeneratorDefinitionCycles(appSet, append(slices.Clone(path), ref)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generatorDefinitionRefs returns the names of the generator definitions referenced by a generator, or by the
+// generators it combines at any level of nesting
+func generatorDefinitionRefs(definition argoprojiov1alpha1.ApplicationSetNestedGenerator) ([]string, error) {
+	generator, err := nestedGeneratorToGenerator(definition)
+	if err != nil {
+		return nil, err
+	}
+	var children []argoprojiov1alpha1.ApplicationSetNestedGenerator
+	if generator.Matrix != nil {
+		children = append(children, generator.Matrix.Generators...)
+	}
+	if generator.Merge != nil {
+		children = append(children, generator.Merge.Generators...)
+	}
+
+	var refs []string
+	if generator.GeneratorRef != nil {
+		refs = append(refs, generator.GeneratorRef.Name)
+	}
+	for _, child := range children {
+		childRefs, err := generatorDefinitionRefs(child)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, childRefs...)
+	}
+	return refs, nil
+}
+
+// nestedGeneratorToGenerator converts a nested generator to a generator, unmarshalling its nested combination-type
+// generator if any
+func nestedGeneratorToGenerator(nested argoprojiov1alpha1.ApplicationSetNestedGenerator) (argoprojiov1alpha1.ApplicationSetGenerator, error) {
+	matrixGen, err := getMatrixGenerator(nested)
+	if err != nil {
+		return argoprojiov1alpha1.ApplicationSetGenerator{}, fmt.Errorf("error retrieving matrix generator: %w", err)
+	}
+	mergeGen, err := getMergeGenerator(nested)
+	if err != nil {
+		return argoprojiov1alpha1.ApplicationSetGenerator{}, fmt.Errorf("error retrieving merge generator: %w", err)
+	}
+	return argoprojiov1alpha1.ApplicationSetGenerator{
+		List:                    nested.List,
+		Clusters:                nested.Clusters,
+		Git:                     nested.Git,
+		SCMProvider:             nested.SCMProvider,
+		ClusterDecisionResource: nested.ClusterDecisionResource,
+		PullRequest:             nested.PullRequest,
+		Plugin:                  nested.Plugin,
+		OCI:                     nested.OCI,
+		HelmChart:               nested.HelmChart,
+		KubernetesResource:      nested.KubernetesResource,
+		GeneratorRef:            nested.GeneratorRef,
+		Matrix:                  matrixGen,
+		Merge:                   mergeGen,
+		Selector:                nested.Selector,
+	}, nil
+}
+
+// combineSelectors returns a selector matching the labels matched by both selectors
+func combineSelectors(a, b *metav1.LabelSelector) *metav1.LabelSelector {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	combined := a.DeepCopy()
+	keys := make([]string, 0, len(b.MatchLabels))
+	for key := range b.MatchLabels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		combined.MatchExpressions = append(combined.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      key,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{b.MatchLabels[key]},
+		})
+	}
+	combined.MatchExpressions = append(combined.MatchExpressions, b.MatchExpressions...)
+	return combined
+}
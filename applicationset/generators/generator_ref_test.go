@@ -0,0 +1,197 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func listGeneratorOf(elements ...string) *v1alpha1.ListGenerator {
+	list := &v1alpha1.ListGenerator{}
+	for _, element := range elements {
+		list.Elements = append(list.Elements, apiextensionsv1.JSON{Raw: []byte(element)})
+	}
+	return list
+}
+
+func getGeneratorRefTestGenerators() map[string]Generator {
+	generators := map[string]Generator{
+		"List": NewListGenerator(),
+	}
+	generators["Matrix"] = NewMatrixGenerator(generators)
+	generators["Merge"] = NewMergeGenerator(generators)
+	generators["GeneratorRef"] = NewGeneratorRefGenerator(generators)
+	return generators
+}
+
+func TestResolveGeneratorRef(t *testing.T) {
+	t.Parallel()
+
+	envs := listGeneratorOf(`{"env": "dev"}`, `{"env": "prod"}`)
+
+	testCases := []struct {
+		name        string
+		definitions map[string]v1alpha1.ApplicationSetNestedGenerator
+		generator   v1alpha1.ApplicationSetGenerator
+		expected    v1alpha1.ApplicationSetGenerator
+		expectedErr string
+	}{
+		{
+			name:      "generator without reference",
+			generator: v1alpha1.ApplicationSetGenerator{List: envs},
+			expected:  v1alpha1.ApplicationSetGenerator{List: envs},
+		},
+		{
+			name: "generator referencing a definition",
+			definitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"envs": {List: envs},
+			},
+			generator: v1alpha1.ApplicationSetGenerator{GeneratorRef: &v1alpha1.GeneratorReference{Name: "envs"}},
+			expected:  v1alpha1.ApplicationSetGenerator{List: envs},
+		},
+		{
+			name: "chained references combine the selectors",
+			definitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"envs": {
+					List: envs,
+					Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "env", Operator: metav1.LabelSelectorOpExists},
+					}},
+				},
+				"prod-envs": {
+					GeneratorRef: &v1alpha1.GeneratorReference{Name: "envs"},
+					Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				},
+			},
+			generator: v1alpha1.ApplicationSetGenerator{GeneratorRef: &v1alpha1.GeneratorReference{Name: "prod-envs"}},
+			expected: v1alpha1.ApplicationSetGenerator{
+				List: envs,
+				Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: metav1.LabelSelectorOpExists},
+					{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"prod"}},
+				}},
+			},
+		},
+		{
+			name:        "definition not found",
+			generator:   v1alpha1.ApplicationSetGenerator{GeneratorRef: &v1alpha1.GeneratorReference{Name: "envs"}},
+			expectedErr: `generator definition "envs" not found`,
+		},
+		{
+			name: "definitions referencing each other",
+			definitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"a": {GeneratorRef: &v1alpha1.GeneratorReference{Name: "b"}},
+				"b": {GeneratorRef: &v1alpha1.GeneratorReference{Name: "a"}},
+			},
+			generator:   v1alpha1.ApplicationSetGenerator{GeneratorRef: &v1alpha1.GeneratorReference{Name: "a"}},
+			expectedErr: "generator definitions reference each other in a cycle: a -> b -> a",
+		},
+		{
+			name: "definition referencing itself through a matrix generator",
+			definitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"a": {Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [{"list": {"elements": []}}, {"generatorRef": {"name": "a"}}]}`)}},
+			},
+			generator:   v1alpha1.ApplicationSetGenerator{GeneratorRef: &v1alpha1.GeneratorReference{Name: "a"}},
+			expectedErr: "generator definitions reference each other in a cycle: a -> a",
+		},
+		{
+			name: "reference with another generator",
+			definitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"envs": {List: envs},
+			},
+			generator: v1alpha1.ApplicationSetGenerator{
+				List:         envs,
+				GeneratorRef: &v1alpha1.GeneratorReference{Name: "envs"},
+			},
+			expectedErr: ErrGeneratorRefWithOtherGenerators.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			appSet := &v1alpha1.ApplicationSet{
+				Spec: v1alpha1.ApplicationSetSpec{GeneratorDefinitions: testCase.definitions},
+			}
+			resolved, err := ResolveGeneratorRef(testCase.generator, appSet)
+			if testCase.expectedErr != "" {
+				require.EqualError(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, resolved)
+		})
+	}
+}
+
+func TestTransformNestsMatrixGeneratorsThroughReferences(t *testing.T) {
+	t.Parallel()
+
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			GeneratorDefinitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"envs":    {List: listGeneratorOf(`{"env": "dev"}`, `{"env": "prod"}`)},
+				"regions": {List: listGeneratorOf(`{"region": "eu"}`, `{"region": "us"}`)},
+				"envs-x-regions": {
+					Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [{"generatorRef": {"name": "envs"}}, {"generatorRef": {"name": "regions"}}]}`)},
+				},
+			},
+		},
+	}
+
+	// matrix(list, matrix(matrix(envs, regions), list)): three levels of matrix generators
+	generator := v1alpha1.ApplicationSetGenerator{
+		Matrix: &v1alpha1.MatrixGenerator{
+			Generators: []v1alpha1.ApplicationSetNestedGenerator{
+				{List: listGeneratorOf(`{"app": "guestbook"}`)},
+				{Matrix: &apiextensionsv1.JSON{Raw: []byte(`{"generators": [{"generatorRef": {"name": "envs-x-regions"}}, {"list": {"elements": [{"tier": "{{ .app }}-web"}]}}]}`)}},
+			},
+		},
+	}
+
+	results, err := Transform(generator, getGeneratorRefTestGenerators(), v1alpha1.ApplicationSetTemplate{}, appSet, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []map[string]any{
+		{"app": "guestbook", "env": "dev", "region": "eu", "tier": "guestbook-web"},
+		{"app": "guestbook", "env": "dev", "region": "us", "tier": "guestbook-web"},
+		{"app": "guestbook", "env": "prod", "region": "eu", "tier": "guestbook-web"},
+		{"app": "guestbook", "env": "prod", "region": "us", "tier": "guestbook-web"},
+	}, results[0].Params)
+}
+
+func TestGeneratorRefGenerateParams(t *testing.T) {
+	t.Parallel()
+
+	appSet := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			GeneratorDefinitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"envs": {
+					List:     listGeneratorOf(`{"env": "dev"}`, `{"env": "prod"}`),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				},
+			},
+		},
+	}
+
+	generators := getGeneratorRefTestGenerators()
+	params, err := generators["GeneratorRef"].GenerateParams(&v1alpha1.ApplicationSetGenerator{
+		GeneratorRef: &v1alpha1.GeneratorReference{Name: "envs"},
+	}, appSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"env": "prod"}}, params)
+
+	_, err = generators["GeneratorRef"].GenerateParams(&v1alpha1.ApplicationSetGenerator{
+		GeneratorRef: &v1alpha1.GeneratorReference{Name: "unknown"},
+	}, appSet, nil)
+	require.EqualError(t, err, `generator definition "unknown" not found`)
+}
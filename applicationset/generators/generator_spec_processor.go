@@ -28,6 +28,13 @@ type TransformResult struct {
 
 // Transform a spec generator to list of paramSets and a template
 func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, allGenerators map[string]Generator, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate, appSet *argoprojiov1alpha1.ApplicationSet, genParams map[string]any, client client.Client) ([]TransformResult, error) {
+	// The generator definitions are resolved first, so that they are interpolated with the parameters of the parent
+	// generators like the generators they replace.
+	requestedGenerator, err := ResolveGeneratorRef(requestedGenerator, appSet)
+	if err != nil {
+		return nil, err
+	}
+
 	// This is a custom version of the `LabelSelectorAsSelector` that is in k8s.io/apimachinery. This has been copied
 	// verbatim from that package, with the difference that we do not have any restrictions on label values. This is done
 	// so that, among other things, we can match on cluster urls.
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"maps"
 	"path"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/jeremywohl/flatten"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -57,7 +60,7 @@ func (g *GitGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Appli
 }
 
 // GenerateParams generates a list of parameter maps for the ApplicationSet by evaluating the Git generator's configuration.
-// It supports both directory-based and file-based Git generators.
+// It supports directory-based, file-based and tag-based Git generators.
 func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
 	if appSetGenerator == nil {
 		return nil, ErrEmptyAppSetGenerator
@@ -98,6 +101,8 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 	var err error
 	var res []map[string]any
 	switch {
+	case appSetGenerator.Git.Tags != nil:
+		res, err = g.generateParamsForGitTags(appSetGenerator, appSet.Spec.GoTemplate, project, appSet.Spec.GoTemplateOptions)
 	case len(appSetGenerator.Git.Directories) != 0:
 		res, err = g.generateParamsForGitDirectories(appSetGenerator, noRevisionCache, sourceIntegrity, appSet.Spec.GoTemplate, project, appSet.Spec.GoTemplateOptions)
 	case len(appSetGenerator.Git.Files) != 0:
@@ -216,6 +221,137 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	return allParams, nil
 }
 
+// generateParamsForGitTags generates parameters for an ApplicationSet using a tag-based Git generator.
+// It lists the tags of the Git repository, filters them based on the generator's configuration and renders
+// parameters with the metadata of the commit of each remaining tag.
+func (g *GitGenerator) generateParamsForGitTags(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, useGoTemplate bool, project string, goTemplateOptions []string) ([]map[string]any, error) {
+	tagsConfig := appSetGenerator.Git.Tags
+	var match *regexp.Regexp
+	if tagsConfig.Match != "" {
+		var err error
+		match, err = regexp.Compile(tagsConfig.Match)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling tags match regexp %q: %w", tagsConfig.Match, err)
+		}
+	}
+	var constraint *semver.Constraints
+	if tagsConfig.VersionConstraint != "" {
+		var err error
+		constraint, err = semver.NewConstraint(tagsConfig.VersionConstraint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing tags VersionConstraint %q: %w", tagsConfig.VersionConstraint, err)
+		}
+	}
+
+	allTags, err := g.repos.ListGitTags(context.TODO(), appSetGenerator.Git.RepoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags of repo: %w", err)
+	}
+
+	requestedTags, err := filterGitTags(allTags, match, constraint, tagsConfig.Latest, tagsConfig.ReleaseLine)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"tags":    requestedTags,
+		"total":   len(allTags),
+		"repoURL": appSetGenerator.Git.RepoURL,
+	}).Info("tags result from the repo service")
+
+	if len(requestedTags) == 0 {
+		return []map[string]any{}, nil
+	}
+
+	tags, err := g.repos.GetGitTags(context.TODO(), appSetGenerator.Git.RepoURL, project, requestedTags)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tags from repo: %w", err)
+	}
+
+	res := make([]map[string]any, 0, len(tags))
+	for _, tag := range tags {
+		params := map[string]any{
+			"tag":           tag.Name,
+			"tagNormalized": utils.SanitizeName(tag.Name),
+			"sha":           tag.Sha,
+			"author":        tag.Author,
+			"date":          tag.Date,
+			"message":       tag.Message,
+		}
+
+		err := appendTemplatedValues(appSetGenerator.Git.Values, params, useGoTemplate, goTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		res = append(res, params)
+	}
+
+	return res, nil
+}
+
+// filterGitTags returns the tags which match the regex and satisfy the version constraint, if any. If latest is
+// greater than zero, only the given number of highest semantic versions are returned, highest first. If releaseLine
+// is "major" or "minor", only the highest semantic version of each major or minor release line is considered.
+// Pre-releases are ignored unless the constraint allows them.
+func filterGitTags(tags []string, match *regexp.Regexp, constraint *semver.Constraints, latest int64, releaseLine string) ([]string, error) {
+	if releaseLine != "" && releaseLine != "major" && releaseLine != "minor" {
+		return nil, fmt.Errorf("unsupported tags release line %q, must be major or minor", releaseLine)
+	}
+	semverOnly := constraint != nil || latest > 0 || releaseLine != ""
+
+	type semverTag struct {
+		name    string
+		version *semver.Version
+	}
+	var res []string
+	var versions []semverTag
+	for _, tag := range tags {
+		if match != nil && !match.MatchString(tag) {
+			continue
+		}
+		if !semverOnly {
+			res = append(res, tag)
+			continue
+		}
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		// Pre-releases are only considered if the constraint explicitly allows them, consistently with the semantic
+		// version constraints
+		if (constraint != nil && !constraint.Check(version)) || (constraint == nil && version.Prerelease() != "") {
+			continue
+		}
+		versions = append(versions, semverTag{tag, version})
+	}
+	if !semverOnly {
+		return res, nil
+	}
+
+	slices.SortStableFunc(versions, func(a, b semverTag) int {
+		return b.version.Compare(a.version)
+	})
+	seenReleaseLines := map[string]bool{}
+	for _, tag := range versions {
+		if releaseLine != "" {
+			line := strconv.FormatUint(tag.version.Major(), 10)
+			if releaseLine == "minor" {
+				line += "." + strconv.FormatUint(tag.version.Minor(), 10)
+			}
+			if seenReleaseLines[line] {
+				continue
+			}
+			seenReleaseLines[line] = true
+		}
+		if latest > 0 && int64(len(res)) >= latest {
+			break
+		}
+		res = append(res, tag.name)
+	}
+	return res, nil
+}
+
 // generateParamsFromGitFile parses the content of a Git-tracked file and generates a slice of parameter maps.
 // The file can contain a single YAML/JSON object or an array of such objects. Depending on the useGoTemplate flag,
 // it either preserves structure for Go templating or flattens the objects for use as plain key-value parameters.
@@ -12,7 +12,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 )
 
 func Test_generateParamsFromGitFile(t *testing.T) {
@@ -2531,3 +2533,123 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 		}
 	}
 }
+
+func TestGitGenerateParamsFromTags(t *testing.T) {
+	allTags := []string{"v1.0.0", "v1.1.0", "v1.1.1", "v1.2.0", "v1.10.0", "v2.0.0-rc.1", "v2.0.0", "nightly"}
+	gitTag := func(name string) *apiclient.GitTag {
+		return &apiclient.GitTag{Name: name, Sha: "sha-" + name, Author: "Jane Doe <jane@example.com>", Date: "2024-01-01T00:00:00Z", Message: "Release " + name}
+	}
+
+	cases := []struct {
+		name          string
+		tags          v1alpha1.GitTagsGeneratorItem
+		expectedTags  []string
+		expectedError string
+	}{
+		{
+			name:         "all tags",
+			tags:         v1alpha1.GitTagsGeneratorItem{},
+			expectedTags: allTags,
+		},
+		{
+			name:         "match",
+			tags:         v1alpha1.GitTagsGeneratorItem{Match: "^v1\\.1\\."},
+			expectedTags: []string{"v1.1.0", "v1.1.1"},
+		},
+		{
+			name:         "version constraint",
+			tags:         v1alpha1.GitTagsGeneratorItem{VersionConstraint: ">=1.1.0 <2.0.0"},
+			expectedTags: []string{"v1.10.0", "v1.2.0", "v1.1.1", "v1.1.0"},
+		},
+		{
+			name:         "latest",
+			tags:         v1alpha1.GitTagsGeneratorItem{Latest: 2},
+			expectedTags: []string{"v2.0.0", "v1.10.0"},
+		},
+		{
+			name:         "latest minor release lines",
+			tags:         v1alpha1.GitTagsGeneratorItem{Latest: 3, ReleaseLine: "minor", VersionConstraint: "<2.0.0"},
+			expectedTags: []string{"v1.10.0", "v1.2.0", "v1.1.1"},
+		},
+		{
+			name:         "pre-releases allowed by the version constraint",
+			tags:         v1alpha1.GitTagsGeneratorItem{VersionConstraint: ">=2.0.0-0"},
+			expectedTags: []string{"v2.0.0", "v2.0.0-rc.1"},
+		},
+		{
+			name:         "major release lines",
+			tags:         v1alpha1.GitTagsGeneratorItem{ReleaseLine: "major"},
+			expectedTags: []string{"v2.0.0", "v1.10.0"},
+		},
+		{
+			name:         "no matching tags",
+			tags:         v1alpha1.GitTagsGeneratorItem{Match: "^v3\\."},
+			expectedTags: nil,
+		},
+		{
+			name:          "invalid match",
+			tags:          v1alpha1.GitTagsGeneratorItem{Match: "("},
+			expectedError: "error generating params from git: error compiling tags match regexp \"(\": error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name:          "invalid release line",
+			tags:          v1alpha1.GitTagsGeneratorItem{ReleaseLine: "patch"},
+			expectedError: "error generating params from git: unsupported tags release line \"patch\", must be major or minor",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			argoCDServiceMock := mocks.NewRepos(t)
+			if testCase.tags.Match != "(" {
+				argoCDServiceMock.EXPECT().ListGitTags(mock.Anything, "https://github.com/argoproj/argo-cd", "default").Return(allTags, nil)
+			}
+			expected := []map[string]any{}
+			if len(testCase.expectedTags) > 0 {
+				var tags []*apiclient.GitTag
+				for _, name := range testCase.expectedTags {
+					tags = append(tags, gitTag(name))
+					expected = append(expected, map[string]any{
+						"tag":           name,
+						"tagNormalized": utils.SanitizeName(name),
+						"sha":           "sha-" + name,
+						"author":        "Jane Doe <jane@example.com>",
+						"date":          "2024-01-01T00:00:00Z",
+						"message":       "Release " + name,
+						"values.team":   "platform",
+					})
+				}
+				argoCDServiceMock.EXPECT().GetGitTags(mock.Anything, "https://github.com/argoproj/argo-cd", "default", testCase.expectedTags).Return(tags, nil)
+			}
+
+			gitGenerator := NewGitGenerator(argoCDServiceMock, "argocd")
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Generators: []v1alpha1.ApplicationSetGenerator{{
+						Git: &v1alpha1.GitGenerator{
+							RepoURL: "https://github.com/argoproj/argo-cd",
+							Tags:    &testCase.tags,
+							Values:  map[string]string{"team": "platform"},
+						},
+					}},
+					Template: v1alpha1.ApplicationSetTemplate{Spec: v1alpha1.ApplicationSpec{Project: "default"}},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			err := v1alpha1.AddToScheme(scheme)
+			require.NoError(t, err)
+			appProject := v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
+
+			got, err := gitGenerator.GenerateParams(&appSet.Spec.Generators[0], &appSet, client)
+			if testCase.expectedError != "" {
+				require.EqualError(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, got)
+		})
+	}
+}
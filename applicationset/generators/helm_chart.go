@@ -0,0 +1,153 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+var _ Generator = (*HelmChartGenerator)(nil)
+
+// HelmChartGenerator generates parameters from the versions of the charts of a Helm repository
+type HelmChartGenerator struct {
+	repos services.Repos
+}
+
+// NewHelmChartGenerator creates a new instance of Helm Chart Generator
+func NewHelmChartGenerator(repos services.Repos) Generator {
+	return &HelmChartGenerator{
+		repos: repos,
+	}
+}
+
+func (g *HelmChartGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.HelmChart.Template
+}
+
+func (g *HelmChartGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.HelmChart.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.HelmChart.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *HelmChartGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.HelmChart == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.HelmChart
+	if generatorConfig.RepoURL == "" {
+		return nil, errors.New("repoURL of the Helm chart generator is required")
+	}
+
+	var constraint *semver.Constraints
+	if generatorConfig.VersionConstraint != "" {
+		var err error
+		constraint, err = semver.NewConstraint(generatorConfig.VersionConstraint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing VersionConstraint %q: %w", generatorConfig.VersionConstraint, err)
+		}
+	}
+
+	// If the project field is templated, we cannot resolve the project name, so we pass an empty string to the repo-server.
+	// This means only "globally-scoped" repo credentials can be used for such appsets.
+	project := resolveProjectName(appSet.Spec.Template.Spec.Project)
+
+	versions, err := g.repos.GetHelmChartVersions(context.TODO(), generatorConfig.RepoURL, project, generatorConfig.Charts, appSet.RefreshRequired())
+	if err != nil {
+		return nil, fmt.Errorf("error getting chart versions of %s: %w", generatorConfig.RepoURL, err)
+	}
+
+	versions = filterHelmChartVersions(versions, constraint, generatorConfig.LatestVersions)
+
+	log.WithFields(log.Fields{
+		"repoURL":   generatorConfig.RepoURL,
+		"total":     len(versions),
+		"appset":    appSet.Name,
+		"namespace": appSet.Namespace,
+	}).Debug("chart versions result from the Helm repository")
+
+	res := make([]map[string]any, 0, len(versions))
+	for _, version := range versions {
+		params := map[string]any{
+			"repoURL":     generatorConfig.RepoURL,
+			"chart":       version.Name,
+			"version":     version.Version,
+			"appVersion":  version.AppVersion,
+			"description": version.Description,
+			"created":     version.Created,
+			"digest":      version.Digest,
+			"home":        version.Home,
+			"icon":        version.Icon,
+			"keywords":    strings.Join(version.Keywords, ","),
+			"deprecated":  strconv.FormatBool(version.Deprecated),
+		}
+
+		err := appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		res = append(res, params)
+	}
+
+	return res, nil
+}
+
+// filterHelmChartVersions returns the chart versions which satisfy the constraint, if any. If latest is greater than
+// zero, only the given number of highest semantic versions of each chart are returned, highest first.
+func filterHelmChartVersions(versions []*apiclient.HelmChartVersion, constraint *semver.Constraints, latest int64) []*apiclient.HelmChartVersion {
+	if constraint == nil && latest <= 0 {
+		return versions
+	}
+
+	type semverChartVersion struct {
+		*apiclient.HelmChartVersion
+		semver *semver.Version
+	}
+	var charts []string
+	chartVersions := map[string][]semverChartVersion{}
+	for _, version := range versions {
+		v, err := semver.NewVersion(version.Version)
+		if err != nil || (constraint != nil && !constraint.Check(v)) {
+			continue
+		}
+		if _, ok := chartVersions[version.Name]; !ok {
+			charts = append(charts, version.Name)
+		}
+		chartVersions[version.Name] = append(chartVersions[version.Name], semverChartVersion{version, v})
+	}
+
+	var res []*apiclient.HelmChartVersion
+	for _, chart := range charts {
+		matching := chartVersions[chart]
+		if latest > 0 {
+			slices.SortStableFunc(matching, func(a, b semverChartVersion) int {
+				return b.semver.Compare(a.semver)
+			})
+			matching = matching[:min(int64(len(matching)), latest)]
+		}
+		for _, version := range matching {
+			res = append(res, version.HelmChartVersion)
+		}
+	}
+	return res
+}
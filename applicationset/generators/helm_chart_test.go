@@ -0,0 +1,177 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestHelmChartGenerateParams(t *testing.T) {
+	chartVersion := func(name, version string) *apiclient.HelmChartVersion {
+		return &apiclient.HelmChartVersion{
+			Name:       name,
+			Version:    version,
+			AppVersion: "v" + version,
+			Created:    "2024-01-01T00:00:00Z",
+			Digest:     "sha256:" + version,
+			Keywords:   []string{"web", "demo"},
+		}
+	}
+	versions := []*apiclient.HelmChartVersion{
+		chartVersion("guestbook", "0.9.0"),
+		chartVersion("guestbook", "1.0.0"),
+		chartVersion("guestbook", "1.10.0"),
+		chartVersion("guestbook", "1.9.0"),
+		chartVersion("guestbook", "2.0.0"),
+		chartVersion("nginx", "1.2.0"),
+		chartVersion("nginx", "latest"),
+	}
+	params := func(name, version string) map[string]any {
+		return map[string]any{
+			"repoURL":     "https://charts.example.com",
+			"chart":       name,
+			"version":     version,
+			"appVersion":  "v" + version,
+			"description": "",
+			"created":     "2024-01-01T00:00:00Z",
+			"digest":      "sha256:" + version,
+			"home":        "",
+			"icon":        "",
+			"keywords":    "web,demo",
+			"deprecated":  "false",
+		}
+	}
+
+	cases := []struct {
+		name           string
+		generator      v1alpha1.HelmChartGenerator
+		expected       []map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "all versions",
+			generator: v1alpha1.HelmChartGenerator{
+				RepoURL: "https://charts.example.com",
+				Charts:  []string{"nginx"},
+			},
+			expected: []map[string]any{
+				params("guestbook", "0.9.0"),
+				params("guestbook", "1.0.0"),
+				params("guestbook", "1.10.0"),
+				params("guestbook", "1.9.0"),
+				params("guestbook", "2.0.0"),
+				params("nginx", "1.2.0"),
+				params("nginx", "latest"),
+			},
+		},
+		{
+			name: "version constraint",
+			generator: v1alpha1.HelmChartGenerator{
+				RepoURL:           "https://charts.example.com",
+				Charts:            []string{"nginx"},
+				VersionConstraint: ">=1.0.0 <2.0.0",
+			},
+			expected: []map[string]any{
+				params("guestbook", "1.0.0"),
+				params("guestbook", "1.10.0"),
+				params("guestbook", "1.9.0"),
+				params("nginx", "1.2.0"),
+			},
+		},
+		{
+			name: "latest versions per chart",
+			generator: v1alpha1.HelmChartGenerator{
+				RepoURL:           "https://charts.example.com",
+				Charts:            []string{"nginx"},
+				VersionConstraint: "<2.0.0",
+				LatestVersions:    2,
+			},
+			expected: []map[string]any{
+				params("guestbook", "1.10.0"),
+				params("guestbook", "1.9.0"),
+				params("nginx", "1.2.0"),
+			},
+		},
+		{
+			name: "invalid version constraint",
+			generator: v1alpha1.HelmChartGenerator{
+				RepoURL:           "https://charts.example.com",
+				VersionConstraint: "not a constraint",
+			},
+			expectedErrMsg: `error parsing VersionConstraint "not a constraint"`,
+		},
+		{
+			name:           "missing repoURL",
+			generator:      v1alpha1.HelmChartGenerator{},
+			expectedErrMsg: "repoURL of the Helm chart generator is required",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			argoCDServiceMock := mocks.NewRepos(t)
+			if c.expectedErrMsg == "" {
+				argoCDServiceMock.EXPECT().GetHelmChartVersions(mock.Anything, "https://charts.example.com", "project", c.generator.Charts, false).Return(versions, nil)
+			}
+
+			generator := NewHelmChartGenerator(argoCDServiceMock)
+			appSet := &v1alpha1.ApplicationSet{
+				Spec: v1alpha1.ApplicationSetSpec{
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{Project: "project"},
+					},
+				},
+			}
+			params, err := generator.GenerateParams(&v1alpha1.ApplicationSetGenerator{HelmChart: &c.generator}, appSet, nil)
+			if c.expectedErrMsg != "" {
+				require.ErrorContains(t, err, c.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+		})
+	}
+}
+
+func TestHelmChartGenerateParams_Values(t *testing.T) {
+	argoCDServiceMock := mocks.NewRepos(t)
+	argoCDServiceMock.EXPECT().GetHelmChartVersions(mock.Anything, "https://charts.example.com", "", []string(nil), false).Return([]*apiclient.HelmChartVersion{{
+		Name:       "guestbook",
+		Version:    "1.0.0",
+		Deprecated: true,
+	}}, nil)
+
+	generator := NewHelmChartGenerator(argoCDServiceMock)
+	appSet := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Template: v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{Project: "{{ .project }}"},
+			},
+		},
+	}
+	params, err := generator.GenerateParams(&v1alpha1.ApplicationSetGenerator{HelmChart: &v1alpha1.HelmChartGenerator{
+		RepoURL: "https://charts.example.com",
+		Values:  map[string]string{"release": "{{ .chart }}-{{ .version }}"},
+	}}, appSet, nil)
+	require.NoError(t, err)
+	require.Len(t, params, 1)
+	assert.Equal(t, "true", params[0]["deprecated"])
+	assert.Equal(t, map[string]string{"release": "guestbook-1.0.0"}, params[0]["values"])
+}
+
+func TestHelmChartGenerateParams_Error(t *testing.T) {
+	argoCDServiceMock := mocks.NewRepos(t)
+	argoCDServiceMock.EXPECT().GetHelmChartVersions(mock.Anything, "https://charts.example.com", "", []string(nil), false).Return(nil, errors.New("index not found"))
+
+	generator := NewHelmChartGenerator(argoCDServiceMock)
+	_, err := generator.GenerateParams(&v1alpha1.ApplicationSetGenerator{HelmChart: &v1alpha1.HelmChartGenerator{RepoURL: "https://charts.example.com"}}, &v1alpha1.ApplicationSet{}, nil)
+	require.EqualError(t, err, "error getting chart versions of https://charts.example.com: index not found")
+}
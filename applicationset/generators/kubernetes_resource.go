@@ -0,0 +1,241 @@
+package generators
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+var _ Generator = (*KubernetesResourceGenerator)(nil)
+
+// KubernetesResourceGenerator generates parameters from arbitrary Kubernetes objects, listed on the cluster of the
+// ApplicationSet controller or on the clusters registered with Argo CD
+type KubernetesResourceGenerator struct {
+	ctx       context.Context
+	dynClient dynamic.Interface
+	disco     discovery.DiscoveryInterface
+	db        db.ArgoDB
+	// newClusterClients returns the clients to list the objects of a cluster registered with Argo CD
+	newClusterClients func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error)
+}
+
+// NewKubernetesResourceGenerator creates a new instance of Kubernetes Resource Generator
+func NewKubernetesResourceGenerator(ctx context.Context, dynClient dynamic.Interface, disco discovery.DiscoveryInterface, argoDB db.ArgoDB) Generator {
+	return &KubernetesResourceGenerator{
+		ctx:               ctx,
+		dynClient:         dynClient,
+		disco:             disco,
+		db:                argoDB,
+		newClusterClients: newClusterClients,
+	}
+}
+
+func newClusterClients(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting REST config: %w", err)
+	}
+	dynClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating discovery client: %w", err)
+	}
+	return dynClient, disco, nil
+}
+
+func (g *KubernetesResourceGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.KubernetesResource.Template
+}
+
+func (g *KubernetesResourceGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.KubernetesResource.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.KubernetesResource.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *KubernetesResourceGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.KubernetesResource == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.KubernetesResource
+	gv, err := schema.ParseGroupVersion(generatorConfig.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing apiVersion %q: %w", generatorConfig.APIVersion, err)
+	}
+	if generatorConfig.Kind == "" {
+		return nil, errors.New("kind of the Kubernetes resource generator is required")
+	}
+	gvk := gv.WithKind(generatorConfig.Kind)
+	// The ApplicationSet controller is able to read the secrets of its namespace, which must not leak into the
+	// generated Applications
+	if gvk.Group == "" && gvk.Kind == kube.SecretKind {
+		return nil, errors.New("the Kubernetes resource generator cannot list secrets")
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(&generatorConfig.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing labelSelector: %w", err)
+	}
+	listOptions := metav1.ListOptions{
+		LabelSelector: labelSelector.String(),
+		FieldSelector: generatorConfig.FieldSelector,
+	}
+
+	parameterNames := slices.Sorted(maps.Keys(generatorConfig.Parameters))
+	parameters := make(map[string]*jsonpath.JSONPath, len(parameterNames))
+	for _, name := range parameterNames {
+		parameter := jsonpath.New(name).AllowMissingKeys(true)
+		if err := parameter.Parse(generatorConfig.Parameters[name]); err != nil {
+			return nil, fmt.Errorf("error parsing JSONPath of parameter %q: %w", name, err)
+		}
+		parameters[name] = parameter
+	}
+
+	clusters, err := g.selectClusters(generatorConfig.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []map[string]any{}
+	for _, cluster := range clusters {
+		dynClient, disco := g.dynClient, g.disco
+		if cluster != nil {
+			dynClient, disco, err = g.newClusterClients(cluster)
+			if err != nil {
+				return nil, fmt.Errorf("error creating clients for cluster %q: %w", cluster.Name, err)
+			}
+		}
+
+		objects, err := listObjects(g.ctx, dynClient, disco, gvk, generatorConfig.Namespace, listOptions)
+		if err != nil {
+			if cluster != nil {
+				return nil, fmt.Errorf("error listing %s on cluster %q: %w", gvk.Kind, cluster.Name, err)
+			}
+			return nil, fmt.Errorf("error listing %s: %w", gvk.Kind, err)
+		}
+
+		log.WithFields(log.Fields{
+			"kind":      gvk.Kind,
+			"total":     len(objects),
+			"appset":    appSet.Name,
+			"namespace": appSet.Namespace,
+		}).Debug("objects result from the Kubernetes API")
+
+		for _, obj := range objects {
+			params := map[string]any{
+				"name":      obj.GetName(),
+				"namespace": obj.GetNamespace(),
+			}
+			objLabels := obj.GetLabels()
+			if appSet.Spec.GoTemplate {
+				labelsParam := make(map[string]any, len(objLabels))
+				for key, value := range objLabels {
+					labelsParam[key] = value
+				}
+				params["labels"] = labelsParam
+			} else {
+				for key, value := range objLabels {
+					params["labels."+key] = value
+				}
+			}
+			if cluster != nil {
+				if appSet.Spec.GoTemplate {
+					params["cluster"] = map[string]any{"name": cluster.Name, "server": cluster.Server}
+				} else {
+					params["cluster.name"] = cluster.Name
+					params["cluster.server"] = cluster.Server
+				}
+			}
+
+			for _, name := range parameterNames {
+				var buf bytes.Buffer
+				if err := parameters[name].Execute(&buf, obj.Object); err != nil {
+					return nil, fmt.Errorf("error evaluating JSONPath of parameter %q on %s %q: %w", name, gvk.Kind, obj.GetName(), err)
+				}
+				params[name] = buf.String()
+			}
+
+			err := appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			res = append(res, params)
+		}
+	}
+
+	return res, nil
+}
+
+// selectClusters returns the clusters registered with Argo CD which match the selector, or a nil cluster which stands
+// for the cluster of the ApplicationSet controller if the selector is nil
+func (g *KubernetesResourceGenerator) selectClusters(clusterSelector *metav1.LabelSelector) ([]*argoprojiov1alpha1.Cluster, error) {
+	if clusterSelector == nil {
+		return []*argoprojiov1alpha1.Cluster{nil}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(clusterSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing clusterSelector: %w", err)
+	}
+	clusterList, err := g.db.ListClusters(g.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+
+	var clusters []*argoprojiov1alpha1.Cluster
+	for i := range clusterList.Items {
+		if selector.Matches(labels.Set(clusterList.Items[i].Labels)) {
+			clusters = append(clusters, &clusterList.Items[i])
+		}
+	}
+	return clusters, nil
+}
+
+// listObjects lists the objects of the given kind, in the given namespace if the kind is namespaced
+func listObjects(ctx context.Context, dynClient dynamic.Interface, disco discovery.DiscoveryInterface, gvk schema.GroupVersionKind, namespace string, listOptions metav1.ListOptions) ([]unstructured.Unstructured, error) {
+	apiResource, err := kube.ServerResourceForGroupVersionKind(disco, gvk, "list")
+	if err != nil {
+		return nil, fmt.Errorf("error discovering the resource: %w", err)
+	}
+
+	resource := dynClient.Resource(gvk.GroupVersion().WithResource(apiResource.Name))
+	var list *unstructured.UnstructuredList
+	if apiResource.Namespaced {
+		list, err = resource.Namespace(namespace).List(ctx, listOptions)
+	} else {
+		list, err = resource.List(ctx, listOptions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
@@ -0,0 +1,193 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func newKubernetesResourceClients(objects ...runtime.Object) (dynamic.Interface, discovery.DiscoveryInterface) {
+	gvrToListKind := map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "namespaces"}:                    "NamespaceList",
+		{Group: "example.com", Version: "v1", Resource: "tenants"}: "TenantList",
+	}
+	disco := &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "namespaces", Kind: "Namespace", Verbs: []string{"get", "list"}},
+		},
+	}, {
+		GroupVersion: "example.com/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "tenants", Kind: "Tenant", Namespaced: true, Verbs: []string{"get", "list"}},
+		},
+	}}}}
+	return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, objects...), disco
+}
+
+func newTestObject(apiVersion, kind, namespace, name string, labels map[string]string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func TestKubernetesResourceGenerateParams(t *testing.T) {
+	objects := []runtime.Object{
+		newTestObject("v1", "Namespace", "", "team-a", map[string]string{"argocd.argoproj.io/provision": "true", "team": "a"}, nil),
+		newTestObject("v1", "Namespace", "", "kube-system", nil, nil),
+		newTestObject("example.com/v1", "Tenant", "tenants", "alpha", nil, map[string]any{"owner": "alice", "tier": "gold"}),
+		newTestObject("example.com/v1", "Tenant", "other", "beta", nil, map[string]any{"owner": "bob"}),
+	}
+
+	cases := []struct {
+		name           string
+		generator      argoprojiov1alpha1.KubernetesResourceGenerator
+		goTemplate     bool
+		expected       []map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "cluster-scoped objects selected by labels",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion:    "v1",
+				Kind:          "Namespace",
+				LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"argocd.argoproj.io/provision": "true"}},
+				Values:        map[string]string{"release": "{{name}}-apps"},
+			},
+			expected: []map[string]any{{
+				"name":                                "team-a",
+				"namespace":                           "",
+				"labels.argocd.argoproj.io/provision": "true",
+				"labels.team":                         "a",
+				"values.release":                      "team-a-apps",
+			}},
+		},
+		{
+			name: "namespaced custom resources with JSONPath parameters",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "example.com/v1",
+				Kind:       "Tenant",
+				Namespace:  "tenants",
+				Parameters: map[string]string{
+					"owner":   "{.spec.owner}",
+					"tier":    "{.spec.tier}",
+					"missing": "{.spec.missing}",
+				},
+			},
+			goTemplate: true,
+			expected: []map[string]any{{
+				"name":      "alpha",
+				"namespace": "tenants",
+				"labels":    map[string]any{},
+				"owner":     "alice",
+				"tier":      "gold",
+				"missing":   "",
+			}},
+		},
+		{
+			name: "secrets are not allowed",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			expectedErrMsg: "the Kubernetes resource generator cannot list secrets",
+		},
+		{
+			name: "unknown kind",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Unknown",
+			},
+			expectedErrMsg: "error listing Unknown: error discovering the resource",
+		},
+		{
+			name: "invalid JSONPath",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+				Parameters: map[string]string{"owner": "{.spec.owner"},
+			},
+			expectedErrMsg: `error parsing JSONPath of parameter "owner"`,
+		},
+		{
+			name:           "missing kind",
+			generator:      argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1"},
+			expectedErrMsg: "kind of the Kubernetes resource generator is required",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dynClient, disco := newKubernetesResourceClients(objects...)
+			generator := NewKubernetesResourceGenerator(t.Context(), dynClient, disco, dbmocks.NewArgoDB(t))
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: testCase.goTemplate}}
+
+			params, err := generator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: &testCase.generator}, appSet, nil)
+			if testCase.expectedErrMsg != "" {
+				require.ErrorContains(t, err, testCase.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, params)
+		})
+	}
+}
+
+func TestKubernetesResourceGenerateParams_Clusters(t *testing.T) {
+	argoDB := dbmocks.NewArgoDB(t)
+	argoDB.EXPECT().ListClusters(mock.Anything).Return(&argoprojiov1alpha1.ClusterList{Items: []argoprojiov1alpha1.Cluster{
+		{Name: "in-cluster", Server: argoprojiov1alpha1.KubernetesInternalAPIServerAddr},
+		{Name: "production", Server: "https://production.example.com", Labels: map[string]string{"environment": "production"}},
+		{Name: "staging", Server: "https://staging.example.com", Labels: map[string]string{"environment": "staging"}},
+	}}, nil)
+	clusterObjects := map[string][]runtime.Object{
+		"production": {newTestObject("v1", "Namespace", "", "payments", nil, nil)},
+		"staging":    {newTestObject("v1", "Namespace", "", "payments-staging", nil, nil)},
+	}
+
+	controlPlaneDynClient, controlPlaneDisco := newKubernetesResourceClients()
+	generator := NewKubernetesResourceGenerator(t.Context(), controlPlaneDynClient, controlPlaneDisco, argoDB).(*KubernetesResourceGenerator)
+	generator.newClusterClients = func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+		dynClient, disco := newKubernetesResourceClients(clusterObjects[cluster.Name]...)
+		return dynClient, disco, nil
+	}
+
+	params, err := generator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		ClusterSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      "environment",
+			Operator: metav1.LabelSelectorOpExists,
+		}}},
+	}}, &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{
+		"name":      "payments",
+		"namespace": "",
+		"labels":    map[string]any{},
+		"cluster":   map[string]any{"name": "production", "server": "https://production.example.com"},
+	}, {
+		"name":      "payments-staging",
+		"namespace": "",
+		"labels":    map[string]any{},
+		"cluster":   map[string]any{"name": "staging", "server": "https://staging.example.com"},
+	}}, params)
+}
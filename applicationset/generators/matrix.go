@@ -98,6 +98,10 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			OCI:                     appSetBaseGenerator.OCI,
+			HelmChart:               appSetBaseGenerator.HelmChart,
+			KubernetesResource:      appSetBaseGenerator.KubernetesResource,
+			GeneratorRef:            appSetBaseGenerator.GeneratorRef,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -137,6 +141,10 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			OCI:                     r.OCI,
+			HelmChart:               r.HelmChart,
+			KubernetesResource:      r.KubernetesResource,
+			GeneratorRef:            r.GeneratorRef,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
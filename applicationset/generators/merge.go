@@ -153,6 +153,10 @@ func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Applic
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			OCI:                     appSetBaseGenerator.OCI,
+			HelmChart:               appSetBaseGenerator.HelmChart,
+			KubernetesResource:      appSetBaseGenerator.KubernetesResource,
+			GeneratorRef:            appSetBaseGenerator.GeneratorRef,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -189,6 +193,10 @@ func (m *MergeGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.App
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			OCI:                     r.OCI,
+			HelmChart:               r.HelmChart,
+			KubernetesResource:      r.KubernetesResource,
+			GeneratorRef:            r.GeneratorRef,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
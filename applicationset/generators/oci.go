@@ -0,0 +1,227 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*OCIGenerator)(nil)
+
+// OCIGenerator generates parameters from the repositories and the tags of an OCI registry namespace
+type OCIGenerator struct {
+	repos services.Repos
+}
+
+// NewOCIGenerator creates a new instance of OCI Generator
+func NewOCIGenerator(repos services.Repos) Generator {
+	return &OCIGenerator{
+		repos: repos,
+	}
+}
+
+func (g *OCIGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.OCI.Template
+}
+
+func (g *OCIGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.OCI.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.OCI.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+// ociFilter is a compiled OCIGeneratorFilter
+type ociFilter struct {
+	repositoryMatch   *regexp.Regexp
+	tagMatch          *regexp.Regexp
+	versionConstraint *semver.Constraints
+}
+
+func (g *OCIGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.OCI == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	ctx := context.TODO()
+	generatorConfig := appSetGenerator.OCI
+	if generatorConfig.RepoURL == "" {
+		return nil, errors.New("repoURL of the OCI generator is required")
+	}
+	namespaceURL := strings.TrimSuffix(generatorConfig.RepoURL, "/")
+
+	filters, err := compileOCIFilters(generatorConfig.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling filters: %w", err)
+	}
+
+	// If the project field is templated, we cannot resolve the project name, so we pass an empty string to the repo-server.
+	// This means only "globally-scoped" repo credentials can be used for such appsets.
+	project := resolveProjectName(appSet.Spec.Template.Spec.Project)
+
+	repositories := generatorConfig.Repositories
+	if len(repositories) == 0 {
+		repositories, err = g.repos.GetOCIRepositories(ctx, namespaceURL, project)
+		if err != nil {
+			return nil, fmt.Errorf("error listing repositories of %s: %w", namespaceURL, err)
+		}
+	}
+
+	var res []map[string]any
+	for _, repository := range repositories {
+		repositoryFilters := filterOCIRepository(repository, filters)
+		if len(filters) > 0 && len(repositoryFilters) == 0 {
+			continue
+		}
+
+		repoURL := namespaceURL + "/" + repository
+		tags, err := g.repos.GetOCITags(ctx, repoURL, project)
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags of %s: %w", repoURL, err)
+		}
+		tags = filterOCITags(tags, repositoryFilters, generatorConfig.Latest)
+		if len(tags) == 0 {
+			continue
+		}
+
+		artifacts, err := g.repos.GetOCIArtifacts(ctx, repoURL, project, tags)
+		if err != nil {
+			return nil, fmt.Errorf("error getting artifacts of %s: %w", repoURL, err)
+		}
+
+		log.WithFields(log.Fields{
+			"repoURL":   repoURL,
+			"tags":      tags,
+			"appset":    appSet.Name,
+			"namespace": appSet.Namespace,
+		}).Debug("tags result from the OCI repository")
+
+		for _, artifact := range artifacts {
+			params := map[string]any{
+				"repoURL":    repoURL,
+				"repository": repository,
+				"tag":        artifact.Tag,
+				"digest":     artifact.Digest,
+			}
+			if appSet.Spec.GoTemplate {
+				annotations := make(map[string]any, len(artifact.Annotations))
+				for key, value := range artifact.Annotations {
+					annotations[key] = value
+				}
+				params["annotations"] = annotations
+			} else {
+				for key, value := range artifact.Annotations {
+					params["annotations."+key] = value
+				}
+			}
+
+			err := appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			res = append(res, params)
+		}
+	}
+
+	return res, nil
+}
+
+func compileOCIFilters(filters []argoprojiov1alpha1.OCIGeneratorFilter) ([]*ociFilter, error) {
+	outFilters := make([]*ociFilter, 0, len(filters))
+	for _, filter := range filters {
+		outFilter := &ociFilter{}
+		var err error
+		if filter.RepositoryMatch != nil {
+			outFilter.repositoryMatch, err = regexp.Compile(*filter.RepositoryMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling RepositoryMatch regexp %q: %w", *filter.RepositoryMatch, err)
+			}
+		}
+		if filter.TagMatch != nil {
+			outFilter.tagMatch, err = regexp.Compile(*filter.TagMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling TagMatch regexp %q: %w", *filter.TagMatch, err)
+			}
+		}
+		if filter.VersionConstraint != nil {
+			outFilter.versionConstraint, err = semver.NewConstraint(*filter.VersionConstraint)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing VersionConstraint %q: %w", *filter.VersionConstraint, err)
+			}
+		}
+		outFilters = append(outFilters, outFilter)
+	}
+	return outFilters, nil
+}
+
+// filterOCIRepository returns the filters which match the repository
+func filterOCIRepository(repository string, filters []*ociFilter) []*ociFilter {
+	var res []*ociFilter
+	for _, filter := range filters {
+		if filter.repositoryMatch == nil || filter.repositoryMatch.MatchString(repository) {
+			res = append(res, filter)
+		}
+	}
+	return res
+}
+
+// filterOCITags returns the tags which match any of the filters, or only the highest semantic version of them if
+// latest is true
+func filterOCITags(tags []string, filters []*ociFilter, latest bool) []string {
+	var res []string
+	var latestVersion *semver.Version
+	var latestTag string
+	for _, tag := range tags {
+		if len(filters) > 0 && !matchOCITag(tag, filters) {
+			continue
+		}
+		if !latest {
+			res = append(res, tag)
+			continue
+		}
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if latestVersion == nil || version.GreaterThan(latestVersion) {
+			latestVersion = version
+			latestTag = tag
+		}
+	}
+	if latestVersion != nil {
+		res = []string{latestTag}
+	}
+	return res
+}
+
+func matchOCITag(tag string, filters []*ociFilter) bool {
+	for _, filter := range filters {
+		if filter.tagMatch != nil && !filter.tagMatch.MatchString(tag) {
+			continue
+		}
+		if filter.versionConstraint != nil {
+			version, err := semver.NewVersion(tag)
+			if err != nil || !filter.versionConstraint.Check(version) {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestOCIGenerateParams(t *testing.T) {
+	artifact := func(tag string) *apiclient.OCIArtifact {
+		return &apiclient.OCIArtifact{
+			Tag:         tag,
+			Digest:      "sha256:" + tag,
+			Annotations: map[string]string{"org.opencontainers.image.source": "https://github.com/argoproj/argocd-example-apps"},
+		}
+	}
+
+	cases := []struct {
+		name           string
+		generator      v1alpha1.OCIGenerator
+		goTemplate     bool
+		repositories   []string
+		tags           map[string][]string
+		expectedTags   map[string][]string
+		expected       []map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "lists repositories with the catalog API",
+			generator: v1alpha1.OCIGenerator{
+				RepoURL: "oci://registry.example.com/charts/",
+				Values:  map[string]string{"environment": "production"},
+			},
+			repositories: []string{"guestbook"},
+			tags:         map[string][]string{"guestbook": {"1.0.0"}},
+			expectedTags: map[string][]string{"guestbook": {"1.0.0"}},
+			expected: []map[string]any{{
+				"repoURL":    "oci://registry.example.com/charts/guestbook",
+				"repository": "guestbook",
+				"tag":        "1.0.0",
+				"digest":     "sha256:1.0.0",
+				"annotations.org.opencontainers.image.source": "https://github.com/argoproj/argocd-example-apps",
+				"values.environment":                          "production",
+			}},
+		},
+		{
+			name: "filters repositories and tags",
+			generator: v1alpha1.OCIGenerator{
+				RepoURL:      "oci://registry.example.com/charts",
+				Repositories: []string{"guestbook", "helm-guestbook", "kustomize-guestbook"},
+				Filters: []v1alpha1.OCIGeneratorFilter{{
+					RepositoryMatch:   new("^guestbook$"),
+					VersionConstraint: new(">=1.0.0 <2.0.0"),
+				}, {
+					RepositoryMatch: new("^helm-"),
+					TagMatch:        new("^v"),
+				}},
+			},
+			goTemplate: true,
+			tags: map[string][]string{
+				"guestbook":      {"0.9.0", "1.0.0", "1.1.0", "2.0.0", "latest"},
+				"helm-guestbook": {"v1", "1.0.0"},
+			},
+			expectedTags: map[string][]string{
+				"guestbook":      {"1.0.0", "1.1.0"},
+				"helm-guestbook": {"v1"},
+			},
+			expected: []map[string]any{{
+				"repoURL":     "oci://registry.example.com/charts/guestbook",
+				"repository":  "guestbook",
+				"tag":         "1.0.0",
+				"digest":      "sha256:1.0.0",
+				"annotations": map[string]any{"org.opencontainers.image.source": "https://github.com/argoproj/argocd-example-apps"},
+			}, {
+				"repoURL":     "oci://registry.example.com/charts/guestbook",
+				"repository":  "guestbook",
+				"tag":         "1.1.0",
+				"digest":      "sha256:1.1.0",
+				"annotations": map[string]any{"org.opencontainers.image.source": "https://github.com/argoproj/argocd-example-apps"},
+			}, {
+				"repoURL":     "oci://registry.example.com/charts/helm-guestbook",
+				"repository":  "helm-guestbook",
+				"tag":         "v1",
+				"digest":      "sha256:v1",
+				"annotations": map[string]any{"org.opencontainers.image.source": "https://github.com/argoproj/argocd-example-apps"},
+			}},
+		},
+		{
+			name: "only considers the latest version",
+			generator: v1alpha1.OCIGenerator{
+				RepoURL:      "oci://registry.example.com/charts",
+				Repositories: []string{"guestbook", "helm-guestbook"},
+				Filters:      []v1alpha1.OCIGeneratorFilter{{VersionConstraint: new("<2.0.0")}},
+				Latest:       true,
+			},
+			goTemplate: true,
+			tags: map[string][]string{
+				"guestbook":      {"1.0.0", "1.10.0", "1.9.0", "2.0.0", "latest"},
+				"helm-guestbook": {"latest"},
+			},
+			expectedTags: map[string][]string{"guestbook": {"1.10.0"}},
+			expected: []map[string]any{{
+				"repoURL":     "oci://registry.example.com/charts/guestbook",
+				"repository":  "guestbook",
+				"tag":         "1.10.0",
+				"digest":      "sha256:1.10.0",
+				"annotations": map[string]any{"org.opencontainers.image.source": "https://github.com/argoproj/argocd-example-apps"},
+			}},
+		},
+		{
+			name: "invalid version constraint",
+			generator: v1alpha1.OCIGenerator{
+				RepoURL: "oci://registry.example.com/charts",
+				Filters: []v1alpha1.OCIGeneratorFilter{{VersionConstraint: new("not a constraint")}},
+			},
+			expectedErrMsg: `error compiling filters: error parsing VersionConstraint "not a constraint"`,
+		},
+		{
+			name:           "missing repoURL",
+			generator:      v1alpha1.OCIGenerator{},
+			expectedErrMsg: "repoURL of the OCI generator is required",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			argoCDServiceMock := mocks.NewRepos(t)
+			if c.repositories != nil {
+				argoCDServiceMock.EXPECT().GetOCIRepositories(mock.Anything, "oci://registry.example.com/charts", "project").Return(c.repositories, nil)
+			}
+			for repository, tags := range c.tags {
+				repoURL := "oci://registry.example.com/charts/" + repository
+				argoCDServiceMock.EXPECT().GetOCITags(mock.Anything, repoURL, "project").Return(tags, nil)
+			}
+			for repository, tags := range c.expectedTags {
+				var artifacts []*apiclient.OCIArtifact
+				for _, tag := range tags {
+					artifacts = append(artifacts, artifact(tag))
+				}
+				repoURL := "oci://registry.example.com/charts/" + repository
+				argoCDServiceMock.EXPECT().GetOCIArtifacts(mock.Anything, repoURL, "project", tags).Return(artifacts, nil)
+			}
+
+			generator := NewOCIGenerator(argoCDServiceMock)
+			appSet := &v1alpha1.ApplicationSet{
+				Spec: v1alpha1.ApplicationSetSpec{
+					GoTemplate: c.goTemplate,
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{Project: "project"},
+					},
+				},
+			}
+			params, err := generator.GenerateParams(&v1alpha1.ApplicationSetGenerator{OCI: &c.generator}, appSet, nil)
+			if c.expectedErrMsg != "" {
+				require.ErrorContains(t, err, c.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+		})
+	}
+}
+
+func TestOCIGenerateParams_Error(t *testing.T) {
+	argoCDServiceMock := mocks.NewRepos(t)
+	argoCDServiceMock.EXPECT().GetOCIRepositories(mock.Anything, "oci://registry.example.com/charts", "").Return(nil, errors.New("catalog API not supported"))
+
+	generator := NewOCIGenerator(argoCDServiceMock)
+	appSet := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{
+				Spec: v1alpha1.ApplicationSpec{Project: "{{ .project }}"},
+			},
+		},
+	}
+	_, err := generator.GenerateParams(&v1alpha1.ApplicationSetGenerator{OCI: &v1alpha1.OCIGenerator{RepoURL: "oci://registry.example.com/charts"}}, appSet, nil)
+	require.EqualError(t, err, "error listing repositories of oci://registry.example.com/charts: catalog API not supported")
+}
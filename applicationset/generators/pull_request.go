@@ -213,6 +213,23 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 		}
 		return pullrequest.NewAzureDevOpsService(token, providerConfig.API, providerConfig.Organization, providerConfig.Project, providerConfig.Repo, providerConfig.Labels)
 	}
+	if generatorConfig.AWSCodeCommit != nil {
+		providerConfig := generatorConfig.AWSCodeCommit
+		return pullrequest.NewAWSCodeCommitService(ctx, providerConfig.Repository, providerConfig.Role, providerConfig.Region)
+	}
+	if generatorConfig.Gerrit != nil {
+		providerConfig := generatorConfig.Gerrit
+		var username, password string
+		if providerConfig.BasicAuth != nil {
+			var err error
+			username = providerConfig.BasicAuth.Username
+			password, err = utils.GetSecretRef(ctx, g.client, providerConfig.BasicAuth.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Secret token: %w", err)
+			}
+		}
+		return pullrequest.NewGerritService(username, password, providerConfig.API, providerConfig.Project, providerConfig.Labels, providerConfig.Insecure, g.scmProxyURL, g.scmNoProxy)
+	}
 	return nil, errors.New("no Pull Request provider implementation configured")
 }
 
@@ -384,6 +384,14 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Error Gerrit",
+			providerConfig: &argoprojiov1alpha1.PullRequestGenerator{
+				Gerrit: &argoprojiov1alpha1.PullRequestGeneratorGerrit{
+					API: "https://myservice.mynamespace.svc.cluster.local",
+				},
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -0,0 +1,431 @@
+package progressivesync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// analysisRequestTimeout bounds the time spent waiting for a Prometheus or HTTP analysis gate endpoint
+const analysisRequestTimeout = 30 * time.Second
+
+// analysisMaxRedirects is the maximum number of redirects followed by a Prometheus or HTTP analysis gate request
+const analysisMaxRedirects = 10
+
+// analysisConfigError is returned by a Prometheus or HTTP gate which can never pass, so it fails without being retried
+type analysisConfigError struct {
+	err error
+}
+
+func (e *analysisConfigError) Error() string {
+	return e.err.Error()
+}
+
+func (e *analysisConfigError) Unwrap() error {
+	return e.err
+}
+
+func newAnalysisConfigError(format string, a ...any) error {
+	return &analysisConfigError{err: fmt.Errorf(format, a...)}
+}
+
+// checkAnalysisURL returns an error unless the URL may be requested by a Prometheus or HTTP analysis gate. When the
+// URLs are restricted, the URL must have the scheme and host of one of the allowed URLs, and a path below its path.
+func (m *Manager) checkAnalysisURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return newAnalysisConfigError("unsupported URL scheme %q, must be http or https", u.Scheme)
+	}
+	if !m.RestrictAnalysisURLs {
+		return nil
+	}
+	for _, allowed := range m.AnalysisAllowedURLs {
+		allowedURL, err := url.Parse(allowed)
+		if err != nil {
+			continue
+		}
+		if u.Scheme != allowedURL.Scheme || !strings.EqualFold(u.Host, allowedURL.Host) {
+			continue
+		}
+		allowedPath := strings.TrimSuffix(allowedURL.Path, "/")
+		if allowedPath == "" {
+			return nil
+		}
+		if p := path.Clean("/" + u.Path); p == allowedPath || strings.HasPrefix(p, allowedPath+"/") {
+			return nil
+		}
+	}
+	return newAnalysisConfigError("URL %s is not allowed for analysis gates, must match one of the URLs allowed by the ApplicationSet controller", u.Redacted())
+}
+
+// analysisHTTPClient returns the client used by Prometheus and HTTP analysis gates, which only follows redirects to
+// allowed URLs
+func (m *Manager) analysisHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: analysisRequestTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= analysisMaxRedirects {
+				return fmt.Errorf("stopped after %d redirects", analysisMaxRedirects)
+			}
+			return m.checkAnalysisURL(req.URL)
+		},
+	}
+}
+
+// analysisGateName returns the name of the gate used in status messages, defaulting to its 1-based position
+func analysisGateName(gate argov1alpha1.ApplicationSetRolloutAnalysis, index int) string {
+	if gate.Name != "" {
+		return gate.Name
+	}
+	return "#" + strconv.Itoa(index+1)
+}
+
+// getStepAnalysisStatus returns the analysis status shared by the Applications of a step. Every evaluation writes the
+// same status to all Applications of the step, so the statuses only differ when an Application was added to the step
+// or started rolling out a new revision, in which case the analysis starts over.
+func getStepAnalysisStatus(applicationSet *argov1alpha1.ApplicationSet, appNames []string) []argov1alpha1.ApplicationSetRolloutAnalysisStatus {
+	var stepStatus []argov1alpha1.ApplicationSetRolloutAnalysisStatus
+	for i, appName := range appNames {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			return nil
+		}
+		appAnalysis := applicationSet.Status.ApplicationStatus[idx].Analysis
+		if i == 0 {
+			stepStatus = appAnalysis
+		} else if !reflect.DeepEqual(stepStatus, appAnalysis) {
+			return nil
+		}
+	}
+	return stepStatus
+}
+
+// isStepAnalysisSuccessful returns true if every analysis gate of the step has passed
+func isStepAnalysisSuccessful(step argov1alpha1.ApplicationSetRolloutStep, stepStatus []argov1alpha1.ApplicationSetRolloutAnalysisStatus) bool {
+	if len(step.Analysis) == 0 {
+		return true
+	}
+	if len(stepStatus) != len(step.Analysis) {
+		return false
+	}
+	for _, gateStatus := range stepStatus {
+		if gateStatus.Phase != argov1alpha1.ApplicationSetRolloutAnalysisSuccessful {
+			return false
+		}
+	}
+	return true
+}
+
+// getFailedStepAnalysis returns the status of the failed analysis gate of a step, if any
+func getFailedStepAnalysis(stepStatus []argov1alpha1.ApplicationSetRolloutAnalysisStatus) *argov1alpha1.ApplicationSetRolloutAnalysisStatus {
+	for i := range stepStatus {
+		if stepStatus[i].Phase == argov1alpha1.ApplicationSetRolloutAnalysisFailed {
+			return &stepStatus[i]
+		}
+	}
+	return nil
+}
+
+// isStepHealthy returns true if all Applications of the step exist and reached the Healthy progressive sync status
+func isStepHealthy(applicationSet *argov1alpha1.ApplicationSet, appNames []string) bool {
+	for _, appName := range appNames {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 || applicationSet.Status.ApplicationStatus[idx].Status != argov1alpha1.ProgressiveSyncHealthy {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateApplicationSetRolloutAnalysis evaluates the analysis gates of the first step which is Healthy but has not
+// yet passed its analysis, and records the result on the status of every Application of that step.
+// It returns the time after which the ApplicationSet should be reconciled again to continue a running analysis.
+func (m *Manager) UpdateApplicationSetRolloutAnalysis(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string) (time.Duration, error) {
+	if !RollingSyncStrategyEnabled(applicationSet) {
+		return 0, nil
+	}
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
+
+	for stepIndex, appNames := range appDependencyList {
+		if len(appNames) == 0 || stepIndex >= len(steps) {
+			continue
+		}
+		if !isStepHealthy(applicationSet, appNames) {
+			// the rollout has not progressed past this step yet
+			return 0, nil
+		}
+		step := steps[stepIndex]
+		stepStatus := getStepAnalysisStatus(applicationSet, appNames)
+		if isStepAnalysisSuccessful(step, stepStatus) {
+			continue
+		}
+		if getFailedStepAnalysis(stepStatus) != nil {
+			// a failed gate halts the rollout until the Applications of the step roll out a new revision
+			return 0, nil
+		}
+
+		stepLogCtx := logCtx.WithField("step", stepIndex+1)
+		newStepStatus, requeueAfter := m.runStepAnalysis(ctx, stepLogCtx, step, stepStatus)
+		if reflect.DeepEqual(newStepStatus, stepStatus) {
+			return requeueAfter, nil
+		}
+
+		appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
+		for _, appStatus := range applicationSet.Status.ApplicationStatus {
+			if appStatus.Step == strconv.Itoa(stepIndex+1) {
+				appStatus.Analysis = newStepStatus
+			}
+			appStatuses = append(appStatuses, appStatus)
+		}
+		if err := m.dependencies.SetAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses); err != nil {
+			return 0, fmt.Errorf("failed to set AppSet application statuses: %w", err)
+		}
+		return requeueAfter, nil
+	}
+	return 0, nil
+}
+
+// runStepAnalysis evaluates the analysis gates of a step in order, stopping at the first gate which has not passed.
+func (m *Manager) runStepAnalysis(ctx context.Context, logCtx *log.Entry, step argov1alpha1.ApplicationSetRolloutStep, stepStatus []argov1alpha1.ApplicationSetRolloutAnalysisStatus) ([]argov1alpha1.ApplicationSetRolloutAnalysisStatus, time.Duration) {
+	now := metav1.Now()
+	newStepStatus := make([]argov1alpha1.ApplicationSetRolloutAnalysisStatus, len(step.Analysis))
+	for i, gate := range step.Analysis {
+		name := analysisGateName(gate, i)
+		if i < len(stepStatus) && stepStatus[i].Name == name {
+			newStepStatus[i] = *stepStatus[i].DeepCopy()
+		} else {
+			newStepStatus[i] = argov1alpha1.ApplicationSetRolloutAnalysisStatus{Name: name}
+		}
+	}
+
+	for i, gate := range step.Analysis {
+		gateStatus := &newStepStatus[i]
+		if gateStatus.Phase == argov1alpha1.ApplicationSetRolloutAnalysisSuccessful {
+			continue
+		}
+		if gateStatus.Phase == "" {
+			gateStatus.Phase = argov1alpha1.ApplicationSetRolloutAnalysisRunning
+			gateStatus.StartedAt = &now
+		}
+
+		gateLogCtx := logCtx.WithField("analysis", gateStatus.Name)
+		passed, requeueAfter, message, err := m.runAnalysisGate(ctx, gate, gateStatus, now)
+		switch {
+		case err != nil:
+			gateLogCtx.WithError(err).Warn("Rollout analysis gate failed, halting rollout")
+			gateStatus.Phase = argov1alpha1.ApplicationSetRolloutAnalysisFailed
+			gateStatus.Message = err.Error()
+			gateStatus.FinishedAt = &now
+		case passed:
+			gateLogCtx.Info("Rollout analysis gate passed")
+			gateStatus.Phase = argov1alpha1.ApplicationSetRolloutAnalysisSuccessful
+			gateStatus.Message = message
+			gateStatus.FinishedAt = &now
+			continue
+		default:
+			gateStatus.Message = message
+		}
+		return newStepStatus, requeueAfter
+	}
+	return newStepStatus, 0
+}
+
+// runAnalysisGate evaluates a single analysis gate. A gate which has not passed yet but may still pass returns a
+// positive requeue duration, whereas a gate which failed returns an error. Prometheus and HTTP gates are evaluated at
+// most once per interval, and the number of consecutive successful and failed evaluations is recorded in gateStatus.
+func (m *Manager) runAnalysisGate(ctx context.Context, gate argov1alpha1.ApplicationSetRolloutAnalysis, gateStatus *argov1alpha1.ApplicationSetRolloutAnalysisStatus, now metav1.Time) (bool, time.Duration, string, error) {
+	configured := 0
+	for _, set := range []bool{gate.Prometheus != nil, gate.HTTP != nil, gate.Pause != nil} {
+		if set {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return false, 0, "", errors.New("exactly one of prometheus, http or pause must be set")
+	}
+
+	if gate.Pause != nil {
+		duration, err := gate.Pause.GetDuration()
+		if err != nil {
+			return false, 0, "", fmt.Errorf("invalid pause duration: %w", err)
+		}
+		if remaining := duration - now.Sub(gateStatus.StartedAt.Time); remaining > 0 {
+			return false, remaining, fmt.Sprintf("Paused for %s", duration), nil
+		}
+		return true, 0, fmt.Sprintf("Paused for %s", duration), nil
+	}
+
+	interval, err := gate.GetInterval()
+	if err != nil {
+		return false, 0, "", fmt.Errorf("invalid interval: %w", err)
+	}
+	if gateStatus.LastEvaluatedAt != nil {
+		if remaining := interval - now.Sub(gateStatus.LastEvaluatedAt.Time); remaining > 0 {
+			return false, remaining, gateStatus.Message, nil
+		}
+	}
+
+	var description string
+	if gate.Prometheus != nil {
+		description = "Prometheus query returned a successful result"
+		err = m.runPrometheusAnalysis(ctx, gate.Prometheus)
+	} else {
+		description = "HTTP request returned a successful status code"
+		err = m.runHTTPAnalysis(ctx, gate.HTTP)
+	}
+	gateStatus.LastEvaluatedAt = &now
+
+	var configErr *analysisConfigError
+	if errors.As(err, &configErr) {
+		return false, 0, "", err
+	}
+	if err != nil {
+		gateStatus.Successes = 0
+		gateStatus.Failures++
+		if gateStatus.Failures > gate.FailureLimit {
+			if gate.FailureLimit > 0 {
+				return false, 0, "", fmt.Errorf("%d consecutive evaluations failed: %w", gateStatus.Failures, err)
+			}
+			return false, 0, "", err
+		}
+		return false, interval, fmt.Sprintf("Evaluation failed (%d of %d tolerated failures): %v", gateStatus.Failures, gate.FailureLimit, err), nil
+	}
+
+	gateStatus.Failures = 0
+	gateStatus.Successes++
+	count := gate.GetCount()
+	if gateStatus.Successes >= count {
+		return true, 0, description, nil
+	}
+	return false, interval, fmt.Sprintf("%s (%d of %d evaluations passed)", description, gateStatus.Successes, count), nil
+}
+
+type prometheusQueryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type prometheusSample struct {
+	Value [2]any `json:"value"`
+}
+
+// runPrometheusAnalysis runs an instant query and returns an error unless the result is non-empty and none of its
+// values is zero
+func (m *Manager) runPrometheusAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetRolloutPrometheusAnalysis) error {
+	address, err := url.Parse(analysis.Address)
+	if err != nil {
+		return newAnalysisConfigError("invalid prometheus address %q: %w", analysis.Address, err)
+	}
+	queryURL := address.JoinPath("api/v1/query")
+	queryURL.RawQuery = url.Values{"query": {analysis.Query}}.Encode()
+	if err := m.checkAnalysisURL(queryURL); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL.String(), http.NoBody)
+	if err != nil {
+		return newAnalysisConfigError("error creating prometheus request: %w", err)
+	}
+	resp, err := m.analysisHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("error querying prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var queryResp prometheusQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&queryResp); err != nil {
+		return fmt.Errorf("error decoding prometheus response with status code %d: %w", resp.StatusCode, err)
+	}
+	if queryResp.Status != "success" {
+		return fmt.Errorf("prometheus query failed: %s: %s", queryResp.ErrorType, queryResp.Error)
+	}
+
+	var values []any
+	switch queryResp.Data.ResultType {
+	case "vector":
+		var samples []prometheusSample
+		if err := json.Unmarshal(queryResp.Data.Result, &samples); err != nil {
+			return fmt.Errorf("error decoding prometheus vector result: %w", err)
+		}
+		for _, sample := range samples {
+			values = append(values, sample.Value[1])
+		}
+	case "scalar":
+		var sample [2]any
+		if err := json.Unmarshal(queryResp.Data.Result, &sample); err != nil {
+			return fmt.Errorf("error decoding prometheus scalar result: %w", err)
+		}
+		values = append(values, sample[1])
+	default:
+		return fmt.Errorf("unsupported prometheus result type %q", queryResp.Data.ResultType)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("prometheus query %q returned an empty result", analysis.Query)
+	}
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected prometheus sample value %v", value)
+		}
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected prometheus sample value %q: %w", str, err)
+		}
+		if f == 0 {
+			return fmt.Errorf("prometheus query %q returned a zero value", analysis.Query)
+		}
+	}
+	return nil
+}
+
+// runHTTPAnalysis sends a GET or HEAD request and returns an error unless the response has a 2xx status code
+func (m *Manager) runHTTPAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetRolloutHTTPAnalysis) error {
+	method := analysis.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodHead {
+		return newAnalysisConfigError("unsupported HTTP method %q, must be GET or HEAD", method)
+	}
+	u, err := url.Parse(analysis.URL)
+	if err != nil {
+		return newAnalysisConfigError("invalid URL %q: %w", analysis.URL, err)
+	}
+	if err := m.checkAnalysisURL(u); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), http.NoBody)
+	if err != nil {
+		return newAnalysisConfigError("error creating HTTP request: %w", err)
+	}
+	resp, err := m.analysisHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024*1024))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP request to %s returned status code %d", analysis.URL, resp.StatusCode)
+	}
+	return nil
+}
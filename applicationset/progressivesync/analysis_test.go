@@ -0,0 +1,434 @@
+package progressivesync
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// analysisDeps applies application statuses in-memory, so tests can assert on the persisted analysis results
+type analysisDeps struct{}
+
+func (analysisDeps) SetAppSetApplicationStatus(_ context.Context, _ *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
+	applicationSet.Status.ApplicationStatus = applicationStatuses
+	return nil
+}
+
+func (analysisDeps) SetApplicationSetStatusCondition(_ context.Context, _ *argov1alpha1.ApplicationSet, _ []argov1alpha1.ApplicationSetCondition, _ bool) error {
+	return nil
+}
+
+func analysisAppSet(analysis ...argov1alpha1.ApplicationSetRolloutAnalysis) *argov1alpha1.ApplicationSet {
+	return &argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: "argocd"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Strategy: &argov1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &argov1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []argov1alpha1.ApplicationSetRolloutStep{{Analysis: analysis}, {}},
+				},
+			},
+		},
+		Status: argov1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []argov1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-canary-1", Status: argov1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app-canary-2", Status: argov1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app-prod", Status: argov1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			},
+		},
+	}
+}
+
+var analysisDependencyList = [][]string{{"app-canary-1", "app-canary-2"}, {"app-prod"}}
+
+func TestUpdateApplicationSetRolloutAnalysis(t *testing.T) {
+	prometheus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		switch r.URL.Query().Get("query") {
+		case "passing":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.5"]}]}}`))
+		case "zero":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0"]}]}}`))
+		case "empty":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+		}
+	}))
+	defer prometheus.Close()
+
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthy" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer endpoint.Close()
+
+	testCases := []struct {
+		name          string
+		analysis      []argov1alpha1.ApplicationSetRolloutAnalysis
+		expectedPhase []argov1alpha1.ApplicationSetRolloutAnalysisPhase
+		expectRequeue bool
+	}{
+		{
+			name: "passing prometheus query",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{Name: "error-rate", Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: prometheus.URL, Query: "passing"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisSuccessful},
+		},
+		{
+			name: "prometheus query with a zero value",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{Name: "error-rate", Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: prometheus.URL, Query: "zero"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisFailed},
+		},
+		{
+			name: "prometheus query with an empty result",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{Name: "error-rate", Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: prometheus.URL, Query: "empty"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisFailed},
+		},
+		{
+			name: "invalid prometheus query",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{Name: "error-rate", Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: prometheus.URL, Query: "invalid"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisFailed},
+		},
+		{
+			name: "passing http check",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: endpoint.URL + "/healthy"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisSuccessful},
+		},
+		{
+			name: "failing http check halts the following gates",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: endpoint.URL + "/unhealthy"}},
+				{Pause: &argov1alpha1.ApplicationSetRolloutPauseAnalysis{Duration: "0"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisFailed, ""},
+		},
+		{
+			name: "running pause",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{Name: "bake", Pause: &argov1alpha1.ApplicationSetRolloutPauseAnalysis{Duration: "10m"}},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisRunning},
+			expectRequeue: true,
+		},
+		{
+			name: "gate without a check",
+			analysis: []argov1alpha1.ApplicationSetRolloutAnalysis{
+				{Name: "empty"},
+			},
+			expectedPhase: []argov1alpha1.ApplicationSetRolloutAnalysisPhase{argov1alpha1.ApplicationSetRolloutAnalysisFailed},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appSet := analysisAppSet(tc.analysis...)
+			m := NewManager(nil, nil, analysisDeps{})
+
+			requeueAfter, err := m.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+			require.NoError(t, err)
+			if tc.expectRequeue {
+				assert.Positive(t, requeueAfter)
+			} else {
+				assert.Zero(t, requeueAfter)
+			}
+
+			stepStatus := getStepAnalysisStatus(appSet, analysisDependencyList[0])
+			require.Len(t, stepStatus, len(tc.expectedPhase))
+			for i, phase := range tc.expectedPhase {
+				assert.Equal(t, phase, stepStatus[i].Phase)
+			}
+			assert.Empty(t, appSet.Status.ApplicationStatus[2].Analysis)
+
+			appsToSync := getAppsToSync(*appSet, analysisDependencyList, []argov1alpha1.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "app-canary-1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app-canary-2"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app-prod"}},
+			})
+			assert.Equal(t, isStepAnalysisSuccessful(appSet.Spec.Strategy.RollingSync.Steps[0], stepStatus), appsToSync["app-prod"])
+		})
+	}
+}
+
+func TestUpdateApplicationSetRolloutAnalysisPauseCompleted(t *testing.T) {
+	appSet := analysisAppSet(argov1alpha1.ApplicationSetRolloutAnalysis{Name: "bake", Pause: &argov1alpha1.ApplicationSetRolloutPauseAnalysis{Duration: "1m"}})
+	startedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	for i := range 2 {
+		appSet.Status.ApplicationStatus[i].Analysis = []argov1alpha1.ApplicationSetRolloutAnalysisStatus{
+			{Name: "bake", Phase: argov1alpha1.ApplicationSetRolloutAnalysisRunning, StartedAt: &startedAt},
+		}
+	}
+	m := NewManager(nil, nil, analysisDeps{})
+
+	requeueAfter, err := m.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	stepStatus := getStepAnalysisStatus(appSet, analysisDependencyList[0])
+	require.Len(t, stepStatus, 1)
+	assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisSuccessful, stepStatus[0].Phase)
+	assert.Equal(t, startedAt.Unix(), stepStatus[0].StartedAt.Unix())
+}
+
+func TestUpdateApplicationSetRolloutAnalysisStepNotHealthy(t *testing.T) {
+	appSet := analysisAppSet(argov1alpha1.ApplicationSetRolloutAnalysis{Name: "bake", Pause: &argov1alpha1.ApplicationSetRolloutPauseAnalysis{Duration: "1m"}})
+	appSet.Status.ApplicationStatus[1].Status = argov1alpha1.ProgressiveSyncProgressing
+	m := NewManager(nil, nil, analysisDeps{})
+
+	requeueAfter, err := m.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	for _, appStatus := range appSet.Status.ApplicationStatus {
+		assert.Empty(t, appStatus.Analysis)
+	}
+}
+
+func TestGetProgressingConditionAnalysisFailed(t *testing.T) {
+	appSet := analysisAppSet(argov1alpha1.ApplicationSetRolloutAnalysis{Name: "error-rate", Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{}})
+	for i := range 2 {
+		appSet.Status.ApplicationStatus[i].Analysis = []argov1alpha1.ApplicationSetRolloutAnalysisStatus{
+			{Name: "error-rate", Phase: argov1alpha1.ApplicationSetRolloutAnalysisFailed, Message: "query returned a zero value"},
+		}
+	}
+	m := NewManager(nil, nil, analysisDeps{})
+
+	condition := m.getProgressingCondition(appSet)
+	require.NotNil(t, condition)
+	assert.Equal(t, argov1alpha1.ApplicationSetConditionStatusFalse, condition.Status)
+	assert.Equal(t, argov1alpha1.ApplicationSetReasonRolloutAnalysisFailed, condition.Reason)
+	assert.Equal(t, "ApplicationSet rollout is halted at step 1: analysis error-rate failed: query returned a zero value", condition.Message)
+}
+
+func TestUpdateApplicationSetRolloutAnalysisRetries(t *testing.T) {
+	healthy := false
+	requests := 0
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if healthy {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer endpoint.Close()
+
+	appSet := analysisAppSet(argov1alpha1.ApplicationSetRolloutAnalysis{
+		Name:         "smoke-test",
+		HTTP:         &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: endpoint.URL},
+		Interval:     "1m",
+		Count:        2,
+		FailureLimit: 1,
+	})
+	m := NewManager(nil, nil, analysisDeps{})
+	evaluate := func(t *testing.T) (time.Duration, argov1alpha1.ApplicationSetRolloutAnalysisStatus) {
+		t.Helper()
+		requeueAfter, err := m.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+		require.NoError(t, err)
+		stepStatus := getStepAnalysisStatus(appSet, analysisDependencyList[0])
+		require.Len(t, stepStatus, 1)
+		return requeueAfter, stepStatus[0]
+	}
+	// moves the last evaluation of the gate back by one interval, so the next reconciliation evaluates it again
+	elapseInterval := func() {
+		for i := range 2 {
+			lastEvaluatedAt := metav1.NewTime(appSet.Status.ApplicationStatus[i].Analysis[0].LastEvaluatedAt.Add(-time.Minute))
+			appSet.Status.ApplicationStatus[i].Analysis[0].LastEvaluatedAt = &lastEvaluatedAt
+		}
+	}
+
+	requeueAfter, gateStatus := evaluate(t)
+	assert.Equal(t, time.Minute, requeueAfter)
+	assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisRunning, gateStatus.Phase)
+	assert.Equal(t, int64(1), gateStatus.Failures)
+	assert.Contains(t, gateStatus.Message, "1 of 1 tolerated failures")
+
+	t.Run("gate is not evaluated again before the interval elapsed", func(t *testing.T) {
+		requeueAfter, gateStatus := evaluate(t)
+		assert.Positive(t, requeueAfter)
+		assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisRunning, gateStatus.Phase)
+		assert.Equal(t, 1, requests)
+	})
+	t.Run("successful evaluations reset the failures", func(t *testing.T) {
+		healthy = true
+		elapseInterval()
+		requeueAfter, gateStatus := evaluate(t)
+		assert.Equal(t, time.Minute, requeueAfter)
+		assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisRunning, gateStatus.Phase)
+		assert.Equal(t, int64(0), gateStatus.Failures)
+		assert.Equal(t, int64(1), gateStatus.Successes)
+	})
+	t.Run("failed evaluations reset the successes", func(t *testing.T) {
+		healthy = false
+		elapseInterval()
+		_, gateStatus := evaluate(t)
+		assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisRunning, gateStatus.Phase)
+		assert.Equal(t, int64(1), gateStatus.Failures)
+		assert.Equal(t, int64(0), gateStatus.Successes)
+	})
+	t.Run("gate fails once the failure limit is exceeded", func(t *testing.T) {
+		elapseInterval()
+		requeueAfter, gateStatus := evaluate(t)
+		assert.Zero(t, requeueAfter)
+		assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisFailed, gateStatus.Phase)
+		assert.Contains(t, gateStatus.Message, "2 consecutive evaluations failed")
+		assert.Equal(t, 4, requests)
+	})
+}
+
+func TestUpdateApplicationSetRolloutAnalysisPassesAfterCount(t *testing.T) {
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer endpoint.Close()
+
+	appSet := analysisAppSet(argov1alpha1.ApplicationSetRolloutAnalysis{
+		Name:  "smoke-test",
+		HTTP:  &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: endpoint.URL, Method: http.MethodHead},
+		Count: 2,
+	})
+	m := NewManager(nil, nil, analysisDeps{})
+
+	requeueAfter, err := m.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+	require.NoError(t, err)
+	assert.Equal(t, argov1alpha1.DefaultApplicationSetRolloutAnalysisInterval, requeueAfter)
+	assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisRunning, appSet.Status.ApplicationStatus[0].Analysis[0].Phase)
+
+	lastEvaluatedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	for i := range 2 {
+		appSet.Status.ApplicationStatus[i].Analysis[0].LastEvaluatedAt = &lastEvaluatedAt
+	}
+	requeueAfter, err = m.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisSuccessful, appSet.Status.ApplicationStatus[0].Analysis[0].Phase)
+	assert.Equal(t, int64(2), appSet.Status.ApplicationStatus[0].Analysis[0].Successes)
+}
+
+func TestUpdateApplicationSetRolloutAnalysisMisconfiguredGateIsNotRetried(t *testing.T) {
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer endpoint.Close()
+
+	testCases := []struct {
+		name     string
+		manager  *Manager
+		analysis argov1alpha1.ApplicationSetRolloutAnalysis
+		message  string
+	}{
+		{
+			name:     "unsupported method",
+			manager:  NewManager(nil, nil, analysisDeps{}),
+			analysis: argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: endpoint.URL, Method: http.MethodPost}},
+			message:  `unsupported HTTP method "POST", must be GET or HEAD`,
+		},
+		{
+			name:     "unsupported scheme",
+			manager:  NewManager(nil, nil, analysisDeps{}),
+			analysis: argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: "file:///etc/passwd"}},
+			message:  `unsupported URL scheme "file"`,
+		},
+		{
+			name:     "disallowed prometheus address",
+			manager:  &Manager{dependencies: analysisDeps{}, RestrictAnalysisURLs: true, AnalysisAllowedURLs: []string{"http://prometheus.monitoring.svc:9090"}},
+			analysis: argov1alpha1.ApplicationSetRolloutAnalysis{Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: endpoint.URL, Query: "up"}},
+			message:  "is not allowed for analysis gates",
+		},
+		{
+			name:     "analysis URLs restricted without allowed URLs",
+			manager:  &Manager{dependencies: analysisDeps{}, RestrictAnalysisURLs: true},
+			analysis: argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: endpoint.URL}},
+			message:  "is not allowed for analysis gates",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.analysis.FailureLimit = 3
+			appSet := analysisAppSet(tc.analysis)
+
+			requeueAfter, err := tc.manager.UpdateApplicationSetRolloutAnalysis(t.Context(), log.NewEntry(log.StandardLogger()), appSet, analysisDependencyList)
+			require.NoError(t, err)
+			assert.Zero(t, requeueAfter)
+			gateStatus := appSet.Status.ApplicationStatus[0].Analysis[0]
+			assert.Equal(t, argov1alpha1.ApplicationSetRolloutAnalysisFailed, gateStatus.Phase)
+			assert.Contains(t, gateStatus.Message, tc.message)
+		})
+	}
+}
+
+func TestCheckAnalysisURL(t *testing.T) {
+	m := &Manager{
+		RestrictAnalysisURLs: true,
+		AnalysisAllowedURLs:  []string{"http://prometheus.monitoring.svc:9090", "https://checks.example.com/canary/"},
+	}
+	testCases := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "http://prometheus.monitoring.svc:9090/api/v1/query?query=up", allowed: true},
+		{url: "http://PROMETHEUS.monitoring.svc:9090/api/v1/query", allowed: true},
+		{url: "https://prometheus.monitoring.svc:9090/api/v1/query", allowed: false},
+		{url: "http://prometheus.monitoring.svc/api/v1/query", allowed: false},
+		{url: "https://checks.example.com/canary", allowed: true},
+		{url: "https://checks.example.com/canary/smoke", allowed: true},
+		{url: "https://checks.example.com/canary-admin", allowed: false},
+		{url: "https://checks.example.com/canary/../admin", allowed: false},
+		{url: "https://checks.example.com.evil.com/canary/smoke", allowed: false},
+		{url: "http://169.254.169.254/latest/meta-data", allowed: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			require.NoError(t, err)
+			err = m.checkAnalysisURL(u)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "is not allowed for analysis gates")
+			}
+		})
+	}
+
+	t.Run("all URLs are allowed unless restricted", func(t *testing.T) {
+		u, err := url.Parse("http://169.254.169.254/latest/meta-data")
+		require.NoError(t, err)
+		require.NoError(t, (&Manager{}).checkAnalysisURL(u))
+	})
+}
+
+func TestRunHTTPAnalysisDoesNotFollowRedirectsToDisallowedURLs(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer internal.Close()
+	allowed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer allowed.Close()
+
+	m := &Manager{RestrictAnalysisURLs: true, AnalysisAllowedURLs: []string{allowed.URL}}
+	err := m.runHTTPAnalysis(t.Context(), &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: allowed.URL})
+	require.ErrorContains(t, err, "is not allowed for analysis gates")
+}
@@ -0,0 +1,78 @@
+package progressivesync
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// GetStepApplications returns the names of the Applications which belong to the given 1-based step, according to
+// the ApplicationSet status
+func GetStepApplications(applicationSet *argov1alpha1.ApplicationSet, step int) []string {
+	var appNames []string
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if appStatus.Step == strconv.Itoa(step) {
+			appNames = append(appNames, appStatus.Application)
+		}
+	}
+	return appNames
+}
+
+// getStepApproval returns the approval decision shared by the Applications of a step. The decision is written to
+// all Applications of the step, so it only differs when an Application was added to the step or started rolling out
+// a new revision, in which case the step needs to be approved again.
+func getStepApproval(applicationSet *argov1alpha1.ApplicationSet, appNames []string) *argov1alpha1.ApplicationSetRolloutApprovalStatus {
+	var approval *argov1alpha1.ApplicationSetRolloutApprovalStatus
+	for i, appName := range appNames {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			return nil
+		}
+		appApproval := applicationSet.Status.ApplicationStatus[idx].Approval
+		if i == 0 {
+			approval = appApproval
+		} else if !reflect.DeepEqual(approval, appApproval) {
+			return nil
+		}
+	}
+	return approval
+}
+
+// isStepAwaitingApproval returns true if the step requires manual approval, has Applications left to roll out and
+// no decision was taken yet
+func isStepAwaitingApproval(applicationSet *argov1alpha1.ApplicationSet, stepIndex int, appNames []string) bool {
+	if !RollingSyncStrategyEnabled(applicationSet) || stepIndex >= len(applicationSet.Spec.Strategy.RollingSync.Steps) {
+		return false
+	}
+	if !applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex].ManualApproval || len(appNames) == 0 {
+		return false
+	}
+	return !isStepHealthy(applicationSet, appNames) && getStepApproval(applicationSet, appNames) == nil
+}
+
+// isStepAborted returns true if the step requires manual approval, has Applications left to roll out and was aborted
+func isStepAborted(applicationSet *argov1alpha1.ApplicationSet, stepIndex int, appNames []string) bool {
+	if !RollingSyncStrategyEnabled(applicationSet) || stepIndex >= len(applicationSet.Spec.Strategy.RollingSync.Steps) {
+		return false
+	}
+	if !applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex].ManualApproval || len(appNames) == 0 {
+		return false
+	}
+	approval := getStepApproval(applicationSet, appNames)
+	return !isStepHealthy(applicationSet, appNames) && approval != nil && approval.Phase == argov1alpha1.ApplicationSetRolloutAborted
+}
+
+// GetStepAwaitingApproval returns the first 1-based step which is waiting for manual approval, or 0 if there is none
+func GetStepAwaitingApproval(applicationSet *argov1alpha1.ApplicationSet) int {
+	if !RollingSyncStrategyEnabled(applicationSet) {
+		return 0
+	}
+	for i := range applicationSet.Spec.Strategy.RollingSync.Steps {
+		if isStepAwaitingApproval(applicationSet, i, GetStepApplications(applicationSet, i+1)) {
+			return i + 1
+		}
+	}
+	return 0
+}
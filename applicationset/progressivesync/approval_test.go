@@ -0,0 +1,110 @@
+package progressivesync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func approvalAppSet(approval *argov1alpha1.ApplicationSetRolloutApprovalStatus) *argov1alpha1.ApplicationSet {
+	return &argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "waves", Namespace: "argocd"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Strategy: &argov1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &argov1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []argov1alpha1.ApplicationSetRolloutStep{{}, {ManualApproval: true}},
+				},
+			},
+		},
+		Status: argov1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []argov1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-staging", Status: argov1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app-prod-1", Status: argov1alpha1.ProgressiveSyncWaiting, Step: "2", Approval: approval},
+				{Application: "app-prod-2", Status: argov1alpha1.ProgressiveSyncWaiting, Step: "2", Approval: approval},
+			},
+		},
+	}
+}
+
+var approvalDependencyList = [][]string{{"app-staging"}, {"app-prod-1", "app-prod-2"}}
+
+var approvalApplications = []argov1alpha1.Application{
+	{ObjectMeta: metav1.ObjectMeta{Name: "app-staging"}},
+	{ObjectMeta: metav1.ObjectMeta{Name: "app-prod-1"}},
+	{ObjectMeta: metav1.ObjectMeta{Name: "app-prod-2"}},
+}
+
+func TestGetAppsToSyncManualApproval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		approval *argov1alpha1.ApplicationSetRolloutApprovalStatus
+		expected map[string]bool
+	}{
+		{
+			name:     "step waiting for approval is not synced",
+			expected: map[string]bool{"app-staging": true},
+		},
+		{
+			name:     "approved step is synced",
+			approval: &argov1alpha1.ApplicationSetRolloutApprovalStatus{Phase: argov1alpha1.ApplicationSetRolloutApproved, User: "admin"},
+			expected: map[string]bool{"app-staging": true, "app-prod-1": true, "app-prod-2": true},
+		},
+		{
+			name:     "aborted step is not synced",
+			approval: &argov1alpha1.ApplicationSetRolloutApprovalStatus{Phase: argov1alpha1.ApplicationSetRolloutAborted, User: "admin"},
+			expected: map[string]bool{"app-staging": true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appSet := approvalAppSet(tc.approval)
+			assert.Equal(t, tc.expected, getAppsToSync(*appSet, approvalDependencyList, approvalApplications))
+		})
+	}
+}
+
+func TestGetAppsToSyncManualApprovalPartiallyApproved(t *testing.T) {
+	// an Application which joined the step after it was promoted requires the step to be approved again
+	appSet := approvalAppSet(&argov1alpha1.ApplicationSetRolloutApprovalStatus{Phase: argov1alpha1.ApplicationSetRolloutApproved, User: "admin"})
+	appSet.Status.ApplicationStatus[2].Approval = nil
+
+	assert.Equal(t, map[string]bool{"app-staging": true}, getAppsToSync(*appSet, approvalDependencyList, approvalApplications))
+	assert.Equal(t, 2, GetStepAwaitingApproval(appSet))
+}
+
+func TestGetStepAwaitingApproval(t *testing.T) {
+	assert.Equal(t, 2, GetStepAwaitingApproval(approvalAppSet(nil)))
+	assert.Equal(t, 0, GetStepAwaitingApproval(approvalAppSet(&argov1alpha1.ApplicationSetRolloutApprovalStatus{Phase: argov1alpha1.ApplicationSetRolloutApproved})))
+
+	healthy := approvalAppSet(nil)
+	for i := range healthy.Status.ApplicationStatus {
+		healthy.Status.ApplicationStatus[i].Status = argov1alpha1.ProgressiveSyncHealthy
+	}
+	assert.Equal(t, 0, GetStepAwaitingApproval(healthy))
+}
+
+func TestGetProgressingConditionManualApproval(t *testing.T) {
+	m := NewManager(nil, nil, analysisDeps{})
+
+	t.Run("awaiting approval", func(t *testing.T) {
+		condition := m.getProgressingCondition(approvalAppSet(nil))
+		require.NotNil(t, condition)
+		assert.Equal(t, argov1alpha1.ApplicationSetConditionStatusTrue, condition.Status)
+		assert.Equal(t, argov1alpha1.ApplicationSetReasonRolloutAwaitingApproval, condition.Reason)
+		assert.Equal(t, "ApplicationSet rollout is waiting for step 2 to be promoted", condition.Message)
+	})
+
+	t.Run("aborted", func(t *testing.T) {
+		condition := m.getProgressingCondition(approvalAppSet(&argov1alpha1.ApplicationSetRolloutApprovalStatus{Phase: argov1alpha1.ApplicationSetRolloutAborted, User: "admin"}))
+		require.NotNil(t, condition)
+		assert.Equal(t, argov1alpha1.ApplicationSetConditionStatusFalse, condition.Status)
+		assert.Equal(t, argov1alpha1.ApplicationSetReasonRolloutAborted, condition.Reason)
+		assert.Equal(t, "ApplicationSet rollout was aborted at step 2 by admin", condition.Message)
+	})
+}
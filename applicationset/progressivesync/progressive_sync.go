@@ -67,7 +67,13 @@ type Manager struct {
 	// event that was never delivered. Required: with no uncached reader the cache cannot be verified,
 	// so past the threshold reverse deletion errors rather than trusting it. Production supplies
 	// mgr.GetAPIReader().
-	APIReader        client.Reader
+	APIReader client.Reader
+	// RestrictAnalysisURLs limits the URLs requested by Prometheus and HTTP analysis gates to AnalysisAllowedURLs.
+	// An empty AnalysisAllowedURLs then disables Prometheus and HTTP analysis gates.
+	RestrictAnalysisURLs bool
+	// AnalysisAllowedURLs are the URL prefixes which Prometheus and HTTP analysis gates may request
+	AnalysisAllowedURLs []string
+
 	dependencies     Dependencies
 	validationIssues *ValidationIssues // collected during progressive sync execution
 }
@@ -103,7 +109,10 @@ func (m *Manager) applicationGoneFromAPIServer(ctx context.Context, namespace, n
 	}
 }
 
-func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, error) {
+// PerformProgressiveSyncs updates the progressive sync status of the ApplicationSet and returns the Applications
+// which are allowed to sync, along with the time after which the ApplicationSet should be reconciled again to
+// continue running the analysis gates of a rollout step.
+func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, time.Duration, error) {
 	// Initialize validation tracking
 	m.validationIssues = &ValidationIssues{}
 
@@ -116,7 +125,12 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 
 	_, err := m.UpdateApplicationSetApplicationStatus(ctx, logCtx, &appset, applications, desiredApplications, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset app status: %w", err)
+	}
+
+	requeueAfter, err := m.UpdateApplicationSetRolloutAnalysis(ctx, logCtx, &appset, appDependencyList)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to update applicationset rollout analysis: %w", err)
 	}
 
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
@@ -129,7 +143,7 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 
 	_, err = m.UpdateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appsToSync, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset application status progress: %w", err)
 	}
 
 	progressingCondition := m.getProgressingCondition(&appset)
@@ -137,7 +151,7 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 	conditions := []*argov1alpha1.ApplicationSetCondition{invalidConfigCondition, progressingCondition}
 	_ = m.updateApplicationSetApplicationStatusConditions(ctx, &appset, conditions)
 
-	return appsToSync, nil
+	return appsToSync, requeueAfter, nil
 }
 
 func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, currentApps []argov1alpha1.Application) (time.Duration, error) {
@@ -480,6 +494,9 @@ func (m *Manager) UpdateApplicationSetApplicationStatus(ctx context.Context, log
 			}
 			newAppStatus.Status = argov1alpha1.ProgressiveSyncWaiting
 			newAppStatus.LastTransitionTime = &now
+			// the analysis of the step starts over for the new rollout
+			newAppStatus.Analysis = nil
+			newAppStatus.Approval = nil
 		}
 
 		if newAppStatus.Status == argov1alpha1.ProgressiveSyncWaiting {
@@ -567,6 +584,11 @@ func getAppsToSync(applicationSet argov1alpha1.ApplicationSet, appDependencyList
 	}
 
 	for stepIndex := range appDependencyList {
+		// a step which requires manual approval is only synced once it has been promoted
+		if isStepAwaitingApproval(&applicationSet, stepIndex, appDependencyList[stepIndex]) || isStepAborted(&applicationSet, stepIndex, appDependencyList[stepIndex]) {
+			break
+		}
+
 		// set the syncEnabled boolean for every Application in the current step
 		for _, appName := range appDependencyList[stepIndex] {
 			appSyncMap[appName] = true
@@ -596,6 +618,11 @@ func getAppsToSync(applicationSet argov1alpha1.ApplicationSet, appDependencyList
 				break
 			}
 		}
+		if syncNextWave && RollingSyncStrategyEnabled(&applicationSet) && stepIndex < len(applicationSet.Spec.Strategy.RollingSync.Steps) {
+			// all applications in this wave are healthy, but its analysis gates must pass before proceeding to the next wave
+			stepStatus := getStepAnalysisStatus(&applicationSet, appDependencyList[stepIndex])
+			syncNextWave = isStepAnalysisSuccessful(applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex], stepStatus)
+		}
 		if !syncNextWave {
 			break
 		}
@@ -758,6 +785,36 @@ func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.Applicati
 			// Step has no applications, so it is completed
 			continue
 		}
+		appNames := GetStepApplications(applicationSet, i+1)
+		if isStepAborted(applicationSet, i, appNames) {
+			approval := getStepApproval(applicationSet, appNames)
+			return &argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+				Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+				Message: fmt.Sprintf("ApplicationSet rollout was aborted at step %s by %s", step, approval.User),
+				Reason:  argov1alpha1.ApplicationSetReasonRolloutAborted,
+			}
+		}
+		if isStepAwaitingApproval(applicationSet, i, appNames) {
+			return &argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+				Message: "ApplicationSet rollout is waiting for step " + step + " to be promoted",
+				Reason:  argov1alpha1.ApplicationSetReasonRolloutAwaitingApproval,
+			}
+		}
+		if isCompleted && len(applicationSet.Spec.Strategy.RollingSync.Steps[i].Analysis) > 0 {
+			stepStatus := getStepAnalysisStatus(applicationSet, appNames)
+			if failed := getFailedStepAnalysis(stepStatus); failed != nil {
+				return &argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+					Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+					Message: fmt.Sprintf("ApplicationSet rollout is halted at step %s: analysis %s failed: %s", step, failed.Name, failed.Message),
+					Reason:  argov1alpha1.ApplicationSetReasonRolloutAnalysisFailed,
+				}
+			}
+			isCompleted = isStepAnalysisSuccessful(applicationSet.Spec.Strategy.RollingSync.Steps[i], stepStatus)
+		}
 		if !isCompleted {
 			isProgressing = true
 			progressingStep = step
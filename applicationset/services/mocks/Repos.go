@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -227,3 +228,551 @@ func (_c *Repos_GetFiles_Call) RunAndReturn(run func(ctx context.Context, repoUR
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetGitTags provides a mock function for the type Repos
+func (_mock *Repos) GetGitTags(ctx context.Context, repoURL string, project string, tags []string) ([]*apiclient.GitTag, error) {
+	ret := _mock.Called(ctx, repoURL, project, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGitTags")
+	}
+
+	var r0 []*apiclient.GitTag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) ([]*apiclient.GitTag, error)); ok {
+		return returnFunc(ctx, repoURL, project, tags)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) []*apiclient.GitTag); ok {
+		r0 = returnFunc(ctx, repoURL, project, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*apiclient.GitTag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetGitTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGitTags'
+type Repos_GetGitTags_Call struct {
+	*mock.Call
+}
+
+// GetGitTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+//   - tags []string
+func (_e *Repos_Expecter) GetGitTags(ctx any, repoURL any, project any, tags any) *Repos_GetGitTags_Call {
+	return &Repos_GetGitTags_Call{Call: _e.mock.On("GetGitTags", ctx, repoURL, project, tags)}
+}
+
+func (_c *Repos_GetGitTags_Call) Run(run func(ctx context.Context, repoURL string, project string, tags []string)) *Repos_GetGitTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetGitTags_Call) Return(gitTags []*apiclient.GitTag, err error) *Repos_GetGitTags_Call {
+	_c.Call.Return(gitTags, err)
+	return _c
+}
+
+func (_c *Repos_GetGitTags_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string, tags []string) ([]*apiclient.GitTag, error)) *Repos_GetGitTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHelmChartVersions provides a mock function for the type Repos
+func (_mock *Repos) GetHelmChartVersions(ctx context.Context, repoURL string, project string, charts []string, noCache bool) ([]*apiclient.HelmChartVersion, error) {
+	ret := _mock.Called(ctx, repoURL, project, charts, noCache)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHelmChartVersions")
+	}
+
+	var r0 []*apiclient.HelmChartVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string, bool) ([]*apiclient.HelmChartVersion, error)); ok {
+		return returnFunc(ctx, repoURL, project, charts, noCache)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string, bool) []*apiclient.HelmChartVersion); ok {
+		r0 = returnFunc(ctx, repoURL, project, charts, noCache)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*apiclient.HelmChartVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string, bool) error); ok {
+		r1 = returnFunc(ctx, repoURL, project, charts, noCache)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetHelmChartVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHelmChartVersions'
+type Repos_GetHelmChartVersions_Call struct {
+	*mock.Call
+}
+
+// GetHelmChartVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+//   - charts []string
+//   - noCache bool
+func (_e *Repos_Expecter) GetHelmChartVersions(ctx any, repoURL any, project any, charts any, noCache any) *Repos_GetHelmChartVersions_Call {
+	return &Repos_GetHelmChartVersions_Call{Call: _e.mock.On("GetHelmChartVersions", ctx, repoURL, project, charts, noCache)}
+}
+
+func (_c *Repos_GetHelmChartVersions_Call) Run(run func(ctx context.Context, repoURL string, project string, charts []string, noCache bool)) *Repos_GetHelmChartVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetHelmChartVersions_Call) Return(helmChartVersions []*apiclient.HelmChartVersion, err error) *Repos_GetHelmChartVersions_Call {
+	_c.Call.Return(helmChartVersions, err)
+	return _c
+}
+
+func (_c *Repos_GetHelmChartVersions_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string, charts []string, noCache bool) ([]*apiclient.HelmChartVersion, error)) *Repos_GetHelmChartVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOCIArtifacts provides a mock function for the type Repos
+func (_mock *Repos) GetOCIArtifacts(ctx context.Context, repoURL string, project string, tags []string) ([]*apiclient.OCIArtifact, error) {
+	ret := _mock.Called(ctx, repoURL, project, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOCIArtifacts")
+	}
+
+	var r0 []*apiclient.OCIArtifact
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) ([]*apiclient.OCIArtifact, error)); ok {
+		return returnFunc(ctx, repoURL, project, tags)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) []*apiclient.OCIArtifact); ok {
+		r0 = returnFunc(ctx, repoURL, project, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*apiclient.OCIArtifact)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetOCIArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOCIArtifacts'
+type Repos_GetOCIArtifacts_Call struct {
+	*mock.Call
+}
+
+// GetOCIArtifacts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+//   - tags []string
+func (_e *Repos_Expecter) GetOCIArtifacts(ctx any, repoURL any, project any, tags any) *Repos_GetOCIArtifacts_Call {
+	return &Repos_GetOCIArtifacts_Call{Call: _e.mock.On("GetOCIArtifacts", ctx, repoURL, project, tags)}
+}
+
+func (_c *Repos_GetOCIArtifacts_Call) Run(run func(ctx context.Context, repoURL string, project string, tags []string)) *Repos_GetOCIArtifacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetOCIArtifacts_Call) Return(oCIArtifacts []*apiclient.OCIArtifact, err error) *Repos_GetOCIArtifacts_Call {
+	_c.Call.Return(oCIArtifacts, err)
+	return _c
+}
+
+func (_c *Repos_GetOCIArtifacts_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string, tags []string) ([]*apiclient.OCIArtifact, error)) *Repos_GetOCIArtifacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOCIRepositories provides a mock function for the type Repos
+func (_mock *Repos) GetOCIRepositories(ctx context.Context, repoURL string, project string) ([]string, error) {
+	ret := _mock.Called(ctx, repoURL, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOCIRepositories")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, repoURL, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, repoURL, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetOCIRepositories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOCIRepositories'
+type Repos_GetOCIRepositories_Call struct {
+	*mock.Call
+}
+
+// GetOCIRepositories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+func (_e *Repos_Expecter) GetOCIRepositories(ctx any, repoURL any, project any) *Repos_GetOCIRepositories_Call {
+	return &Repos_GetOCIRepositories_Call{Call: _e.mock.On("GetOCIRepositories", ctx, repoURL, project)}
+}
+
+func (_c *Repos_GetOCIRepositories_Call) Run(run func(ctx context.Context, repoURL string, project string)) *Repos_GetOCIRepositories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetOCIRepositories_Call) Return(strings []string, err error) *Repos_GetOCIRepositories_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Repos_GetOCIRepositories_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string) ([]string, error)) *Repos_GetOCIRepositories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOCITags provides a mock function for the type Repos
+func (_mock *Repos) GetOCITags(ctx context.Context, repoURL string, project string) ([]string, error) {
+	ret := _mock.Called(ctx, repoURL, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOCITags")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, repoURL, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, repoURL, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetOCITags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOCITags'
+type Repos_GetOCITags_Call struct {
+	*mock.Call
+}
+
+// GetOCITags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+func (_e *Repos_Expecter) GetOCITags(ctx any, repoURL any, project any) *Repos_GetOCITags_Call {
+	return &Repos_GetOCITags_Call{Call: _e.mock.On("GetOCITags", ctx, repoURL, project)}
+}
+
+func (_c *Repos_GetOCITags_Call) Run(run func(ctx context.Context, repoURL string, project string)) *Repos_GetOCITags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetOCITags_Call) Return(strings []string, err error) *Repos_GetOCITags_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Repos_GetOCITags_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string) ([]string, error)) *Repos_GetOCITags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisionMetadata provides a mock function for the type Repos
+func (_mock *Repos) GetRevisionMetadata(ctx context.Context, repoURL string, project string, revision string) (*v1alpha1.RevisionMetadata, error) {
+	ret := _mock.Called(ctx, repoURL, project, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionMetadata")
+	}
+
+	var r0 *v1alpha1.RevisionMetadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*v1alpha1.RevisionMetadata, error)); ok {
+		return returnFunc(ctx, repoURL, project, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *v1alpha1.RevisionMetadata); ok {
+		r0 = returnFunc(ctx, repoURL, project, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RevisionMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetRevisionMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionMetadata'
+type Repos_GetRevisionMetadata_Call struct {
+	*mock.Call
+}
+
+// GetRevisionMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+//   - revision string
+func (_e *Repos_Expecter) GetRevisionMetadata(ctx any, repoURL any, project any, revision any) *Repos_GetRevisionMetadata_Call {
+	return &Repos_GetRevisionMetadata_Call{Call: _e.mock.On("GetRevisionMetadata", ctx, repoURL, project, revision)}
+}
+
+func (_c *Repos_GetRevisionMetadata_Call) Run(run func(ctx context.Context, repoURL string, project string, revision string)) *Repos_GetRevisionMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetRevisionMetadata_Call) Return(revisionMetadata *v1alpha1.RevisionMetadata, err error) *Repos_GetRevisionMetadata_Call {
+	_c.Call.Return(revisionMetadata, err)
+	return _c
+}
+
+func (_c *Repos_GetRevisionMetadata_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string, revision string) (*v1alpha1.RevisionMetadata, error)) *Repos_GetRevisionMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGitTags provides a mock function for the type Repos
+func (_mock *Repos) ListGitTags(ctx context.Context, repoURL string, project string) ([]string, error) {
+	ret := _mock.Called(ctx, repoURL, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGitTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, repoURL, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, repoURL, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_ListGitTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGitTags'
+type Repos_ListGitTags_Call struct {
+	*mock.Call
+}
+
+// ListGitTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+func (_e *Repos_Expecter) ListGitTags(ctx any, repoURL any, project any) *Repos_ListGitTags_Call {
+	return &Repos_ListGitTags_Call{Call: _e.mock.On("ListGitTags", ctx, repoURL, project)}
+}
+
+func (_c *Repos_ListGitTags_Call) Run(run func(ctx context.Context, repoURL string, project string)) *Repos_ListGitTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_ListGitTags_Call) Return(strings []string, err error) *Repos_ListGitTags_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Repos_ListGitTags_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string) ([]string, error)) *Repos_ListGitTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,135 @@
+package pull_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pathpkg "path"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	codecommittypes "github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	log "github.com/sirupsen/logrus"
+)
+
+// AWSCodeCommitClient is a lean facade to the CodeCommit API used by the pull request generator,
+// it helps to reduce the mockery generated code.
+type AWSCodeCommitClient interface {
+	ListPullRequests(context.Context, *codecommit.ListPullRequestsInput, ...func(*codecommit.Options)) (*codecommit.ListPullRequestsOutput, error)
+	GetPullRequest(context.Context, *codecommit.GetPullRequestInput, ...func(*codecommit.Options)) (*codecommit.GetPullRequestOutput, error)
+}
+
+type AWSCodeCommitService struct {
+	client     AWSCodeCommitClient
+	repository string
+}
+
+var _ PullRequestService = (*AWSCodeCommitService)(nil)
+
+func NewAWSCodeCommitService(ctx context.Context, repository, role, region string) (PullRequestService, error) {
+	var configOpts []func(*config.LoadOptions) error
+	if region != "" {
+		configOpts = append(configOpts, config.WithRegion(region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, configOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error loading default config: %w", err)
+	}
+	// assume role if provided - this allows cross account access to the repository.
+	if role != "" {
+		log.Debugf("role %s is provided for AWS CodeCommit pull requests", role)
+		assumeRoleCreds := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), role)
+		cfg.Credentials = aws.NewCredentialsCache(assumeRoleCreds)
+	}
+
+	return &AWSCodeCommitService{
+		client:     codecommit.NewFromConfig(cfg),
+		repository: repository,
+	}, nil
+}
+
+func (c *AWSCodeCommitService) List(ctx context.Context) ([]*PullRequest, error) {
+	pullRequests := []*PullRequest{}
+	input := &codecommit.ListPullRequestsInput{
+		RepositoryName:    aws.String(c.repository),
+		PullRequestStatus: codecommittypes.PullRequestStatusEnumOpen,
+	}
+	for {
+		output, err := c.client.ListPullRequests(ctx, input)
+		if err != nil {
+			var notFoundErr *codecommittypes.RepositoryDoesNotExistException
+			if errors.As(err, &notFoundErr) {
+				// return a custom error indicating that the repository is not found,
+				// but also returning the empty result since the decision to continue or not in this case is made by the caller
+				return pullRequests, NewRepositoryNotFoundError(err)
+			}
+			return nil, fmt.Errorf("error listing pull requests for %s: %w", c.repository, err)
+		}
+
+		for _, id := range output.PullRequestIds {
+			pullRequest, err := c.getPullRequest(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if pullRequest != nil {
+				pullRequests = append(pullRequests, pullRequest)
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return pullRequests, nil
+}
+
+// getPullRequest returns the pull request with the given ID, or nil if it does not target the repository
+func (c *AWSCodeCommitService) getPullRequest(ctx context.Context, id string) (*PullRequest, error) {
+	output, err := c.client.GetPullRequest(ctx, &codecommit.GetPullRequestInput{PullRequestId: aws.String(id)})
+	if err != nil {
+		return nil, fmt.Errorf("error getting pull request %s: %w", id, err)
+	}
+	if output == nil || output.PullRequest == nil {
+		log.Warnf("codecommit returned invalid response for pull request %s, skipped", id)
+		return nil, nil
+	}
+
+	number, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request ID %q: %w", id, err)
+	}
+	for _, target := range output.PullRequest.PullRequestTargets {
+		if aws.ToString(target.RepositoryName) != c.repository {
+			continue
+		}
+		return &PullRequest{
+			Number:       number,
+			Title:        aws.ToString(output.PullRequest.Title),
+			Branch:       strings.TrimPrefix(aws.ToString(target.SourceReference), "refs/heads/"),
+			TargetBranch: strings.TrimPrefix(aws.ToString(target.DestinationReference), "refs/heads/"),
+			HeadSHA:      aws.ToString(target.SourceCommit),
+			// CodeCommit pull requests do not have labels.
+			Labels:    []string{},
+			Author:    codeCommitAuthor(aws.ToString(output.PullRequest.AuthorArn)),
+			UpdatedAt: aws.ToTime(output.PullRequest.LastActivityDate),
+		}, nil
+	}
+	return nil, nil
+}
+
+// codeCommitAuthor returns the name of the IAM user or of the session of the role which created the pull request,
+// e.g. alice for arn:aws:iam::123456789012:user/alice
+func codeCommitAuthor(authorArn string) string {
+	parsed, err := arn.Parse(authorArn)
+	if err != nil {
+		return authorArn
+	}
+	return pathpkg.Base(parsed.Resource)
+}
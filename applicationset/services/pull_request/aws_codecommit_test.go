@@ -0,0 +1,106 @@
+package pull_request
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	codecommittypes "github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request/mocks"
+)
+
+func codeCommitPullRequest(id, repository, source, sourceCommit string) *codecommit.GetPullRequestOutput {
+	return &codecommit.GetPullRequestOutput{PullRequest: &codecommittypes.PullRequest{
+		PullRequestId: aws.String(id),
+		Title:         aws.String("Pull request " + id),
+		AuthorArn:     aws.String("arn:aws:iam::111111111111:user/alice"),
+		PullRequestTargets: []codecommittypes.PullRequestTarget{{
+			RepositoryName:       aws.String(repository),
+			SourceReference:      aws.String("refs/heads/" + source),
+			DestinationReference: aws.String("refs/heads/main"),
+			SourceCommit:         aws.String(sourceCommit),
+		}},
+	}}
+}
+
+func TestAWSCodeCommitList(t *testing.T) {
+	t.Parallel()
+	client := mocks.NewAWSCodeCommitClient(t)
+	client.EXPECT().ListPullRequests(mock.Anything, &codecommit.ListPullRequestsInput{
+		RepositoryName:    aws.String("repo1"),
+		PullRequestStatus: codecommittypes.PullRequestStatusEnumOpen,
+	}).Return(&codecommit.ListPullRequestsOutput{PullRequestIds: []string{"1", "2"}, NextToken: aws.String("next")}, nil)
+	client.EXPECT().ListPullRequests(mock.Anything, &codecommit.ListPullRequestsInput{
+		RepositoryName:    aws.String("repo1"),
+		PullRequestStatus: codecommittypes.PullRequestStatusEnumOpen,
+		NextToken:         aws.String("next"),
+	}).Return(&codecommit.ListPullRequestsOutput{PullRequestIds: []string{"3"}}, nil)
+	client.EXPECT().GetPullRequest(mock.Anything, &codecommit.GetPullRequestInput{PullRequestId: aws.String("1")}).
+		Return(codeCommitPullRequest("1", "repo1", "feature-a", "6dcb09b5b57875f334f61aebed695e2e4193db5e"), nil)
+	client.EXPECT().GetPullRequest(mock.Anything, &codecommit.GetPullRequestInput{PullRequestId: aws.String("2")}).
+		Return(codeCommitPullRequest("2", "other-repo", "feature-b", "9ec3a4b1a1a1b2b2c3c3d4d4e5e5f6f6a7a7b8b8"), nil)
+	client.EXPECT().GetPullRequest(mock.Anything, &codecommit.GetPullRequestInput{PullRequestId: aws.String("3")}).
+		Return(codeCommitPullRequest("3", "repo1", "feature-c", "2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b"), nil)
+
+	svc := &AWSCodeCommitService{client: client, repository: "repo1"}
+	pullRequests, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []*PullRequest{{
+		Number:       1,
+		Title:        "Pull request 1",
+		Branch:       "feature-a",
+		TargetBranch: "main",
+		HeadSHA:      "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Labels:       []string{},
+		Author:       "alice",
+	}, {
+		Number:       3,
+		Title:        "Pull request 3",
+		Branch:       "feature-c",
+		TargetBranch: "main",
+		HeadSHA:      "2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b",
+		Labels:       []string{},
+		Author:       "alice",
+	}}, pullRequests)
+}
+
+func TestAWSCodeCommitListReturnsRepositoryNotFoundError(t *testing.T) {
+	t.Parallel()
+	client := mocks.NewAWSCodeCommitClient(t)
+	client.EXPECT().ListPullRequests(mock.Anything, mock.Anything).
+		Return(nil, &codecommittypes.RepositoryDoesNotExistException{Message: aws.String("repo1 does not exist")})
+
+	svc := &AWSCodeCommitService{client: client, repository: "repo1"}
+	pullRequests, err := svc.List(t.Context())
+
+	// Should return empty pull requests list
+	assert.Empty(t, pullRequests)
+
+	// Should return RepositoryNotFoundError
+	require.Error(t, err)
+	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
+}
+
+func TestAWSCodeCommitListError(t *testing.T) {
+	t.Parallel()
+	client := mocks.NewAWSCodeCommitClient(t)
+	client.EXPECT().ListPullRequests(mock.Anything, mock.Anything).
+		Return(&codecommit.ListPullRequestsOutput{PullRequestIds: []string{"1"}}, nil)
+	client.EXPECT().GetPullRequest(mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+
+	svc := &AWSCodeCommitService{client: client, repository: "repo1"}
+	_, err := svc.List(t.Context())
+	require.ErrorContains(t, err, "error getting pull request 1: access denied")
+}
+
+func TestCodeCommitAuthor(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "alice", codeCommitAuthor("arn:aws:iam::111111111111:user/alice"))
+	assert.Equal(t, "bob", codeCommitAuthor("arn:aws:sts::111111111111:assumed-role/Developer/bob"))
+	assert.Equal(t, "not-an-arn", codeCommitAuthor("not-an-arn"))
+}
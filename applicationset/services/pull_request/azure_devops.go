@@ -115,6 +115,7 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 				HeadSHA:      *pr.LastMergeSourceCommit.CommitId,
 				Labels:       azureDevOpsLabels,
 				Author:       strings.Split(*pr.CreatedBy.UniqueName, "@")[0], // Get the part before the @ in the email-address
+				Draft:        pr.IsDraft != nil && *pr.IsDraft,
 			})
 		}
 	}
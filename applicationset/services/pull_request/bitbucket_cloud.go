@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/ktrysmt/go-bitbucket"
 )
@@ -23,6 +24,8 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Draft       bool                                 `json:"draft"`
+	UpdatedOn   string                               `json:"updated_on"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
@@ -155,6 +158,13 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 	}
 
 	for _, pull := range pulls {
+		var updatedAt time.Time
+		if pull.UpdatedOn != "" {
+			updatedAt, err = time.Parse(time.RFC3339Nano, pull.UpdatedOn)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing the update time of pull request %d: %w", pull.ID, err)
+			}
+		}
 		pullRequests = append(pullRequests, &PullRequest{
 			Number:       int64(pull.ID),
 			Title:        pull.Title,
@@ -162,6 +172,8 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 			TargetBranch: pull.Destination.Branch.Name,
 			HeadSHA:      pull.Source.Commit.Hash,
 			Author:       pull.Author.Nickname,
+			Draft:        pull.Draft,
+			UpdatedAt:    updatedAt,
 		})
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -81,6 +82,10 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 		}
 
 		for _, pull := range pulls {
+			var updatedAt time.Time
+			if pull.UpdatedDate > 0 {
+				updatedAt = time.UnixMilli(pull.UpdatedDate)
+			}
 			pullRequests = append(pullRequests, &PullRequest{
 				Number:       int64(pull.ID),
 				Title:        pull.Title,
@@ -89,6 +94,7 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 				HeadSHA:      pull.FromRef.LatestCommit, // This is not defined in the official docs, but works in practice
 				Labels:       []string{},                // Not supported by library
 				Author:       pull.Author.User.Name,
+				UpdatedAt:    updatedAt,
 			})
 		}
 
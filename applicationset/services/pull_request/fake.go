@@ -9,7 +9,10 @@ type FakeService struct {
 	listError       error
 }
 
-var _ PullRequestService = (*FakeService)(nil)
+var (
+	_ PullRequestService        = (*FakeService)(nil)
+	_ PullRequestDetailsService = (*FakeService)(nil)
+)
 
 func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError error) (PullRequestService, error) {
 	return &FakeService{
@@ -21,3 +24,8 @@ func NewFakeService(_ context.Context, listPullReuests []*PullRequest, listError
 func (g *FakeService) List(_ context.Context) ([]*PullRequest, error) {
 	return g.listPullReuests, g.listError
 }
+
+// GetDetails does nothing, as the fake pull requests are listed with their details
+func (g *FakeService) GetDetails(_ context.Context, _ *PullRequest) error {
+	return nil
+}
@@ -0,0 +1,243 @@
+package pull_request
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/util/proxy"
+)
+
+const (
+	// gerritMagicPrefix is prepended by Gerrit to its JSON responses to prevent XSSI
+	gerritMagicPrefix = ")]}'"
+	gerritPageSize    = 100
+	// gerritTimeLayout is the layout of the timestamps of Gerrit, which are in UTC
+	gerritTimeLayout = "2006-01-02 15:04:05.000000000"
+)
+
+type GerritService struct {
+	client   *http.Client
+	url      string
+	username string
+	password string
+	project  string
+	labels   []string
+}
+
+var (
+	_ PullRequestService        = (*GerritService)(nil)
+	_ PullRequestDetailsService = (*GerritService)(nil)
+)
+
+type gerritChange struct {
+	Number          int64                     `json:"_number"`
+	Subject         string                    `json:"subject"`
+	Branch          string                    `json:"branch"`
+	Hashtags        []string                  `json:"hashtags"`
+	Owner           gerritAccount             `json:"owner"`
+	Updated         string                    `json:"updated"`
+	WorkInProgress  bool                      `json:"work_in_progress"`
+	Labels          map[string]gerritLabel    `json:"labels"`
+	CurrentRevision string                    `json:"current_revision"`
+	Revisions       map[string]gerritRevision `json:"revisions"`
+	MoreChanges     bool                      `json:"_more_changes"`
+}
+
+// gerritLabel is the state of a review label of a change, e.g. Code-Review, whose fields are set if a reviewer voted
+// the maximum or minimum value
+type gerritLabel struct {
+	Approved *gerritAccount `json:"approved"`
+	Rejected *gerritAccount `json:"rejected"`
+}
+
+type gerritAccount struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+type gerritRevision struct {
+	Ref   string              `json:"ref"`
+	Files map[string]struct{} `json:"files"`
+}
+
+func NewGerritService(username, password, url, project string, labels []string, insecure bool, proxyURL, noProxy string) (PullRequestService, error) {
+	if url == "" {
+		return nil, errors.New("the URL of the Gerrit server is required")
+	}
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	tr.Proxy = proxy.GetCallback(proxyURL, noProxy)
+
+	return &GerritService{
+		client:   &http.Client{Transport: tr},
+		url:      strings.TrimSuffix(url, "/"),
+		username: username,
+		password: password,
+		project:  project,
+		labels:   labels,
+	}, nil
+}
+
+func (g *GerritService) List(ctx context.Context) ([]*PullRequest, error) {
+	query := fmt.Sprintf("status:open project:%q", g.project)
+	for _, label := range g.labels {
+		query += fmt.Sprintf(" hashtag:%q", label)
+	}
+
+	pullRequests := []*PullRequest{}
+	for start := 0; ; start += gerritPageSize {
+		params := url.Values{}
+		params.Set("q", query)
+		params.Add("o", "CURRENT_REVISION")
+		params.Add("o", "DETAILED_ACCOUNTS")
+		// the details of the changes are returned by the same request
+		params.Add("o", "LABELS")
+		params.Add("o", "CURRENT_FILES")
+		params.Set("n", strconv.Itoa(gerritPageSize))
+		params.Set("S", strconv.Itoa(start))
+
+		var changes []gerritChange
+		if _, err := g.get(ctx, "/changes/?"+params.Encode(), &changes); err != nil {
+			return nil, fmt.Errorf("error listing changes for %s: %w", g.project, err)
+		}
+
+		for _, change := range changes {
+			revision, ok := change.Revisions[change.CurrentRevision]
+			if !ok {
+				continue
+			}
+			author := change.Owner.Username
+			if author == "" {
+				author = change.Owner.Name
+			}
+			labels := change.Hashtags
+			if labels == nil {
+				labels = []string{}
+			}
+			updatedAt, err := time.Parse(gerritTimeLayout, change.Updated)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing the update time of change %d: %w", change.Number, err)
+			}
+			changedFiles := []string{}
+			for file := range revision.Files {
+				// skip the magic files, e.g. /COMMIT_MSG
+				if !strings.HasPrefix(file, "/") {
+					changedFiles = append(changedFiles, file)
+				}
+			}
+			slices.Sort(changedFiles)
+			pullRequests = append(pullRequests, &PullRequest{
+				Number: change.Number,
+				Title:  change.Subject,
+				// Gerrit changes do not have a source branch, the ref of their current patch set is used instead,
+				// e.g. refs/changes/34/1234/2
+				Branch:       revision.Ref,
+				TargetBranch: change.Branch,
+				HeadSHA:      change.CurrentRevision,
+				Labels:       labels,
+				Author:       author,
+				Draft:        change.WorkInProgress,
+				UpdatedAt:    updatedAt,
+				Approved:     gerritApproved(change.Labels),
+				ChecksStatus: gerritChecksStatus(change.Labels),
+				ChangedFiles: changedFiles,
+			})
+		}
+
+		if len(changes) == 0 || !changes[len(changes)-1].MoreChanges {
+			break
+		}
+	}
+
+	// Querying the changes of an unknown project returns no changes rather than an error
+	if len(pullRequests) == 0 {
+		status, err := g.get(ctx, "/projects/"+url.PathEscape(g.project), nil)
+		if status == http.StatusNotFound {
+			// return a custom error indicating that the repository is not found,
+			// but also returning the empty result since the decision to continue or not in this case is made by the caller
+			return pullRequests, NewRepositoryNotFoundError(err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", g.project, err)
+		}
+	}
+	return pullRequests, nil
+}
+
+// GetDetails does nothing, as the details of the changes are returned by List
+func (g *GerritService) GetDetails(_ context.Context, _ *PullRequest) error {
+	return nil
+}
+
+// gerritApproved returns true if a reviewer voted the maximum value of the Code-Review label, and none the minimum
+func gerritApproved(labels map[string]gerritLabel) bool {
+	codeReview, ok := labels["Code-Review"]
+	return ok && codeReview.Approved != nil && codeReview.Rejected == nil
+}
+
+// gerritChecksStatus returns the status of the checks from the Verified label, which is voted by CI systems
+func gerritChecksStatus(labels map[string]gerritLabel) ChecksStatus {
+	verified, ok := labels["Verified"]
+	switch {
+	case !ok:
+		return ChecksStatusSuccess
+	case verified.Rejected != nil:
+		return ChecksStatusFailure
+	case verified.Approved != nil:
+		return ChecksStatusSuccess
+	default:
+		return ChecksStatusPending
+	}
+}
+
+// get sends a GET request to the REST API of Gerrit and decodes the response into out, if not nil
+func (g *GerritService) get(ctx context.Context, path string, out any) (int, error) {
+	endpoint := g.url
+	if g.username != "" {
+		// authenticated requests are sent to the /a/ prefixed endpoints
+		endpoint += "/a"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+path, http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if g.username != "" {
+		req.SetBasicAuth(g.username, g.password)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	if out == nil {
+		return resp.StatusCode, nil
+	}
+	body = bytes.TrimPrefix(body, []byte(gerritMagicPrefix))
+	if err := json.Unmarshal(body, out); err != nil {
+		return resp.StatusCode, fmt.Errorf("error decoding the response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,145 @@
+package pull_request
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gerritMockHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body string
+		switch {
+		case r.URL.Path == "/a/changes/" && r.URL.Query().Get("S") == "0":
+			assert.Equal(t, `status:open project:"platform/apps" hashtag:"preview"`, r.URL.Query().Get("q"))
+			assert.Equal(t, []string{"CURRENT_REVISION", "DETAILED_ACCOUNTS", "LABELS", "CURRENT_FILES"}, r.URL.Query()["o"])
+			username, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "argocd", username)
+			assert.Equal(t, "secret", password)
+			body = `)]}'
+[{
+	"_number": 1234,
+	"project": "platform/apps",
+	"branch": "main",
+	"hashtags": ["preview"],
+	"subject": "Add the payments service",
+	"owner": {"_account_id": 1000, "name": "Alice", "username": "alice"},
+	"updated": "2026-03-02 10:15:30.000000000",
+	"labels": {
+		"Code-Review": {"approved": {"_account_id": 1002}},
+		"Verified": {"approved": {"_account_id": 1003}}
+	},
+	"current_revision": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+	"revisions": {"6dcb09b5b57875f334f61aebed695e2e4193db5e": {
+		"_number": 2,
+		"ref": "refs/changes/34/1234/2",
+		"files": {"services/payments/main.go": {}, "/COMMIT_MSG": {}, "README.md": {}}
+	}},
+	"_more_changes": true
+}]`
+		case r.URL.Path == "/a/changes/" && r.URL.Query().Get("S") == "100":
+			body = `)]}'
+[{
+	"_number": 1240,
+	"project": "platform/apps",
+	"branch": "release-1.0",
+	"hashtags": ["preview", "backport"],
+	"subject": "Fix the payments service",
+	"owner": {"_account_id": 1001, "name": "Bob"},
+	"updated": "2026-03-01 08:00:00.000000000",
+	"work_in_progress": true,
+	"labels": {
+		"Code-Review": {"approved": {"_account_id": 1002}, "rejected": {"_account_id": 1004}},
+		"Verified": {}
+	},
+	"current_revision": "9ec3a4b1a1a1b2b2c3c3d4d4e5e5f6f6a7a7b8b8",
+	"revisions": {"9ec3a4b1a1a1b2b2c3c3d4d4e5e5f6f6a7a7b8b8": {"_number": 1, "ref": "refs/changes/40/1240/1", "files": {}}}
+}]`
+		default:
+			t.Errorf("unexpected request %s", r.URL.String())
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		_, err := io.WriteString(w, body)
+		if err != nil {
+			t.Fail()
+		}
+	}
+}
+
+func TestGerritList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(gerritMockHandler(t)))
+	defer ts.Close()
+	svc, err := NewGerritService("argocd", "secret", ts.URL+"/", "platform/apps", []string{"preview"}, false, "", "")
+	require.NoError(t, err)
+	pullRequests, err := svc.List(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []*PullRequest{{
+		Number:       1234,
+		Title:        "Add the payments service",
+		Branch:       "refs/changes/34/1234/2",
+		TargetBranch: "main",
+		HeadSHA:      "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Labels:       []string{"preview"},
+		Author:       "alice",
+		UpdatedAt:    time.Date(2026, 3, 2, 10, 15, 30, 0, time.UTC),
+		Approved:     true,
+		ChecksStatus: ChecksStatusSuccess,
+		ChangedFiles: []string{"README.md", "services/payments/main.go"},
+	}, {
+		Number:       1240,
+		Title:        "Fix the payments service",
+		Branch:       "refs/changes/40/1240/1",
+		TargetBranch: "release-1.0",
+		HeadSHA:      "9ec3a4b1a1a1b2b2c3c3d4d4e5e5f6f6a7a7b8b8",
+		Labels:       []string{"preview", "backport"},
+		Author:       "Bob",
+		Draft:        true,
+		UpdatedAt:    time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC),
+		ChecksStatus: ChecksStatusPending,
+		ChangedFiles: []string{},
+	}}, pullRequests)
+}
+
+func TestGerritListReturnsRepositoryNotFoundError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/changes/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, ")]}'\n[]")
+	})
+	mux.HandleFunc("/projects/", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "Not found: unknown", http.StatusNotFound)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	svc, err := NewGerritService("", "", ts.URL, "unknown", nil, false, "", "")
+	require.NoError(t, err)
+	pullRequests, err := svc.List(t.Context())
+
+	// Should return empty pull requests list
+	assert.Empty(t, pullRequests)
+
+	// Should return RepositoryNotFoundError
+	require.Error(t, err)
+	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
+}
+
+func TestGerritListError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	svc, err := NewGerritService("argocd", "wrong", ts.URL, "platform/apps", nil, false, "", "")
+	require.NoError(t, err)
+	_, err = svc.List(t.Context())
+	require.ErrorContains(t, err, "error listing changes for platform/apps: unexpected status 401 Unauthorized: Unauthorized")
+}
@@ -3,9 +3,11 @@ package pull_request
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 
@@ -19,7 +21,10 @@ type GiteaService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService        = (*GiteaService)(nil)
+	_ PullRequestDetailsService = (*GiteaService)(nil)
+)
 
 func NewGiteaService(token, url, owner, repo string, labels []string, insecure bool, proxyURL, noProxy string) (PullRequestService, error) {
 	if token == "" {
@@ -77,11 +82,67 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 			HeadSHA:      pr.Head.Sha,
 			Labels:       getGiteaPRLabelNames(pr.Labels),
 			Author:       pr.Poster.UserName,
+			Draft:        pr.Draft,
+			UpdatedAt:    giteaTime(pr.Updated),
 		})
 	}
 	return list, nil
 }
 
+func (g *GiteaService) GetDetails(ctx context.Context, pullRequest *PullRequest) error {
+	g.client.SetContext(ctx)
+
+	giteaReviews, _, err := g.client.ListPullReviews(g.owner, g.repo, pullRequest.Number, gitea.ListPullReviewsOptions{ListOptions: gitea.ListOptions{Page: -1}})
+	if err != nil {
+		return fmt.Errorf("error listing reviews of pull request %d: %w", pullRequest.Number, err)
+	}
+	reviews := []review{}
+	for _, r := range giteaReviews {
+		if r.Dismissed || r.Reviewer == nil {
+			continue
+		}
+		reviews = append(reviews, review{reviewer: r.Reviewer.UserName, state: string(r.State)})
+	}
+	pullRequest.Approved = reviewsApproved(reviews, string(gitea.ReviewStateApproved), string(gitea.ReviewStateRequestChanges))
+
+	combinedStatus, _, err := g.client.GetCombinedStatus(g.owner, g.repo, pullRequest.HeadSHA)
+	if err != nil {
+		return fmt.Errorf("error getting the combined status of %s: %w", pullRequest.HeadSHA, err)
+	}
+	pullRequest.ChecksStatus = ChecksStatusSuccess
+	if combinedStatus.TotalCount > 0 {
+		switch combinedStatus.State {
+		case gitea.StatusSuccess, gitea.StatusWarning:
+		case gitea.StatusPending:
+			pullRequest.ChecksStatus = ChecksStatusPending
+		default:
+			pullRequest.ChecksStatus = ChecksStatusFailure
+		}
+	}
+
+	files, _, err := g.client.ListPullRequestFiles(g.owner, g.repo, pullRequest.Number, gitea.ListPullRequestFilesOptions{ListOptions: gitea.ListOptions{Page: -1}})
+	if err != nil {
+		return fmt.Errorf("error listing the files of pull request %d: %w", pullRequest.Number, err)
+	}
+	changedFiles := []string{}
+	for _, file := range files {
+		changedFiles = append(changedFiles, file.Filename)
+		if file.PreviousFilename != "" {
+			changedFiles = append(changedFiles, file.PreviousFilename)
+		}
+	}
+	pullRequest.ChangedFiles = changedFiles
+	return nil
+}
+
+// giteaTime returns the time, or the zero time if it is nil
+func giteaTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func giteaContainLabels(expectedLabels []string, gotLabels []*gitea.Label) bool {
 	gotLabelNamesMap := make(map[string]bool)
@@ -377,3 +377,36 @@ func TestGiteaListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGiteaGetDetails(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"1.21.0"}`))
+	})
+	mux.HandleFunc("/api/v1/repos/test-argocd/pr-test/pulls/1/reviews", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"user": {"login": "alice"}, "state": "APPROVED"},
+			{"user": {"login": "bob"}, "state": "REQUEST_CHANGES", "dismissed": true}
+		]`))
+	})
+	mux.HandleFunc("/api/v1/repos/test-argocd/pr-test/commits/7bbaf62d92ddfafd9cc8b340c619abaec32bc09f/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"state": "success", "total_count": 2}`))
+	})
+	mux.HandleFunc("/api/v1/repos/test-argocd/pr-test/pulls/1/files", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"filename": "services/foo/main.go"}]`))
+	})
+
+	svc, err := NewGiteaService("", server.URL, "test-argocd", "pr-test", []string{}, false, "", "")
+	require.NoError(t, err)
+
+	pullRequest := &PullRequest{Number: 1, HeadSHA: "7bbaf62d92ddfafd9cc8b340c619abaec32bc09f"}
+	err = svc.(PullRequestDetailsService).GetDetails(t.Context(), pullRequest)
+	require.NoError(t, err)
+	assert.True(t, pullRequest.Approved)
+	assert.Equal(t, ChecksStatusSuccess, pullRequest.ChecksStatus)
+	assert.Equal(t, []string{"services/foo/main.go"}, pullRequest.ChangedFiles)
+}
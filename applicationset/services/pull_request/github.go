@@ -18,7 +18,10 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService        = (*GithubService)(nil)
+	_ PullRequestDetailsService = (*GithubService)(nil)
+)
 
 func NewGithubService(token, url, owner, repo string, labels []string, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -83,6 +86,8 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 				HeadSHA:      *pull.Head.SHA,
 				Labels:       getGithubPRLabelNames(pull.Labels),
 				Author:       *pull.User.Login,
+				Draft:        pull.GetDraft(),
+				UpdatedAt:    pull.GetUpdatedAt().Time,
 			})
 		}
 		if resp.NextPage == 0 {
@@ -93,6 +98,106 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) GetDetails(ctx context.Context, pullRequest *PullRequest) error {
+	var err error
+	if pullRequest.Approved, err = g.getApproved(ctx, int(pullRequest.Number)); err != nil {
+		return err
+	}
+	if pullRequest.ChecksStatus, err = g.getChecksStatus(ctx, pullRequest.HeadSHA); err != nil {
+		return err
+	}
+	if pullRequest.ChangedFiles, err = g.getChangedFiles(ctx, int(pullRequest.Number)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (g *GithubService) getApproved(ctx context.Context, number int) (bool, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	reviews := []review{}
+	for {
+		githubReviews, resp, err := g.client.PullRequests.ListReviews(ctx, g.owner, g.repo, number, opts)
+		if err != nil {
+			return false, fmt.Errorf("error listing reviews of pull request %d: %w", number, err)
+		}
+		for _, r := range githubReviews {
+			reviews = append(reviews, review{reviewer: r.GetUser().GetLogin(), state: r.GetState()})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return reviewsApproved(reviews, "APPROVED", "CHANGES_REQUESTED"), nil
+}
+
+// getChecksStatus combines the commit statuses and the check runs of the commit
+func (g *GithubService) getChecksStatus(ctx context.Context, sha string) (ChecksStatus, error) {
+	statuses := []ChecksStatus{}
+
+	combinedStatus, _, err := g.client.Repositories.GetCombinedStatus(ctx, g.owner, g.repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return "", fmt.Errorf("error getting the combined status of %s: %w", sha, err)
+	}
+	// the state of a commit without statuses is pending
+	if combinedStatus.GetTotalCount() > 0 {
+		switch combinedStatus.GetState() {
+		case "success":
+			statuses = append(statuses, ChecksStatusSuccess)
+		case "pending":
+			statuses = append(statuses, ChecksStatusPending)
+		default:
+			statuses = append(statuses, ChecksStatusFailure)
+		}
+	}
+
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		checkRuns, resp, err := g.client.Checks.ListCheckRunsForRef(ctx, g.owner, g.repo, sha, opts)
+		if err != nil {
+			return "", fmt.Errorf("error listing the check runs of %s: %w", sha, err)
+		}
+		for _, checkRun := range checkRuns.CheckRuns {
+			switch {
+			case checkRun.GetStatus() != "completed":
+				statuses = append(statuses, ChecksStatusPending)
+			case checkRun.GetConclusion() == "success" || checkRun.GetConclusion() == "neutral" || checkRun.GetConclusion() == "skipped":
+				statuses = append(statuses, ChecksStatusSuccess)
+			default:
+				statuses = append(statuses, ChecksStatusFailure)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return combineChecksStatus(statuses...), nil
+}
+
+func (g *GithubService) getChangedFiles(ctx context.Context, number int) ([]string, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	changedFiles := []string{}
+	for {
+		files, resp, err := g.client.PullRequests.ListFiles(ctx, g.owner, g.repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing the files of pull request %d: %w", number, err)
+		}
+		for _, file := range files {
+			changedFiles = append(changedFiles, file.GetFilename())
+			if file.GetPreviousFilename() != "" {
+				changedFiles = append(changedFiles, file.GetPreviousFilename())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return changedFiles, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
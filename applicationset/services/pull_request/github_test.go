@@ -116,3 +116,44 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubGetDetails(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls/12/reviews", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"user": {"login": "alice"}, "state": "CHANGES_REQUESTED"},
+			{"user": {"login": "bob"}, "state": "APPROVED"},
+			{"user": {"login": "alice"}, "state": "APPROVED"},
+			{"user": {"login": "carol"}, "state": "COMMENTED"}
+		]`))
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"state": "success", "total_count": 1}`))
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/check-runs", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"total_count": 2, "check_runs": [
+			{"status": "completed", "conclusion": "success"},
+			{"status": "in_progress"}
+		]}`))
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls/12/files", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"filename": "services/foo/main.go"},
+			{"filename": "services/bar/main.go", "previous_filename": "services/baz/main.go"}
+		]`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "argoproj", "argo-cd", []string{}, nil)
+	require.NoError(t, err)
+
+	pullRequest := &PullRequest{Number: 12, HeadSHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	err = svc.(PullRequestDetailsService).GetDetails(t.Context(), pullRequest)
+	require.NoError(t, err)
+	assert.True(t, pullRequest.Approved)
+	assert.Equal(t, ChecksStatusPending, pullRequest.ChecksStatus)
+	assert.Equal(t, []string{"services/foo/main.go", "services/bar/main.go", "services/baz/main.go"}, pullRequest.ChangedFiles)
+}
@@ -20,7 +20,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService        = (*GitLabService)(nil)
+	_ PullRequestDetailsService = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte, proxyURL, noProxy string) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -90,7 +93,7 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 			return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
 		}
 		for _, mr := range mrs {
-			pullRequests = append(pullRequests, &PullRequest{
+			pullRequest := &PullRequest{
 				Number:       mr.IID,
 				Title:        mr.Title,
 				Branch:       mr.SourceBranch,
@@ -98,7 +101,12 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 				HeadSHA:      mr.SHA,
 				Labels:       mr.Labels,
 				Author:       mr.Author.Username,
-			})
+				Draft:        mr.Draft,
+			}
+			if mr.UpdatedAt != nil {
+				pullRequest.UpdatedAt = *mr.UpdatedAt
+			}
+			pullRequests = append(pullRequests, pullRequest)
 		}
 		if resp.NextPage == 0 {
 			break
@@ -107,3 +115,48 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) GetDetails(ctx context.Context, pullRequest *PullRequest) error {
+	approvals, _, err := g.client.MergeRequestApprovals.GetConfiguration(g.project, pullRequest.Number, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error getting the approvals of merge request %d: %w", pullRequest.Number, err)
+	}
+	// merge requests which do not require approvals are approved, even if nobody approved them
+	pullRequest.Approved = approvals.Approved && len(approvals.ApprovedBy) > 0
+
+	mr, _, err := g.client.MergeRequests.GetMergeRequest(g.project, pullRequest.Number, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error getting merge request %d: %w", pullRequest.Number, err)
+	}
+	pullRequest.ChecksStatus = ChecksStatusSuccess
+	if mr.HeadPipeline != nil {
+		switch mr.HeadPipeline.Status {
+		case "success", "skipped":
+		case "failed", "canceled":
+			pullRequest.ChecksStatus = ChecksStatusFailure
+		default:
+			pullRequest.ChecksStatus = ChecksStatusPending
+		}
+	}
+
+	opts := &gitlab.ListMergeRequestDiffsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	changedFiles := []string{}
+	for {
+		diffs, resp, err := g.client.MergeRequests.ListMergeRequestDiffs(g.project, pullRequest.Number, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("error listing the diffs of merge request %d: %w", pullRequest.Number, err)
+		}
+		for _, diff := range diffs {
+			changedFiles = append(changedFiles, diff.NewPath)
+			if diff.RenamedFile {
+				changedFiles = append(changedFiles, diff.OldPath)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	pullRequest.ChangedFiles = changedFiles
+	return nil
+}
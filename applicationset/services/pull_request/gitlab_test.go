@@ -224,3 +224,33 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabGetDetails(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/15/approvals", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"approved": true, "approved_by": [{"user": {"username": "alice"}}]}`))
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/15", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"iid": 15, "head_pipeline": {"status": "failed"}}`))
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/15/diffs", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"old_path": "services/foo/main.go", "new_path": "services/foo/main.go"},
+			{"old_path": "services/baz/main.go", "new_path": "services/bar/main.go", "renamed_file": true}
+		]`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil, "", "")
+	require.NoError(t, err)
+
+	pullRequest := &PullRequest{Number: 15}
+	err = svc.(PullRequestDetailsService).GetDetails(t.Context(), pullRequest)
+	require.NoError(t, err)
+	assert.True(t, pullRequest.Approved)
+	assert.Equal(t, ChecksStatusFailure, pullRequest.ChecksStatus)
+	assert.Equal(t, []string{"services/foo/main.go", "services/bar/main.go", "services/baz/main.go"}, pullRequest.ChangedFiles)
+}
@@ -3,6 +3,16 @@ package pull_request
 import (
 	"context"
 	"regexp"
+	"time"
+)
+
+// ChecksStatus is the combined status of the CI status checks of a pull request.
+type ChecksStatus string
+
+const (
+	ChecksStatusSuccess ChecksStatus = "success"
+	ChecksStatusPending ChecksStatus = "pending"
+	ChecksStatusFailure ChecksStatus = "failure"
 )
 
 type PullRequest struct {
@@ -21,6 +31,19 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// Draft is true if the pull request is a draft or a work in progress.
+	Draft bool
+	// UpdatedAt is the time the pull request was last updated, zero if the provider does not return it.
+	UpdatedAt time.Time
+	// Approved is true if the pull request has been approved by reviewers.
+	// It is set by PullRequestDetailsService.GetDetails.
+	Approved bool
+	// ChecksStatus is the combined status of the CI status checks of the HEAD of the pull request.
+	// It is set by PullRequestDetailsService.GetDetails.
+	ChecksStatus ChecksStatus
+	// ChangedFiles are the paths of the files changed by the pull request.
+	// It is set by PullRequestDetailsService.GetDetails.
+	ChangedFiles []string
 }
 
 type PullRequestService interface {
@@ -28,8 +51,25 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// PullRequestDetailsService is implemented by the services able to get the details of a pull request which require
+// additional requests. The details are only fetched for the pull requests a filter needs them for.
+type PullRequestDetailsService interface {
+	// GetDetails sets the review approval state, the status checks and the changed files of the pull request.
+	GetDetails(ctx context.Context, pullRequest *PullRequest) error
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
 	TitleMatch        *regexp.Regexp
+	Draft             *bool
+	Approved          *bool
+	ChecksStatus      *ChecksStatus
+	PathsChanged      []string
+	UpdatedAfter      time.Time
+}
+
+// needsDetails returns true if the filter needs the details of the pull requests
+func (f *Filter) needsDetails() bool {
+	return f.Approved != nil || f.ChecksStatus != nil || len(f.PathsChanged) > 0
 }
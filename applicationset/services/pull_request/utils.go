@@ -2,8 +2,13 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -31,6 +36,25 @@ func compileFilters(filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]
 				return nil, fmt.Errorf("error compiling TitleMatch regexp %q: %w", *filter.TitleMatch, err)
 			}
 		}
+		outFilter.Draft = filter.Draft
+		outFilter.Approved = filter.Approved
+		if filter.ChecksStatus != nil {
+			checksStatus := ChecksStatus(*filter.ChecksStatus)
+			outFilter.ChecksStatus = &checksStatus
+		}
+		for _, pattern := range filter.PathsChanged {
+			if !doublestar.ValidatePattern(pattern) {
+				return nil, fmt.Errorf("invalid PathsChanged pattern %q", pattern)
+			}
+		}
+		outFilter.PathsChanged = filter.PathsChanged
+		if filter.UpdatedWithin != nil {
+			updatedWithin, err := time.ParseDuration(*filter.UpdatedWithin)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing UpdatedWithin duration %q: %w", *filter.UpdatedWithin, err)
+			}
+			outFilter.UpdatedAfter = time.Now().Add(-updatedWithin)
+		}
 		outFilters = append(outFilters, outFilter)
 	}
 	return outFilters, nil
@@ -46,6 +70,31 @@ func matchFilter(pullRequest *PullRequest, filter *Filter) bool {
 	if filter.TitleMatch != nil && !filter.TitleMatch.MatchString(pullRequest.Title) {
 		return false
 	}
+	if filter.Draft != nil && *filter.Draft != pullRequest.Draft {
+		return false
+	}
+	if !filter.UpdatedAfter.IsZero() && pullRequest.UpdatedAt.Before(filter.UpdatedAfter) {
+		return false
+	}
+
+	return true
+}
+
+// matchDetailsFilter matches the conditions of the filter on the details of the pull request
+func matchDetailsFilter(pullRequest *PullRequest, filter *Filter) bool {
+	if filter.Approved != nil && *filter.Approved != pullRequest.Approved {
+		return false
+	}
+	if filter.ChecksStatus != nil && *filter.ChecksStatus != pullRequest.ChecksStatus {
+		return false
+	}
+	if len(filter.PathsChanged) > 0 && !slices.ContainsFunc(pullRequest.ChangedFiles, func(file string) bool {
+		return slices.ContainsFunc(filter.PathsChanged, func(pattern string) bool {
+			return doublestar.MatchUnvalidated(pattern, file)
+		})
+	}) {
+		return false
+	}
 
 	return true
 }
@@ -65,16 +114,75 @@ func ListPullRequests(ctx context.Context, provider PullRequestService, filters
 		return pullRequests, nil
 	}
 
+	detailsProvider, ok := provider.(PullRequestDetailsService)
+	if !ok && slices.ContainsFunc(compiledFilters, (*Filter).needsDetails) {
+		return nil, errors.New("the approved, checksStatus and pathsChanged filters are not supported by this pull request provider")
+	}
+
 	filteredPullRequests := make([]*PullRequest, 0, len(pullRequests))
 	for _, pullRequest := range pullRequests {
+		hasDetails := false
 		for _, filter := range compiledFilters {
-			matches := matchFilter(pullRequest, filter)
-			if matches {
-				filteredPullRequests = append(filteredPullRequests, pullRequest)
-				break
+			if !matchFilter(pullRequest, filter) {
+				continue
 			}
+			if filter.needsDetails() {
+				// the details are only fetched once the other conditions of a filter match, as they require additional requests
+				if !hasDetails {
+					if err := detailsProvider.GetDetails(ctx, pullRequest); err != nil {
+						return nil, fmt.Errorf("error getting the details of pull request %d: %w", pullRequest.Number, err)
+					}
+					hasDetails = true
+				}
+				if !matchDetailsFilter(pullRequest, filter) {
+					continue
+				}
+			}
+			filteredPullRequests = append(filteredPullRequests, pullRequest)
+			break
 		}
 	}
 
 	return filteredPullRequests, nil
 }
+
+// reviewsApproved returns true if at least one reviewer approved the pull request and none requested changes, given
+// the reviews in chronological order and the review states which approve and request changes
+func reviewsApproved(reviews []review, approvedState, changesRequestedState string) bool {
+	latestStates := map[string]string{}
+	for _, r := range reviews {
+		// only the latest approval or change request of each reviewer counts, comments and dismissed reviews do not
+		if r.state == approvedState || r.state == changesRequestedState {
+			latestStates[r.reviewer] = r.state
+		}
+	}
+	approved := false
+	for _, state := range latestStates {
+		if state == changesRequestedState {
+			return false
+		}
+		approved = true
+	}
+	return approved
+}
+
+// review is a review of a pull request by a reviewer
+type review struct {
+	reviewer string
+	state    string
+}
+
+// combineChecksStatus returns the combined status of status checks: failure if any of them failed, pending if any
+// of them is pending, success otherwise, including if there are no status checks
+func combineChecksStatus(statuses ...ChecksStatus) ChecksStatus {
+	combined := ChecksStatusSuccess
+	for _, status := range statuses {
+		switch status {
+		case ChecksStatusFailure:
+			return ChecksStatusFailure
+		case ChecksStatusPending:
+			combined = ChecksStatusPending
+		}
+	}
+	return combined
+}
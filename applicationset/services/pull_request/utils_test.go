@@ -1,7 +1,9 @@
 package pull_request
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -403,3 +405,154 @@ func TestNoFilters(t *testing.T) {
 	assert.Equal(t, "one", repos[0].Branch)
 	assert.Equal(t, "two", repos[1].Branch)
 }
+
+// detailsService lists pull requests and sets their details, counting the requests for details
+type detailsService struct {
+	pullRequests []*PullRequest
+	details      map[int64]PullRequest
+	detailsCalls []int64
+}
+
+func (d *detailsService) List(_ context.Context) ([]*PullRequest, error) {
+	return d.pullRequests, nil
+}
+
+func (d *detailsService) GetDetails(_ context.Context, pullRequest *PullRequest) error {
+	d.detailsCalls = append(d.detailsCalls, pullRequest.Number)
+	details := d.details[pullRequest.Number]
+	pullRequest.Approved = details.Approved
+	pullRequest.ChecksStatus = details.ChecksStatus
+	pullRequest.ChangedFiles = details.ChangedFiles
+	return nil
+}
+
+// listOnlyService lists pull requests but is not able to get their details
+type listOnlyService struct {
+	pullRequests []*PullRequest
+}
+
+func (l *listOnlyService) List(_ context.Context) ([]*PullRequest, error) {
+	return l.pullRequests, nil
+}
+
+func TestFilterDraftAndUpdatedWithin(t *testing.T) {
+	t.Parallel()
+	provider := &listOnlyService{pullRequests: []*PullRequest{
+		{Number: 1, Branch: "one", UpdatedAt: time.Now().Add(-time.Hour)},
+		{Number: 2, Branch: "two", Draft: true, UpdatedAt: time.Now().Add(-time.Hour)},
+		{Number: 3, Branch: "three", UpdatedAt: time.Now().Add(-30 * 24 * time.Hour)},
+		{Number: 4, Branch: "four"},
+	}}
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			Draft:         new(false),
+			UpdatedWithin: new("168h"),
+		},
+	}
+	pullRequests, err := ListPullRequests(t.Context(), provider, filters)
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 1)
+	assert.Equal(t, "one", pullRequests[0].Branch)
+
+	_, err = ListPullRequests(t.Context(), provider, []argoprojiov1alpha1.PullRequestGeneratorFilter{{UpdatedWithin: new("one week")}})
+	require.ErrorContains(t, err, `error parsing UpdatedWithin duration "one week"`)
+}
+
+func TestFilterDetails(t *testing.T) {
+	t.Parallel()
+	provider := &detailsService{
+		pullRequests: []*PullRequest{
+			{Number: 1, Branch: "one"},
+			{Number: 2, Branch: "two"},
+			{Number: 3, Branch: "three"},
+			{Number: 4, Branch: "four", Draft: true},
+		},
+		details: map[int64]PullRequest{
+			1: {Approved: true, ChecksStatus: ChecksStatusSuccess, ChangedFiles: []string{"services/foo/main.go"}},
+			2: {Approved: true, ChecksStatus: ChecksStatusSuccess, ChangedFiles: []string{"services/bar/main.go"}},
+			3: {Approved: false, ChecksStatus: ChecksStatusFailure, ChangedFiles: []string{"services/foo/deploy/values.yaml"}},
+			4: {Approved: true, ChecksStatus: ChecksStatusSuccess, ChangedFiles: []string{"services/foo/main.go"}},
+		},
+	}
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			Draft:        new(false),
+			Approved:     new(true),
+			ChecksStatus: new("success"),
+			PathsChanged: []string{"services/foo/**"},
+		},
+	}
+	pullRequests, err := ListPullRequests(t.Context(), provider, filters)
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 1)
+	assert.Equal(t, "one", pullRequests[0].Branch)
+	// the details of the draft pull request are not needed
+	assert.Equal(t, []int64{1, 2, 3}, provider.detailsCalls)
+}
+
+func TestFilterDetailsNotSupported(t *testing.T) {
+	t.Parallel()
+	provider := &listOnlyService{pullRequests: []*PullRequest{{Number: 1, Branch: "one"}}}
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			PathsChanged: []string{"services/foo/**"},
+		},
+	}
+	_, err := ListPullRequests(t.Context(), provider, filters)
+	require.ErrorContains(t, err, "not supported by this pull request provider")
+}
+
+func TestFilterPathsChangedBadPattern(t *testing.T) {
+	t.Parallel()
+	provider, _ := NewFakeService(t.Context(), []*PullRequest{{Number: 1, Branch: "one"}}, nil)
+	filters := []argoprojiov1alpha1.PullRequestGeneratorFilter{
+		{
+			PathsChanged: []string{"services/[foo"},
+		},
+	}
+	_, err := ListPullRequests(t.Context(), provider, filters)
+	require.ErrorContains(t, err, `invalid PathsChanged pattern "services/[foo"`)
+}
+
+func TestReviewsApproved(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		reviews  []review
+		expected bool
+	}{
+		{
+			name:     "no reviews",
+			expected: false,
+		},
+		{
+			name:     "approved",
+			reviews:  []review{{reviewer: "alice", state: "COMMENTED"}, {reviewer: "alice", state: "APPROVED"}, {reviewer: "bob", state: "COMMENTED"}},
+			expected: true,
+		},
+		{
+			name:     "changes requested by another reviewer",
+			reviews:  []review{{reviewer: "alice", state: "APPROVED"}, {reviewer: "bob", state: "CHANGES_REQUESTED"}},
+			expected: false,
+		},
+		{
+			name:     "approved after requesting changes",
+			reviews:  []review{{reviewer: "bob", state: "CHANGES_REQUESTED"}, {reviewer: "bob", state: "APPROVED"}},
+			expected: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.expected, reviewsApproved(c.reviews, "APPROVED", "CHANGES_REQUESTED"))
+		})
+	}
+}
+
+func TestCombineChecksStatus(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ChecksStatusSuccess, combineChecksStatus())
+	assert.Equal(t, ChecksStatusSuccess, combineChecksStatus(ChecksStatusSuccess, ChecksStatusSuccess))
+	assert.Equal(t, ChecksStatusPending, combineChecksStatus(ChecksStatusSuccess, ChecksStatusPending))
+	assert.Equal(t, ChecksStatusFailure, combineChecksStatus(ChecksStatusPending, ChecksStatusFailure, ChecksStatusSuccess))
+}
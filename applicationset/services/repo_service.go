@@ -11,11 +11,18 @@ import (
 )
 
 type argoCDService struct {
-	getRepository                   func(ctx context.Context, url, project string) (*v1alpha1.Repository, error)
-	submoduleEnabled                bool
-	newFileGlobbingEnabled          bool
-	getGitFilesFromRepoServer       func(ctx context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error)
-	getGitDirectoriesFromRepoServer func(ctx context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error)
+	getRepository                      func(ctx context.Context, url, project string) (*v1alpha1.Repository, error)
+	submoduleEnabled                   bool
+	newFileGlobbingEnabled             bool
+	getGitFilesFromRepoServer          func(ctx context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error)
+	getGitDirectoriesFromRepoServer    func(ctx context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error)
+	listOCIRepositoriesFromRepoServer  func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.OCIRepositories, error)
+	listOCITagsFromRepoServer          func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error)
+	getOCIArtifactsFromRepoServer      func(ctx context.Context, req *apiclient.OCIArtifactsRequest) (*apiclient.OCIArtifactsResponse, error)
+	getHelmChartVersionsFromRepoServer func(ctx context.Context, req *apiclient.HelmChartVersionsRequest) (*apiclient.HelmChartVersionsResponse, error)
+	listGitRefsFromRepoServer          func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error)
+	getGitTagsFromRepoServer           func(ctx context.Context, req *apiclient.GitTagsRequest) (*apiclient.GitTagsResponse, error)
+	getRevisionMetadataFromRepoServer  func(ctx context.Context, req *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
 }
 
 type Repos interface {
@@ -24,6 +31,27 @@ type Repos interface {
 
 	// GetDirectories returns a list of directories (not files) within the target repo
 	GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache bool, sourceIntegrity *v1alpha1.SourceIntegrity) ([]string, error)
+
+	// GetOCIRepositories returns the names of the repositories of an OCI registry namespace
+	GetOCIRepositories(ctx context.Context, repoURL, project string) ([]string, error)
+
+	// GetOCITags returns the tags of an OCI repository
+	GetOCITags(ctx context.Context, repoURL, project string) ([]string, error)
+
+	// GetOCIArtifacts returns the digest and the annotations of the given tags of an OCI repository
+	GetOCIArtifacts(ctx context.Context, repoURL, project string, tags []string) ([]*apiclient.OCIArtifact, error)
+
+	// GetHelmChartVersions returns the versions of the given charts of a Helm repository, or of all its charts
+	GetHelmChartVersions(ctx context.Context, repoURL, project string, charts []string, noCache bool) ([]*apiclient.HelmChartVersion, error)
+
+	// ListGitTags returns the names of the tags of a Git repository
+	ListGitTags(ctx context.Context, repoURL, project string) ([]string, error)
+
+	// GetGitTags returns the commit SHA and the commit metadata of the given tags of a Git repository
+	GetGitTags(ctx context.Context, repoURL, project string, tags []string) ([]*apiclient.GitTag, error)
+
+	// GetRevisionMetadata returns the metadata, e.g. the author and the date, of a commit of a Git repository
+	GetRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*v1alpha1.RevisionMetadata, error)
 }
 
 func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) Repos {
@@ -47,6 +75,62 @@ func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclie
 			defer utilio.Close(closer)
 			return client.GetGitDirectories(ctx, dirRequest)
 		},
+		listOCIRepositoriesFromRepoServer: func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.OCIRepositories, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.ListOCIRepositories(ctx, req)
+		},
+		listOCITagsFromRepoServer: func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.ListOCITags(ctx, req)
+		},
+		getOCIArtifactsFromRepoServer: func(ctx context.Context, req *apiclient.OCIArtifactsRequest) (*apiclient.OCIArtifactsResponse, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.GetOCIArtifacts(ctx, req)
+		},
+		getHelmChartVersionsFromRepoServer: func(ctx context.Context, req *apiclient.HelmChartVersionsRequest) (*apiclient.HelmChartVersionsResponse, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.GetHelmChartVersions(ctx, req)
+		},
+		listGitRefsFromRepoServer: func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.ListRefs(ctx, req)
+		},
+		getGitTagsFromRepoServer: func(ctx context.Context, req *apiclient.GitTagsRequest) (*apiclient.GitTagsResponse, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.GetGitTags(ctx, req)
+		},
+		getRevisionMetadataFromRepoServer: func(ctx context.Context, req *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.GetRevisionMetadata(ctx, req)
+		},
 	}
 }
 
@@ -96,3 +180,94 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 	}
 	return dirResponse.GetPaths(), nil
 }
+
+func (a *argoCDService) GetOCIRepositories(ctx context.Context, repoURL, project string) ([]string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	res, err := a.listOCIRepositoriesFromRepoServer(ctx, &apiclient.ListRefsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving OCI repositories: %w", err)
+	}
+	return res.GetRepositories(), nil
+}
+
+func (a *argoCDService) GetOCITags(ctx context.Context, repoURL, project string) ([]string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	res, err := a.listOCITagsFromRepoServer(ctx, &apiclient.ListRefsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving OCI tags: %w", err)
+	}
+	return res.GetTags(), nil
+}
+
+func (a *argoCDService) GetOCIArtifacts(ctx context.Context, repoURL, project string, tags []string) ([]*apiclient.OCIArtifact, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	res, err := a.getOCIArtifactsFromRepoServer(ctx, &apiclient.OCIArtifactsRequest{Repo: repo, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving OCI artifacts: %w", err)
+	}
+	return res.GetArtifacts(), nil
+}
+
+func (a *argoCDService) GetHelmChartVersions(ctx context.Context, repoURL, project string, charts []string, noCache bool) ([]*apiclient.HelmChartVersion, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	res, err := a.getHelmChartVersionsFromRepoServer(ctx, &apiclient.HelmChartVersionsRequest{Repo: repo, Charts: charts, NoCache: noCache})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Helm chart versions: %w", err)
+	}
+	return res.GetItems(), nil
+}
+
+func (a *argoCDService) ListGitTags(ctx context.Context, repoURL, project string) ([]string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	res, err := a.listGitRefsFromRepoServer(ctx, &apiclient.ListRefsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Git refs: %w", err)
+	}
+	return res.GetTags(), nil
+}
+
+func (a *argoCDService) GetGitTags(ctx context.Context, repoURL, project string, tags []string) ([]*apiclient.GitTag, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	res, err := a.getGitTagsFromRepoServer(ctx, &apiclient.GitTagsRequest{Repo: repo, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Git tags: %w", err)
+	}
+	return res.GetTags(), nil
+}
+
+func (a *argoCDService) GetRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*v1alpha1.RevisionMetadata, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	metadata, err := a.getRevisionMetadataFromRepoServer(ctx, &apiclient.RepoServerRevisionMetadataRequest{Repo: repo, Revision: revision})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving revision metadata: %w", err)
+	}
+	return metadata, nil
+}
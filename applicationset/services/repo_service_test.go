@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -172,6 +175,141 @@ func TestGetFiles(t *testing.T) {
 	}
 }
 
+func TestGetOCIArtifacts(t *testing.T) {
+	t.Parallel()
+	getRepository := func(_ context.Context, url, project string) (*v1alpha1.Repository, error) {
+		return &v1alpha1.Repository{Repo: url, Type: "oci", Project: project}, nil
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		t.Parallel()
+		a := &argoCDService{
+			getRepository: getRepository,
+			listOCIRepositoriesFromRepoServer: func(_ context.Context, req *apiclient.ListRefsRequest) (*apiclient.OCIRepositories, error) {
+				assert.Equal(t, "oci://registry.example.com/charts", req.Repo.Repo)
+				return &apiclient.OCIRepositories{Repositories: []string{"guestbook"}}, nil
+			},
+			listOCITagsFromRepoServer: func(_ context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+				assert.Equal(t, "oci://registry.example.com/charts/guestbook", req.Repo.Repo)
+				return &apiclient.Refs{Tags: []string{"1.0.0"}}, nil
+			},
+			getOCIArtifactsFromRepoServer: func(_ context.Context, req *apiclient.OCIArtifactsRequest) (*apiclient.OCIArtifactsResponse, error) {
+				assert.Equal(t, "project", req.Repo.Project)
+				return &apiclient.OCIArtifactsResponse{Artifacts: []*apiclient.OCIArtifact{{Tag: req.Tags[0], Digest: "sha256:abc"}}}, nil
+			},
+		}
+
+		repositories, err := a.GetOCIRepositories(t.Context(), "oci://registry.example.com/charts", "project")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"guestbook"}, repositories)
+		tags, err := a.GetOCITags(t.Context(), "oci://registry.example.com/charts/guestbook", "project")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.0.0"}, tags)
+		artifacts, err := a.GetOCIArtifacts(t.Context(), "oci://registry.example.com/charts/guestbook", "project", tags)
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.OCIArtifact{{Tag: "1.0.0", Digest: "sha256:abc"}}, artifacts)
+	})
+
+	t.Run("ErrorGettingRepos", func(t *testing.T) {
+		t.Parallel()
+		a := &argoCDService{
+			getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+				return nil, errors.New("unable to get repos")
+			},
+		}
+		_, err := a.GetOCIArtifacts(t.Context(), "oci://registry.example.com/charts/guestbook", "", []string{"1.0.0"})
+		require.EqualError(t, err, "error in GetRepository: unable to get repos")
+	})
+
+	t.Run("ErrorListingTags", func(t *testing.T) {
+		t.Parallel()
+		a := &argoCDService{
+			getRepository: getRepository,
+			listOCITagsFromRepoServer: func(_ context.Context, _ *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+				return nil, errors.New("unauthorized")
+			},
+		}
+		_, err := a.GetOCITags(t.Context(), "oci://registry.example.com/charts/guestbook", "")
+		require.EqualError(t, err, "error retrieving OCI tags: unauthorized")
+	})
+}
+
+func TestGetHelmChartVersions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HappyCase", func(t *testing.T) {
+		t.Parallel()
+		a := &argoCDService{
+			getRepository: func(_ context.Context, url, project string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{Repo: url, Type: "helm", Project: project}, nil
+			},
+			getHelmChartVersionsFromRepoServer: func(_ context.Context, req *apiclient.HelmChartVersionsRequest) (*apiclient.HelmChartVersionsResponse, error) {
+				assert.Equal(t, "https://charts.example.com", req.Repo.Repo)
+				assert.Equal(t, "project", req.Repo.Project)
+				assert.Equal(t, []string{"guestbook"}, req.Charts)
+				assert.True(t, req.NoCache)
+				return &apiclient.HelmChartVersionsResponse{Items: []*apiclient.HelmChartVersion{{Name: "guestbook", Version: "1.0.0"}}}, nil
+			},
+		}
+
+		versions, err := a.GetHelmChartVersions(t.Context(), "https://charts.example.com", "project", []string{"guestbook"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.HelmChartVersion{{Name: "guestbook", Version: "1.0.0"}}, versions)
+	})
+
+	t.Run("ErrorGettingVersions", func(t *testing.T) {
+		t.Parallel()
+		a := &argoCDService{
+			getRepository: func(_ context.Context, url, _ string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{Repo: url}, nil
+			},
+			getHelmChartVersionsFromRepoServer: func(_ context.Context, _ *apiclient.HelmChartVersionsRequest) (*apiclient.HelmChartVersionsResponse, error) {
+				return nil, errors.New("index not found")
+			},
+		}
+		_, err := a.GetHelmChartVersions(t.Context(), "https://charts.example.com", "", nil, false)
+		require.EqualError(t, err, "error retrieving Helm chart versions: index not found")
+	})
+}
+
+func TestGetRevisionMetadata(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HappyCase", func(t *testing.T) {
+		t.Parallel()
+		date := metav1.NewTime(time.Date(2026, 3, 2, 10, 15, 30, 0, time.UTC))
+		a := &argoCDService{
+			getRepository: func(_ context.Context, url, project string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{Repo: url, Project: project}, nil
+			},
+			getRevisionMetadataFromRepoServer: func(_ context.Context, req *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+				assert.Equal(t, "https://github.com/argoproj/argo-cd", req.Repo.Repo)
+				assert.Equal(t, "project", req.Repo.Project)
+				assert.Equal(t, "6dcb09b5b57875f334f61aebed695e2e4193db5e", req.Revision)
+				return &v1alpha1.RevisionMetadata{Author: "alice", Date: &date}, nil
+			},
+		}
+
+		metadata, err := a.GetRevisionMetadata(t.Context(), "https://github.com/argoproj/argo-cd", "project", "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+		require.NoError(t, err)
+		assert.Equal(t, &v1alpha1.RevisionMetadata{Author: "alice", Date: &date}, metadata)
+	})
+
+	t.Run("ErrorGettingMetadata", func(t *testing.T) {
+		t.Parallel()
+		a := &argoCDService{
+			getRepository: func(_ context.Context, url, _ string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{Repo: url}, nil
+			},
+			getRevisionMetadataFromRepoServer: func(_ context.Context, _ *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+				return nil, errors.New("revision not found")
+			},
+		}
+		_, err := a.GetRevisionMetadata(t.Context(), "https://github.com/argoproj/argo-cd", "", "unknown")
+		require.EqualError(t, err, "error retrieving revision metadata: revision not found")
+	})
+}
+
 func TestNewArgoCDService(t *testing.T) {
 	t.Parallel()
 	testNamespace := "test"
@@ -120,29 +120,16 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, dif
 		return controllerutil.OperationResultCreated, nil
 	}
 
-	normalizedLive := obj.DeepCopy()
-
-	// Mutate the live object to match the desired state.
-	if err := mutate(f, key, obj); err != nil {
-		return controllerutil.OperationResultNone, err
-	}
-
-	// Normalize the live spec to avoid spurious diffs from unimportant differences (e.g. nil vs
-	// empty SyncPolicy). obj.Spec is already normalized by the caller; only the live side needs it.
-	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
-
-	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
-	// prevents those differences from appearing in the diff and therefore in the patch.
-	err := applyIgnoreDifferences(diffConfig, normalizedLive, obj)
+	normalizedLive, err := mutateExisting(diffConfig, key, obj, f)
 	if err != nil {
-		return controllerutil.OperationResultNone, fmt.Errorf("failed to apply ignore differences: %w", err)
+		return controllerutil.OperationResultNone, err
 	}
 
 	// Note: if the informer cache holds a stale entry for an application that no longer exists on
 	// the API server, DeepEqual may match against that stale entry and we skip Patch here. The
 	// eviction in cacheSyncingClient only runs on NotFound from a write operation, so this edge
 	// case is not covered and relies on Kubernetes propagating the delete event to the informer.
-	if appEquality.DeepEqual(normalizedLive, obj) {
+	if normalizedLive == nil {
 		return controllerutil.OperationResultNone, nil
 	}
 
@@ -156,6 +143,58 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, dif
 	return controllerutil.OperationResultUpdated, nil
 }
 
+// DryRunCreateOrUpdate reports the operation CreateOrUpdate would execute for the given object, without creating or
+// patching it. For an update, it also returns the live object the mutated obj is compared to, i.e. normalized and
+// without the ignored differences, so that the caller can show what would change.
+func DryRunCreateOrUpdate(ctx context.Context, c client.Client, diffConfig argodiff.DiffConfig, obj *argov1alpha1.Application, f controllerutil.MutateFn) (controllerutil.OperationResult, *argov1alpha1.Application, error) {
+	key := client.ObjectKeyFromObject(obj)
+	if err := c.Get(ctx, key, obj); err != nil {
+		if !errors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, nil, err
+		}
+		if err := mutate(f, key, obj); err != nil {
+			return controllerutil.OperationResultNone, nil, err
+		}
+		return controllerutil.OperationResultCreated, nil, nil
+	}
+
+	normalizedLive, err := mutateExisting(diffConfig, key, obj, f)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, err
+	}
+	if normalizedLive == nil {
+		return controllerutil.OperationResultNone, nil, nil
+	}
+	return controllerutil.OperationResultUpdated, normalizedLive, nil
+}
+
+// mutateExisting mutates the live object fetched from the cluster to match the desired state, and returns the live
+// object normalized for the comparison with the mutated obj, or nil if both are equivalent.
+func mutateExisting(diffConfig argodiff.DiffConfig, key client.ObjectKey, obj *argov1alpha1.Application, f controllerutil.MutateFn) (*argov1alpha1.Application, error) {
+	normalizedLive := obj.DeepCopy()
+
+	// Mutate the live object to match the desired state.
+	if err := mutate(f, key, obj); err != nil {
+		return nil, err
+	}
+
+	// Normalize the live spec to avoid spurious diffs from unimportant differences (e.g. nil vs
+	// empty SyncPolicy). obj.Spec is already normalized by the caller; only the live side needs it.
+	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
+
+	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
+	// prevents those differences from appearing in the diff and therefore in the patch.
+	err := applyIgnoreDifferences(diffConfig, normalizedLive, obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+
+	if appEquality.DeepEqual(normalizedLive, obj) {
+		return nil, nil
+	}
+	return normalizedLive, nil
+}
+
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
 	patchBytes, err := patch.Data(obj)
 	if err != nil {
@@ -365,3 +365,77 @@ func TestCreateOrUpdateDoesNotRevertIgnoredKustomizeImagesMultiSource(t *testing
 	require.NotNil(t, persisted.Spec.Sources[1].Kustomize, "the ignored kustomize.images override must survive in the cluster")
 	require.Equal(t, live.Spec.Sources[1].Kustomize.Images, persisted.Spec.Sources[1].Kustomize.Images)
 }
+
+func TestDryRunCreateOrUpdate(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	live := &v1alpha1.Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source: &v1alpha1.ApplicationSource{
+				RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+				Path:           "guestbook",
+				TargetRevision: "v1",
+			},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(live.DeepCopy()).Build()
+	diffConfig, err := BuildIgnoreDiffConfig(v1alpha1.ApplicationSetIgnoreDifferences{
+		{JSONPointers: []string{"/spec/source/targetRevision"}},
+	}, normalizers.IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+
+	dryRun := func(generated *v1alpha1.Application) (controllerutil.OperationResult, *v1alpha1.Application, *v1alpha1.Application) {
+		obj := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: generated.Name, Namespace: generated.Namespace}}
+		op, normalizedLive, err := DryRunCreateOrUpdate(t.Context(), c, diffConfig, obj, func() error {
+			obj.Spec = generated.Spec
+			return nil
+		})
+		require.NoError(t, err)
+		return op, normalizedLive, obj
+	}
+
+	t.Run("created", func(t *testing.T) {
+		t.Parallel()
+
+		generated := live.DeepCopy()
+		generated.Name = "new"
+		op, normalizedLive, obj := dryRun(generated)
+		assert.Equal(t, controllerutil.OperationResultCreated, op)
+		assert.Nil(t, normalizedLive)
+		assert.Equal(t, generated.Spec, obj.Spec)
+	})
+
+	t.Run("updated", func(t *testing.T) {
+		t.Parallel()
+
+		generated := live.DeepCopy()
+		generated.Spec.Source.Path = "helm-guestbook"
+		generated.Spec.Source.TargetRevision = "v2"
+		op, normalizedLive, obj := dryRun(generated)
+		assert.Equal(t, controllerutil.OperationResultUpdated, op)
+		require.NotNil(t, normalizedLive)
+		assert.Equal(t, "guestbook", normalizedLive.Spec.Source.Path)
+		assert.Equal(t, "helm-guestbook", obj.Spec.Source.Path)
+
+		persisted := &v1alpha1.Application{}
+		require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(live), persisted))
+		assert.Equal(t, live.Spec, persisted.Spec, "the dry run must not patch the Application")
+	})
+
+	t.Run("unchanged except ignored differences", func(t *testing.T) {
+		t.Parallel()
+
+		generated := live.DeepCopy()
+		generated.Spec.Source.TargetRevision = "v2"
+		op, normalizedLive, _ := dryRun(generated)
+		assert.Equal(t, controllerutil.OperationResultNone, op)
+		assert.Nil(t, normalizedLive)
+	})
+}
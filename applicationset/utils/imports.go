@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"dario.cat/mergo"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ImportGeneratorTemplates returns a copy of the ApplicationSet in which the generator definitions and the template of
+// the GeneratorTemplates it imports are merged, or the ApplicationSet itself if it does not import any.
+//
+// The generator definitions of the ApplicationSet take precedence over the imported ones, and the generator definitions
+// of a GeneratorTemplate over the ones of the GeneratorTemplates imported before it. Likewise, the fields set by the
+// template of the ApplicationSet take precedence over the imported ones. The project of the Applications is never
+// imported, so that the RBAC of the ApplicationSet applies to the Applications it generates.
+func ImportGeneratorTemplates(ctx context.Context, c client.Client, appSet *argoprojiov1alpha1.ApplicationSet) (*argoprojiov1alpha1.ApplicationSet, error) {
+	if len(appSet.Spec.Imports) == 0 {
+		return appSet, nil
+	}
+
+	imported := appSet.DeepCopy()
+	definitions := map[string]argoprojiov1alpha1.ApplicationSetNestedGenerator{}
+	var templates []*argoprojiov1alpha1.ApplicationSetTemplate
+	for _, ref := range appSet.Spec.Imports {
+		generatorTemplate := &argoprojiov1alpha1.GeneratorTemplate{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: appSet.Namespace, Name: ref.Name}, generatorTemplate); err != nil {
+			return nil, fmt.Errorf("error getting imported GeneratorTemplate %s: %w", ref.Name, err)
+		}
+		for name, definition := range generatorTemplate.Spec.GeneratorDefinitions {
+			definitions[name] = *definition.DeepCopy()
+		}
+		if generatorTemplate.Spec.Template != nil {
+			template := generatorTemplate.Spec.Template.DeepCopy()
+			template.Spec.Project = ""
+			templates = append(templates, template)
+		}
+	}
+
+	for name, definition := range appSet.Spec.GeneratorDefinitions {
+		definitions[name] = *definition.DeepCopy()
+	}
+	imported.Spec.GeneratorDefinitions = definitions
+
+	// merging only sets the fields which are not set yet, so the last imported template is merged first
+	for _, template := range slices.Backward(templates) {
+		if err := mergo.Merge(&imported.Spec.Template, template); err != nil {
+			return nil, fmt.Errorf("error merging imported template: %w", err)
+		}
+	}
+	return imported, nil
+}
+
+// IsGeneratorTemplateImported returns true if the ApplicationSet imports the GeneratorTemplate with the given name
+func IsGeneratorTemplateImported(appSet *argoprojiov1alpha1.ApplicationSet, name string) bool {
+	for _, ref := range appSet.Spec.Imports {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
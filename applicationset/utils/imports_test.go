@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestImportGeneratorTemplates(t *testing.T) {
+	t.Parallel()
+
+	listGenerator := func(element string) argoprojiov1alpha1.ApplicationSetNestedGenerator {
+		return argoprojiov1alpha1.ApplicationSetNestedGenerator{
+			List: &argoprojiov1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{{Raw: []byte(element)}}},
+		}
+	}
+
+	base := &argoprojiov1alpha1.GeneratorTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "argocd"},
+		Spec: argoprojiov1alpha1.GeneratorTemplateSpec{
+			GeneratorDefinitions: map[string]argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				"clusters": listGenerator(`{"cluster":"base"}`),
+				"envs":     listGenerator(`{"env":"base"}`),
+			},
+			Template: &argoprojiov1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
+					Labels: map[string]string{"team": "base"},
+				},
+				Spec: argoprojiov1alpha1.ApplicationSpec{
+					Project: "base",
+					Source: &argoprojiov1alpha1.ApplicationSource{
+						RepoURL:        "https://github.com/argoproj/argo-cd.git",
+						TargetRevision: "HEAD",
+					},
+					Destination: argoprojiov1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc"},
+				},
+			},
+		},
+	}
+	production := &argoprojiov1alpha1.GeneratorTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: "argocd"},
+		Spec: argoprojiov1alpha1.GeneratorTemplateSpec{
+			GeneratorDefinitions: map[string]argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				"clusters": listGenerator(`{"cluster":"production"}`),
+			},
+			Template: &argoprojiov1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
+					Labels: map[string]string{"environment": "production"},
+				},
+				Spec: argoprojiov1alpha1.ApplicationSpec{
+					Source: &argoprojiov1alpha1.ApplicationSource{TargetRevision: "stable"},
+				},
+			},
+		},
+	}
+	otherNamespace := production.DeepCopy()
+	otherNamespace.Name = "other"
+	otherNamespace.Namespace = "other"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, argoprojiov1alpha1.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(base, production, otherNamespace).Build()
+
+	t.Run("merges the imported generator definitions and templates", func(t *testing.T) {
+		t.Parallel()
+
+		appSet := &argoprojiov1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec: argoprojiov1alpha1.ApplicationSetSpec{
+				Imports: []argoprojiov1alpha1.ApplicationSetImport{{Name: "base"}, {Name: "production"}},
+				GeneratorDefinitions: map[string]argoprojiov1alpha1.ApplicationSetNestedGenerator{
+					"envs": listGenerator(`{"env":"guestbook"}`),
+				},
+				Template: argoprojiov1alpha1.ApplicationSetTemplate{
+					ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
+						Name:   "{{.cluster}}-guestbook",
+						Labels: map[string]string{"team": "guestbook"},
+					},
+					Spec: argoprojiov1alpha1.ApplicationSpec{
+						Destination: argoprojiov1alpha1.ApplicationDestination{Namespace: "guestbook"},
+					},
+				},
+			},
+		}
+		original := appSet.DeepCopy()
+
+		imported, err := ImportGeneratorTemplates(t.Context(), client, appSet)
+		require.NoError(t, err)
+		assert.Equal(t, original, appSet, "the ApplicationSet must not be modified")
+		assert.Equal(t, map[string]argoprojiov1alpha1.ApplicationSetNestedGenerator{
+			"clusters": listGenerator(`{"cluster":"production"}`),
+			"envs":     listGenerator(`{"env":"guestbook"}`),
+		}, imported.Spec.GeneratorDefinitions)
+		assert.Equal(t, argoprojiov1alpha1.ApplicationSetTemplate{
+			ApplicationSetTemplateMeta: argoprojiov1alpha1.ApplicationSetTemplateMeta{
+				Name:   "{{.cluster}}-guestbook",
+				Labels: map[string]string{"team": "guestbook", "environment": "production"},
+			},
+			Spec: argoprojiov1alpha1.ApplicationSpec{
+				Source: &argoprojiov1alpha1.ApplicationSource{
+					RepoURL:        "https://github.com/argoproj/argo-cd.git",
+					TargetRevision: "stable",
+				},
+				Destination: argoprojiov1alpha1.ApplicationDestination{
+					Server:    "https://kubernetes.default.svc",
+					Namespace: "guestbook",
+				},
+			},
+		}, imported.Spec.Template)
+	})
+
+	t.Run("returns the ApplicationSet without imports", func(t *testing.T) {
+		t.Parallel()
+
+		appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}
+		imported, err := ImportGeneratorTemplates(t.Context(), client, appSet)
+		require.NoError(t, err)
+		assert.Same(t, appSet, imported)
+	})
+
+	t.Run("only imports GeneratorTemplates of the namespace of the ApplicationSet", func(t *testing.T) {
+		t.Parallel()
+
+		appSet := &argoprojiov1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec: argoprojiov1alpha1.ApplicationSetSpec{
+				Imports: []argoprojiov1alpha1.ApplicationSetImport{{Name: "other"}},
+			},
+		}
+		_, err := ImportGeneratorTemplates(t.Context(), client, appSet)
+		require.ErrorContains(t, err, "error getting imported GeneratorTemplate other")
+	})
+}
+
+func TestIsGeneratorTemplateImported(t *testing.T) {
+	t.Parallel()
+
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Imports: []argoprojiov1alpha1.ApplicationSetImport{{Name: "base"}},
+		},
+	}
+	assert.True(t, IsGeneratorTemplateImported(appSet, "base"))
+	assert.False(t, IsGeneratorTemplateImported(appSet, "production"))
+}
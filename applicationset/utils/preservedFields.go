@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"strings"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// NotifiedAnnotationKey is the annotation in which the notifications controller records the notifications it sent.
+// Rather than importing the whole argocd-notifications controller, just copying the const here
+//   - https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/subscriptions.go#L12
+//   - https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
+const NotifiedAnnotationKey = "notified.notifications.argoproj.io"
+
+var defaultPreservedFinalizers = []string{
+	argov1alpha1.PreDeleteFinalizerName,
+	argov1alpha1.PostDeleteFinalizerName,
+}
+
+var defaultPreservedAnnotations = []string{
+	NotifiedAnnotationKey,
+	argov1alpha1.AnnotationKeyRefresh,
+	argov1alpha1.AnnotationKeyHydrate,
+}
+
+// ApplyGeneratedApplication copies the significant fields of the generated Application to the Application found in the
+// cluster. The annotations and labels of the found Application listed in the preserved fields of the ApplicationSet,
+// in the global preserved fields or in the defaults, as well as its deletion hook finalizers, are kept.
+func ApplyGeneratedApplication(found *argov1alpha1.Application, generatedApp *argov1alpha1.Application, preservedFields *argov1alpha1.ApplicationPreservedFields, globalPreservedAnnotations []string, globalPreservedLabels []string) {
+	found.Spec = generatedApp.Spec
+
+	// allow setting the Operation field to trigger a sync operation on an Application
+	if generatedApp.Operation != nil {
+		found.Operation = generatedApp.Operation
+	}
+
+	preservedAnnotations := make([]string, 0)
+	preservedLabels := make([]string, 0)
+
+	if preservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, preservedFields.Annotations...)
+		preservedLabels = append(preservedLabels, preservedFields.Labels...)
+	}
+
+	if len(globalPreservedAnnotations) > 0 {
+		preservedAnnotations = append(preservedAnnotations, globalPreservedAnnotations...)
+	}
+
+	if len(globalPreservedLabels) > 0 {
+		preservedLabels = append(preservedLabels, globalPreservedLabels...)
+	}
+
+	// Preserve specially treated argo cd annotations:
+	// * https://github.com/argoproj/applicationset/issues/180
+	// * https://github.com/argoproj/argo-cd/issues/10500
+	preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
+
+	for _, key := range preservedAnnotations {
+		if state, exists := found.Annotations[key]; exists {
+			if generatedApp.Annotations == nil {
+				generatedApp.Annotations = map[string]string{}
+			}
+			generatedApp.Annotations[key] = state
+		}
+	}
+
+	for _, key := range preservedLabels {
+		if state, exists := found.Labels[key]; exists {
+			if generatedApp.Labels == nil {
+				generatedApp.Labels = map[string]string{}
+			}
+			generatedApp.Labels[key] = state
+		}
+	}
+
+	// Preserve deleting finalizers and avoid diff conflicts
+	for _, finalizer := range defaultPreservedFinalizers {
+		for _, f := range found.Finalizers {
+			// For finalizers, use prefix matching in case it contains "/" stages
+			if strings.HasPrefix(f, finalizer) {
+				generatedApp.Finalizers = append(generatedApp.Finalizers, f)
+			}
+		}
+	}
+
+	found.Annotations = generatedApp.Annotations
+	found.Labels = generatedApp.Labels
+	found.Finalizers = generatedApp.Finalizers
+}
@@ -47,6 +47,7 @@ type WebhookHandler struct {
 type gitGeneratorInfo struct {
 	Revision    string
 	TouchedHead bool
+	TouchedTag  bool
 	RepoRegexp  *regexp.Regexp
 }
 
@@ -142,7 +143,8 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 				shouldRefreshPRGenerator(gen.PullRequest, prGenInfo) ||
 				shouldRefreshPluginGenerator(gen.Plugin) ||
 				h.shouldRefreshMatrixGenerator(gen.Matrix, &appSet, gitGenInfo, prGenInfo) ||
-				h.shouldRefreshMergeGenerator(gen.Merge, &appSet, gitGenInfo, prGenInfo)
+				h.shouldRefreshMergeGenerator(gen.Merge, &appSet, gitGenInfo, prGenInfo) ||
+				h.shouldRefreshGeneratorRef(gen.GeneratorRef, &appSet, gitGenInfo, prGenInfo)
 			if shouldRefresh {
 				break
 			}
@@ -196,21 +198,29 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 func getGitGeneratorInfo(payload any) *gitGeneratorInfo {
 	var (
 		webURL      string
+		ref         string
 		revision    string
 		touchedHead bool
 	)
 	switch payload := payload.(type) {
 	case github.PushPayload:
 		webURL = payload.Repository.HTMLURL
+		ref = payload.Ref
 		revision = webhook.ParseRevision(payload.Ref)
 		touchedHead = payload.Repository.DefaultBranch == revision
 	case gitlab.PushEventPayload:
 		webURL = payload.Project.WebURL
+		ref = payload.Ref
 		revision = webhook.ParseRevision(payload.Ref)
 		touchedHead = payload.Project.DefaultBranch == revision
+	case gitlab.TagEventPayload:
+		webURL = payload.Project.WebURL
+		ref = payload.Ref
+		revision = webhook.ParseRevision(payload.Ref)
 	case azuredevops.GitPushEvent:
 		// See: https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#git.push
 		webURL = payload.Resource.Repository.RemoteURL
+		ref = payload.Resource.RefUpdates[0].Name
 		revision = webhook.ParseRevision(payload.Resource.RefUpdates[0].Name)
 		touchedHead = payload.Resource.RefUpdates[0].Name == payload.Resource.Repository.DefaultBranch
 		// unfortunately, Azure DevOps doesn't provide a list of changed files
@@ -228,6 +238,7 @@ func getGitGeneratorInfo(payload any) *gitGeneratorInfo {
 	return &gitGeneratorInfo{
 		RepoRegexp:  repoRegexp,
 		TouchedHead: touchedHead,
+		TouchedTag:  strings.HasPrefix(ref, "refs/tags/"),
 		Revision:    revision,
 	}
 }
@@ -321,6 +332,9 @@ func shouldRefreshGitGenerator(gen *v1alpha1.GitGenerator, info *gitGeneratorInf
 	if !gitGeneratorUsesURL(gen, info.Revision, info.RepoRegexp) {
 		return false
 	}
+	if gen.Tags != nil {
+		return info.TouchedTag
+	}
 	if !genRevisionHasChanged(gen, info.Revision, info.TouchedHead) {
 		return false
 	}
@@ -427,7 +441,8 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 
 	// Check first child generator for Git or Pull Request Generator
 	if shouldRefreshGitGenerator(g0.Git, gitGenInfo) ||
-		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) {
+		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) ||
+		h.shouldRefreshGeneratorRef(g0.GeneratorRef, appSet, gitGenInfo, prGenInfo) {
 		return true
 	}
 
@@ -474,6 +489,10 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		OCI:                     g0.OCI,
+		HelmChart:               g0.HelmChart,
+		KubernetesResource:      g0.KubernetesResource,
+		GeneratorRef:            g0.GeneratorRef,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -529,6 +548,10 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		OCI:                     g1.OCI,
+		HelmChart:               g1.HelmChart,
+		KubernetesResource:      g1.KubernetesResource,
+		GeneratorRef:            g1.GeneratorRef,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
@@ -548,7 +571,8 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 				shouldRefreshPRGenerator(interpolatedGenerator.PullRequest, prGenInfo) ||
 				shouldRefreshPluginGenerator(interpolatedGenerator.Plugin) ||
 				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo) ||
-				h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo) {
+				h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo) ||
+				h.shouldRefreshGeneratorRef(interpolatedGenerator.GeneratorRef, appSet, gitGenInfo, prGenInfo) {
 				return true
 			}
 		}
@@ -559,7 +583,8 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		shouldRefreshPRGenerator(requestedGenerator1.PullRequest, prGenInfo) ||
 		shouldRefreshPluginGenerator(requestedGenerator1.Plugin) ||
 		h.shouldRefreshMatrixGenerator(requestedGenerator1.Matrix, appSet, gitGenInfo, prGenInfo) ||
-		h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo)
+		h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo) ||
+		h.shouldRefreshGeneratorRef(requestedGenerator1.GeneratorRef, appSet, gitGenInfo, prGenInfo)
 }
 
 func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
@@ -570,7 +595,8 @@ func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerato
 	for _, g := range gen.Generators {
 		// Check Git or Pull Request generator
 		if shouldRefreshGitGenerator(g.Git, gitGenInfo) ||
-			shouldRefreshPRGenerator(g.PullRequest, prGenInfo) {
+			shouldRefreshPRGenerator(g.PullRequest, prGenInfo) ||
+			h.shouldRefreshGeneratorRef(g.GeneratorRef, appSet, gitGenInfo, prGenInfo) {
 			return true
 		}
 
@@ -608,6 +634,25 @@ func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerato
 	return false
 }
 
+// shouldRefreshGeneratorRef checks the generator definition referenced by a generator, if any
+func (h *WebhookHandler) shouldRefreshGeneratorRef(ref *v1alpha1.GeneratorReference, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
+	if ref == nil {
+		return false
+	}
+
+	// Silently ignore, the ApplicationSetReconciler will log the error as part of the reconcile
+	gen, err := generators.ResolveGeneratorRef(v1alpha1.ApplicationSetGenerator{GeneratorRef: ref}, appSet)
+	if err != nil {
+		return false
+	}
+
+	return shouldRefreshGitGenerator(gen.Git, gitGenInfo) ||
+		shouldRefreshPRGenerator(gen.PullRequest, prGenInfo) ||
+		shouldRefreshPluginGenerator(gen.Plugin) ||
+		h.shouldRefreshMatrixGenerator(gen.Matrix, appSet, gitGenInfo, prGenInfo) ||
+		h.shouldRefreshMergeGenerator(gen.Merge, appSet, gitGenInfo, prGenInfo)
+}
+
 func refreshApplicationSet(c client.Client, appSet *v1alpha1.ApplicationSet) error {
 	// patch the ApplicationSet with the refresh annotation to reconcile
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
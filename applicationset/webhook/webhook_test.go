@@ -28,6 +28,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	argosettings "github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/webhook"
 )
 
 type generatorMock struct {
@@ -63,7 +64,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-GitHub-Event",
 			headerValue:        "push",
 			payloadFile:        "github-commit-event.json",
-			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "merge-nested-git-github", "plugin", "matrix-pull-request-github-plugin"},
+			effectedAppSets:    []string{"git-github", "git-github-ssh", "git-github-alt-ssh", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "merge-nested-git-github", "matrix-generator-ref-git-github", "plugin", "matrix-pull-request-github-plugin"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -230,6 +231,7 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithMergeAndGitGenerator("merge-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMergeAndPullRequestGenerator("merge-pull-request-github", namespace, "Codertocat", "Hello-World"),
 				fakeAppWithMergeAndNestedGitGenerator("merge-nested-git-github", namespace, "https://github.com/org/repo"),
+				fakeAppWithMatrixAndGeneratorRefGitGenerator("matrix-generator-ref-git-github", namespace, "https://github.com/org/repo"),
 			).Build()
 			set := argosettings.NewSettingsManager(t.Context(), fakeClient, namespace)
 			h, err := NewWebhookHandler(webhookParallelism, set, fc, mockGenerators())
@@ -386,6 +388,16 @@ func TestGenRevisionHasChanged(t *testing.T) {
 	}
 }
 
+func TestShouldRefreshGitGenerator_Tags(t *testing.T) {
+	t.Parallel()
+	repoRegexp, err := webhook.GetWebURLRegex("https://github.com/org/repo")
+	require.NoError(t, err)
+	gen := &v1alpha1.GitGenerator{RepoURL: "https://github.com/org/repo", Tags: &v1alpha1.GitTagsGeneratorItem{}}
+
+	assert.True(t, shouldRefreshGitGenerator(gen, &gitGeneratorInfo{Revision: "v1.0.0", TouchedTag: true, RepoRegexp: repoRegexp}))
+	assert.False(t, shouldRefreshGitGenerator(gen, &gitGeneratorInfo{Revision: "main", TouchedHead: true, RepoRegexp: repoRegexp}))
+}
+
 func fakeAppWithGitGenerator(name, namespace, repo string) *v1alpha1.ApplicationSet {
 	return &v1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -734,6 +746,40 @@ func fakeAppWithMergeAndNestedGitGenerator(name, namespace, repo string) *v1alph
 	}
 }
 
+func fakeAppWithMatrixAndGeneratorRefGitGenerator(name, namespace, repo string) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GeneratorDefinitions: map[string]v1alpha1.ApplicationSetNestedGenerator{
+				"git": {
+					Git: &v1alpha1.GitGenerator{
+						RepoURL: repo,
+					},
+				},
+			},
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					Matrix: &v1alpha1.MatrixGenerator{
+						Generators: []v1alpha1.ApplicationSetNestedGenerator{
+							{
+								List: &v1alpha1.ListGenerator{},
+							},
+							{
+								GeneratorRef: &v1alpha1.GeneratorReference{
+									Name: "git",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func fakeAppWithPluginGenerator(name, namespace string) *v1alpha1.ApplicationSet {
 	return &v1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -36,6 +36,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/synccalendar"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	"github.com/argoproj/argo-cd/v3/util/trace"
 )
@@ -137,6 +138,7 @@ func NewCommand() *cobra.Command {
 
 			kubeClient := kubernetes.NewForConfigOrDie(config)
 			appClient := appclientset.NewForConfigOrDie(config)
+			v1alpha1.SetSyncWindowCalendarLoader(synccalendar.NewLoader(kubeClient, namespace))
 
 			hardResyncDuration := time.Duration(appHardResyncPeriod) * time.Second
 
@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -36,6 +37,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	utilglob "github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/synccalendar"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -96,11 +98,15 @@ func NewCommand() *cobra.Command {
 		disableSwaggerUI         bool
 
 		// ApplicationSet
-		enableNewGitFileGlobbing bool
-		scmRootCAPath            string
-		allowedScmProviders      []string
-		enableScmProviders       bool
-		enableGitHubAPIMetrics   bool
+		enableNewGitFileGlobbing   bool
+		scmRootCAPath              string
+		allowedScmProviders        []string
+		enableScmProviders         bool
+		enableGitHubAPIMetrics     bool
+		globalPreservedAnnotations []string
+		globalPreservedLabels      []string
+		appSetPolicy               string
+		enablePolicyOverride       bool
 
 		// argocd k8s event logging flag
 		enableK8sEvent []string
@@ -150,6 +156,7 @@ func NewCommand() *cobra.Command {
 			errors.CheckError(err)
 
 			kubeclientset := kubernetes.NewForConfigOrDie(config)
+			v1alpha1.SetSyncWindowCalendarLoader(synccalendar.NewLoader(kubeclientset, namespace))
 
 			appclientsetConfig, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
@@ -262,6 +269,11 @@ func NewCommand() *cobra.Command {
 				DisableSwaggerUI:        disableSwaggerUI,
 			}
 
+			policy, exists := appsetutils.Policies[appSetPolicy]
+			if !exists {
+				errors.CheckError(fmt.Errorf("invalid ApplicationSet policy %q, policy value can be: sync, create-only, create-update, create-delete", appSetPolicy))
+			}
+
 			appsetOpts := server.ApplicationSetOpts{
 				GitSubmoduleEnabled:      gitSubmoduleEnabled,
 				EnableNewGitFileGlobbing: enableNewGitFileGlobbing,
@@ -269,6 +281,11 @@ func NewCommand() *cobra.Command {
 				AllowedScmProviders:      allowedScmProviders,
 				EnableScmProviders:       enableScmProviders,
 				EnableGitHubAPIMetrics:   enableGitHubAPIMetrics,
+
+				GlobalPreservedAnnotations: globalPreservedAnnotations,
+				GlobalPreservedLabels:      globalPreservedLabels,
+				Policy:                     policy,
+				EnablePolicyOverride:       enablePolicyOverride,
 			}
 
 			stats.RegisterStackDumper()
@@ -353,6 +370,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&allowedScmProviders, "appset-allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
 	command.Flags().BoolVar(&enableNewGitFileGlobbing, "appset-enable-new-git-file-globbing", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_NEW_GIT_FILE_GLOBBING", false), "Enable new globbing in Git files generator.")
 	command.Flags().BoolVar(&enableGitHubAPIMetrics, "appset-enable-github-api-metrics", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_GITHUB_API_METRICS", false), "Enable GitHub API metrics for generators that use the GitHub API")
+	command.Flags().StringSliceVar(&globalPreservedAnnotations, "appset-preserved-annotations", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_ANNOTATIONS", []string{}, ","), "Global preserved field values for annotations of the ApplicationSet controller, used to compute the changes of ApplicationSets")
+	command.Flags().StringSliceVar(&globalPreservedLabels, "appset-preserved-labels", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_LABELS", []string{}, ","), "Global preserved field values for labels of the ApplicationSet controller, used to compute the changes of ApplicationSets")
+	command.Flags().StringVar(&appSetPolicy, "appset-policy", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_POLICY", ""), "Policy of the ApplicationSet controller, used to compute the changes of ApplicationSets. One of: sync, create-only, create-update, create-delete (Default: '' (empty), which means ApplicationSets default to 'sync')")
+	command.Flags().BoolVar(&enablePolicyOverride, "appset-enable-policy-override", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_POLICY_OVERRIDE", appSetPolicy == ""), "Whether the ApplicationSet controller allows ApplicationSets to override its policy, used to compute the changes of ApplicationSets")
 
 	repoServerClientTLSConfigSrc = tls.AddClientTLSFlagsToCmdWithPrefix(command, "SERVER")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
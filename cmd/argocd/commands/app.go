@@ -1708,6 +1708,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		selector                  string
 		prune                     bool
 		dryRun                    bool
+		plan                      bool
+		planID                    string
 		timeout                   uint
 		strategy                  string
 		force                     bool
@@ -1752,6 +1754,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
   argocd app sync my-app --revisions 0.0.1 --source-names my-chart --revisions 0.0.2 --source-names my-values
 
+  # Preview the changes a sync would make, validated by the Kubernetes API server, without applying them
+  argocd app sync my-app --plan
+
+  # Apply exactly the previewed sync plan, refusing to sync if Git or the live state changed since it was previewed
+  argocd app sync my-app --plan-id "$PLAN_ID"
+
   # Sync a specific resource
   # Resource should be formatted as GROUP:KIND:NAME. If no GROUP is specified then :KIND:NAME
   argocd app sync my-app --resource :Service:my-service
@@ -1783,6 +1791,18 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				log.Fatal("Only one of source-positions and source-names can be specified.")
 			}
 
+			if planID != "" {
+				if len(args) != 1 {
+					log.Fatal("Cannot use --plan-id option when 0 or more than 1 application names are passed as argument(s)")
+				}
+				// the sync uses the options the plan was previewed with
+				for _, name := range []string{"plan", "dry-run", "revision", "revisions", "source-positions", "source-names", "resource", "label", "local", "prune", "strategy", "force", "replace", "server-side", "apply-out-of-sync-only", "preview-changes"} {
+					if c.Flags().Changed(name) {
+						log.Fatalf("Cannot use --%s option with --plan-id", name)
+					}
+				}
+			}
+
 			if len(sourcePositions) > 0 && len(revisions) != len(sourcePositions) {
 				log.Fatal("While using --revisions and --source-positions, length of values for both flags should be same.")
 			}
@@ -1797,6 +1817,11 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			// a plan is a dry-run of the sync
+			if plan {
+				dryRun = true
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDieWithContext(ctx)
 			defer utilio.Close(conn)
@@ -1978,6 +2003,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					Name:            &appName,
 					AppNamespace:    &appNs,
 					DryRun:          &dryRun,
+					Plan:            &plan,
+					PlanID:          &planID,
 					Revision:        &revision,
 					Resources:       filteredResources,
 					Prune:           &prune,
@@ -2042,6 +2069,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
 					errors.CheckError(err)
 
+					if plan && (output == "wide" || output == "") && opState.SyncResult != nil {
+						printSyncPlan(opState.SyncResult.Plan)
+					}
+
 					if !dryRun {
 						if !opState.Phase.Successful() {
 							log.Fatalf("Operation has completed with phase: %s", opState.Phase)
@@ -2058,6 +2089,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		}),
 	}
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().BoolVar(&plan, "plan", false, "Preview the phases, waves and actions of the sync using a server-side dry-run, without affecting cluster")
+	command.Flags().StringVar(&planID, "plan-id", "", "Apply the sync plan with this ID, using the options it was previewed with. Fails if the target revision or the live state changed since it was previewed")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%[1]sKIND%[1]sNAME or %[2]sGROUP%[1]sKIND%[1]sNAME. Fields may be blank and '*' can be used. This option may be specified repeatedly", resourceFieldDelimiter, resourceExcludeIndicator))
@@ -2795,6 +2828,24 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	}
 }
 
+// printSyncPlan prints the steps of a sync plan
+func printSyncPlan(plan *argoappv1.SyncPlan) {
+	if plan == nil {
+		return
+	}
+	fmt.Println()
+	fmt.Printf(printOpFmtStr, "Plan ID:", plan.ID)
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "PHASE\tWAVE\tACTION\tKIND\tNAMESPACE\tNAME\tMODIFIED\tSTATUS\tMESSAGE\n")
+	for _, step := range plan.Steps {
+		for _, res := range step.Resources {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%t\t%s\t%s\n", step.Phase, step.Wave, res.Action, res.Kind, res.Namespace, res.Name, res.Modified, res.Status, res.Message)
+		}
+	}
+	_ = w.Flush()
+}
+
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
 func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
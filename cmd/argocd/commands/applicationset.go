@@ -6,10 +6,13 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"strings"
 	"text/tabwriter"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8swatch "k8s.io/apimachinery/pkg/watch"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
@@ -44,7 +47,7 @@ var appSetExample = templates.Examples(`
 
 	# Namespace precedence for --appset-namespace (-N):
 	# - get/delete: if the argument is namespace/name, that namespace wins; -N is ignored.
-	# - create/generate: metadata.namespace in the YAML wins when set; -N applies only when the manifest omits namespace.
+	# - create/generate/diff: metadata.namespace in the YAML wins when set; -N applies only when the manifest omits namespace.
 	`)
 
 // NewAppSetCommand returns a new instance of an `argocd appset` command
@@ -63,6 +66,8 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetDiffCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutCommand(clientOpts))
 	return command
 }
 
@@ -323,6 +328,95 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationSetDiffCommand returns a new instance of an `argocd appset diff` command
+func NewApplicationSetDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appSetNamespace string
+		exitCode        bool
+		diffExitCode    int
+	)
+	shortDesc := "Perform a diff between the Applications generated by an ApplicationSet and the live Applications."
+	command := &cobra.Command{
+		Use:   "diff",
+		Short: shortDesc,
+		Long:  shortDesc + "\nLists the Applications which would be created, updated or deleted, according to the preserved fields, the ignored Application differences and the policy of the ApplicationSet.\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
+		Example: templates.Examples(`
+	# Diff the Applications generated by an ApplicationSet against the live Applications
+	argocd appset diff <filename or URL>
+
+	# Diff the Applications generated by an ApplicationSet in a specific namespace
+	argocd appset diff --appset-namespace=APPSET_NAMESPACE <filename or URL>
+`),
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(2)
+			}
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			appsets, err := cmdutil.ConstructApplicationSet(args[0])
+			errors.CheckError(err)
+
+			if len(appsets) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Input file must contain one ApplicationSet")
+			}
+			appset := appsets[0]
+			if appset.Name == "" {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Error diffing apps for ApplicationSet %s. ApplicationSet does not have Name field set", appset))
+			}
+
+			if appset.Namespace == "" && appSetNamespace != "" {
+				fmt.Printf("ApplicationSet YAML file does not have namespace; using --appset-namespace=%q.\n", appSetNamespace)
+				appset.Namespace = appSetNamespace
+			}
+
+			conn, appIf := argocdClient.NewApplicationSetClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			resp, err := appIf.Diff(ctx, &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appset})
+			errors.CheckError(err)
+
+			for _, diff := range resp.Diffs {
+				printApplicationSetApplicationDiff(diff)
+			}
+			if len(resp.Diffs) > 0 && exitCode {
+				os.Exit(diffExitCode)
+			}
+		}),
+	}
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Namespace of the ApplicationSet (ignored when provided YAML file has namespace set in metadata)")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff. May also return non-zero exit code if there is an error.")
+	command.Flags().IntVar(&diffExitCode, "diff-exit-code", 1, "Return specified exit code when there is a diff. Typical error code is 20 but use another exit code if you want to differentiate from the generic exit code (20) returned by all CLI commands.")
+	return command
+}
+
+// printApplicationSetApplicationDiff prints the action and the diff of an Application generated by an ApplicationSet
+func printApplicationSetApplicationDiff(diff *applicationset.ApplicationSetApplicationDiff) {
+	var live, target *unstructured.Unstructured
+	var app *arogappsetv1.Application
+	if diff.LiveState != nil {
+		app = diff.LiveState
+		live = applicationToUnstructured(diff.LiveState)
+	}
+	if diff.TargetState != nil {
+		app = diff.TargetState
+		target = applicationToUnstructured(diff.TargetState)
+	}
+	fmt.Printf("\n===== %s Application %s/%s ======\n", diff.Action, app.Namespace, app.Name)
+	_ = cli.PrintDiff(app.Name, live, target)
+}
+
+func applicationToUnstructured(app *arogappsetv1.Application) *unstructured.Unstructured {
+	app = app.DeepCopy()
+	// backfill api version and kind because k8s client always return empty values for these fields
+	app.APIVersion = arogappsetv1.ApplicationSchemaGroupVersionKind.GroupVersion().String()
+	app.Kind = arogappsetv1.ApplicationSchemaGroupVersionKind.Kind
+	obj, err := kube.ToUnstructured(app)
+	errors.CheckError(err)
+	return obj
+}
+
 // NewApplicationSetListCommand returns a new instance of an `argocd appset list` command
 func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -457,6 +551,74 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetRolloutCommand returns a new instance of an `argocd appset rollout` command
+func NewApplicationSetRolloutCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage the progressive sync rollout of an ApplicationSet",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(newApplicationSetRolloutDecisionCommand(clientOpts, "promote", "Approve a rollout step which requires manual approval", true))
+	command.AddCommand(newApplicationSetRolloutDecisionCommand(clientOpts, "abort", "Abort the rollout at a step which requires manual approval", false))
+	return command
+}
+
+func newApplicationSetRolloutDecisionCommand(clientOpts *argocdclient.ClientOptions, use string, short string, promote bool) *cobra.Command {
+	var (
+		step            int64
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   use + " APPSETNAME",
+		Short: short,
+		Example: templates.Examples(fmt.Sprintf(`
+	# %[1]s the step which is currently waiting for approval
+	argocd appset rollout %[2]s APPSETNAME
+
+	# %[1]s a specific step
+	argocd appset rollout %[2]s APPSETNAME --step 2
+
+	# %[1]s the rollout of an ApplicationSet in a specific namespace
+	argocd appset rollout %[2]s APPSET_NAMESPACE/APPSETNAME
+		`, strings.ToUpper(use[:1])+use[1:], use)),
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			req := applicationset.ApplicationSetRolloutRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Step:            step,
+			}
+			var err error
+			if promote {
+				_, err = appIf.PromoteRollout(ctx, &req)
+			} else {
+				_, err = appIf.AbortRollout(ctx, &req)
+			}
+			errors.CheckError(err)
+			if promote {
+				fmt.Printf("applicationset '%s' rollout promoted\n", args[0])
+			} else {
+				fmt.Printf("applicationset '%s' rollout aborted\n", args[0])
+			}
+		}),
+	}
+	command.Flags().Int64Var(&step, "step", 0, "The 1-based rollout step to "+use+" (defaults to the step which is waiting for approval)")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Namespace of the ApplicationSet (ignored when qualified name is provided)")
+	return command
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
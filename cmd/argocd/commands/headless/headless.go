@@ -39,6 +39,7 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
+	"github.com/argoproj/argo-cd/v3/util/synccalendar"
 )
 
 type forwardCacheClient struct {
@@ -260,6 +261,7 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 	if err != nil {
 		return nil, fmt.Errorf("error getting namespace: %w", err)
 	}
+	v1alpha1.SetSyncWindowCalendarLoader(synccalendar.NewLoader(kubeClientset, namespace))
 
 	mr, err := miniredis.Run()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -36,7 +37,10 @@ argocd proj windows add my-project \
 argocd proj windows delete <project-name> <window-id>
 
 #List project sync windows
-argocd proj windows list <project-name>`,
+argocd proj windows list <project-name>
+
+#Show when syncs of an application are allowed during the next week
+argocd proj windows simulate <project-name> --app <app-name>`,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 			os.Exit(1)
@@ -50,6 +54,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsSimulateCommand(clientOpts))
 	return roleCommand
 }
 
@@ -397,6 +402,95 @@ argocd proj windows list test-project`,
 	return command
 }
 
+// NewProjectWindowsSimulateCommand returns a new instance of an `argocd proj windows simulate` command
+func NewProjectWindowsSimulateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appName      string
+		appNamespace string
+		namespace    string
+		cluster      string
+		from         string
+		to           string
+		output       string
+	)
+	command := &cobra.Command{
+		Use:   "simulate PROJECT",
+		Short: "Show when the sync windows of a project allow syncs over a time range",
+		Example: `
+#Show when syncs are allowed during the next week
+argocd proj windows simulate PROJECT
+
+#Show when syncs of an application are allowed during a weekend
+argocd proj windows simulate PROJECT --app guestbook --from 2025-06-06T18:00:00Z --to 2025-06-09T06:00:00Z
+
+#Show when syncs to a namespace of a cluster are allowed, in yaml format
+argocd proj windows simulate PROJECT --namespace default --cluster in-cluster -o yaml`,
+		Run: cli.WithSignalContext(func(c *cobra.Command, args []string, _ context.CancelFunc) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDieWithContext(ctx)
+			defer utilio.Close(conn)
+
+			res, err := projIf.SimulateSyncWindows(ctx, &projectpkg.SyncWindowsSimulationRequest{
+				Name:         projName,
+				Application:  appName,
+				AppNamespace: appNamespace,
+				Namespace:    namespace,
+				Cluster:      cluster,
+				From:         from,
+				To:           to,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(res.Periods, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSyncWindowPeriods(res.Periods)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		}),
+	}
+	command.Flags().StringVar(&appName, "app", "", "Name of an application of the project to simulate the sync windows for")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().StringVar(&namespace, "namespace", "", "Destination namespace to simulate the sync windows for, overrides the one of the application")
+	command.Flags().StringVar(&cluster, "cluster", "", "Name or server URL of the destination cluster to simulate the sync windows for, overrides the one of the application")
+	command.Flags().StringVar(&from, "from", "", "Start of the time range in RFC 3339 format (default: now)")
+	command.Flags().StringVar(&to, "to", "", "End of the time range in RFC 3339 format (default: seven days after its start)")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// Print table of simulated sync window periods
+func printSyncWindowPeriods(periods []*v1alpha1.SyncWindowPeriod) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	headers := []any{"START", "END", "AUTOSYNC", "MANUALSYNC", "SYNCOVERRUN", "REASON"}
+	fmtStr := strings.Repeat("%s\t", len(headers)) + "\n"
+	fmt.Fprintf(w, fmtStr, headers...)
+	for _, period := range periods {
+		reason := period.Reason
+		if reason == "" {
+			reason = "-"
+		}
+		vals := []any{
+			period.Start.Format(time.RFC3339),
+			period.End.Format(time.RFC3339),
+			formatBoolAllowedOutput(period.AutoSync),
+			formatBoolAllowedOutput(period.ManualSync),
+			formatBoolAllowedOutput(period.SyncOverrun),
+			reason,
+		}
+		fmt.Fprintf(w, fmtStr, vals...)
+	}
+	_ = w.Flush()
+}
+
 // Print table of sync window data
 func printSyncWindows(proj *v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -407,11 +501,15 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
 			isActive, _ := window.Active()
+			schedule := window.Schedule
+			if window.Calendar != nil {
+				schedule = "calendar:" + window.Calendar.Source()
+			}
 			vals := []any{
 				strconv.Itoa(i),
 				formatBoolOutput(isActive),
 				window.Kind,
-				window.Schedule,
+				schedule,
 				window.Duration,
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
@@ -456,3 +554,10 @@ func formatBoolEnabledOutput(active bool) string {
 	}
 	return o
 }
+
+func formatBoolAllowedOutput(allowed bool) string {
+	if allowed {
+		return "Allowed"
+	}
+	return "Denied"
+}
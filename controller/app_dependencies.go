@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// dependentsIndex contains applications by the qualified names of the applications they depend on
+const dependentsIndex = "dependents"
+
+func dependencyKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+// dependentsIndexFunc indexes applications by the qualified names of the applications they depend on
+func dependentsIndexFunc(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, nil
+	}
+	var keys []string
+	for _, dep := range app.Spec.DependsOn {
+		keys = append(keys, dependencyKey(dep.GetNamespace(app.Namespace), dep.Name))
+	}
+	return keys, nil
+}
+
+// dependencyStateChanged returns true if the change of the application might satisfy or break the dependencies of
+// other applications
+func dependencyStateChanged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return oldApp.Spec.GetProject() != newApp.Spec.GetProject() ||
+		oldApp.Status.Sync.Status != newApp.Status.Sync.Status ||
+		oldApp.Status.Health.Status != newApp.Status.Health.Status ||
+		(oldApp.Operation == nil) != (newApp.Operation == nil)
+}
+
+// requestDependentsRefresh requests a refresh of the applications which depend on the given application, so their
+// automated sync is started as soon as their dependencies are satisfied
+func (ctrl *ApplicationController) requestDependentsRefresh(app *appv1.Application) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependentsIndex, dependencyKey(app.Namespace, app.Name))
+	if err != nil {
+		return
+	}
+	for _, obj := range objs {
+		dependent, ok := obj.(*appv1.Application)
+		if !ok || !ctrl.canProcessApp(dependent) {
+			continue
+		}
+		ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+	}
+}
+
+// getDependency returns the application referenced by a dependency. Only applications of the same project can be
+// referenced, so that the dependencies of an application do not reveal the state of applications of other projects:
+// an application of another project is reported as not found.
+func (ctrl *ApplicationController) getDependency(app *appv1.Application, namespace string, name string) (*appv1.Application, error) {
+	depApp, err := ctrl.appLister.Applications(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if depApp.Spec.GetProject() != app.Spec.GetProject() {
+		return nil, apierrors.NewNotFound(appv1.SchemeGroupVersion.WithResource("applications").GroupResource(), name)
+	}
+	return depApp, nil
+}
+
+// getBlockingDependencies returns the dependencies of the application which are not Synced and Healthy yet. An error
+// is returned if the dependencies of the application form a cycle.
+func (ctrl *ApplicationController) getBlockingDependencies(app *appv1.Application) ([]appv1.ApplicationDependencyStatus, error) {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil, nil
+	}
+	if cycle := ctrl.findDependencyCycle(app); cycle != nil {
+		return nil, fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	var blocking []appv1.ApplicationDependencyStatus
+	for _, dep := range app.Spec.DependsOn {
+		status := appv1.ApplicationDependencyStatus{Name: dep.Name, Namespace: dep.GetNamespace(app.Namespace)}
+		depApp, err := ctrl.getDependency(app, status.Namespace, status.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting application %s: %w", dependencyKey(status.Namespace, status.Name), err)
+			}
+			status.Message = fmt.Sprintf("application does not exist in project %s", app.Spec.GetProject())
+			blocking = append(blocking, status)
+			continue
+		}
+		status.SyncStatus = depApp.Status.Sync.Status
+		status.HealthStatus = depApp.Status.Health.Status
+		switch {
+		case depApp.DeletionTimestamp != nil:
+			status.Message = "application is being deleted"
+		case depApp.Operation != nil || (depApp.Status.OperationState != nil && !depApp.Status.OperationState.Phase.Completed()):
+			status.Message = "application is being synced"
+		case status.SyncStatus != appv1.SyncStatusCodeSynced || status.HealthStatus != health.HealthStatusHealthy:
+			status.Message = fmt.Sprintf("application is %s and %s", status.SyncStatus, status.HealthStatus)
+		default:
+			continue
+		}
+		blocking = append(blocking, status)
+	}
+	return blocking, nil
+}
+
+// findDependencyCycle returns the qualified names of the applications forming a dependency cycle reachable from the
+// given application, or nil if there is no cycle. Dependencies which do not exist or belong to another project are
+// ignored.
+func (ctrl *ApplicationController) findDependencyCycle(app *appv1.Application) []string {
+	visited := map[string]bool{}
+	var path []string
+
+	var visit func(a *appv1.Application) []string
+	visit = func(a *appv1.Application) []string {
+		key := dependencyKey(a.Namespace, a.Name)
+		path = append(path, key)
+		for _, dep := range a.Spec.DependsOn {
+			depKey := dependencyKey(dep.GetNamespace(a.Namespace), dep.Name)
+			if i := slices.Index(path, depKey); i != -1 {
+				return append(slices.Clone(path[i:]), depKey)
+			}
+			if visited[depKey] {
+				continue
+			}
+			depApp, err := ctrl.getDependency(app, dep.GetNamespace(a.Namespace), dep.Name)
+			if err != nil {
+				continue
+			}
+			if cycle := visit(depApp); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		visited[key] = true
+		return nil
+	}
+	return visit(app)
+}
+
+// dependenciesCondition returns the WaitingForDependencies condition of the application, or nil if its dependencies
+// are satisfied
+func dependenciesCondition(blocking []appv1.ApplicationDependencyStatus, err error) *appv1.ApplicationCondition {
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionWaitingForDependencies, Message: err.Error()}
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+	messages := make([]string, len(blocking))
+	for i, dep := range blocking {
+		messages[i] = fmt.Sprintf("%s (%s)", dependencyKey(dep.Namespace, dep.Name), dep.Message)
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionWaitingForDependencies,
+		Message: "Waiting for dependencies to be Synced and Healthy: " + strings.Join(messages, ", "),
+	}
+}
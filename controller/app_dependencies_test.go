@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeDependencyApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Spec.DependsOn = nil
+	for _, dep := range dependsOn {
+		app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: dep})
+	}
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	app.Status.OperationState = nil
+	return app
+}
+
+func TestGetBlockingDependencies(t *testing.T) {
+	t.Run("NoDependencies", func(t *testing.T) {
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		blocking, err := ctrl.getBlockingDependencies(app)
+		require.NoError(t, err)
+		assert.Empty(t, blocking)
+	})
+
+	t.Run("DependenciesSatisfied", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		operators := newFakeDependencyApp("operators", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "crds")
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds", "operators")
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{crds, operators, app}}, nil)
+
+		blocking, err := ctrl.getBlockingDependencies(app)
+		require.NoError(t, err)
+		assert.Empty(t, blocking)
+	})
+
+	t.Run("DependenciesNotSatisfied", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		operators := newFakeDependencyApp("operators", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing, "crds")
+		syncing := newFakeDependencyApp("syncing", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		syncing.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning}
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds", "operators", "syncing", "missing")
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{crds, operators, syncing, app}}, nil)
+
+		blocking, err := ctrl.getBlockingDependencies(app)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ApplicationDependencyStatus{{
+			Name:         "operators",
+			Namespace:    test.FakeArgoCDNamespace,
+			SyncStatus:   v1alpha1.SyncStatusCodeSynced,
+			HealthStatus: health.HealthStatusProgressing,
+			Message:      "application is Synced and Progressing",
+		}, {
+			Name:         "syncing",
+			Namespace:    test.FakeArgoCDNamespace,
+			SyncStatus:   v1alpha1.SyncStatusCodeSynced,
+			HealthStatus: health.HealthStatusHealthy,
+			Message:      "application is being synced",
+		}, {
+			Name:      "missing",
+			Namespace: test.FakeArgoCDNamespace,
+			Message:   "application does not exist in project default",
+		}}, blocking)
+
+		cond := dependenciesCondition(blocking, nil)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionWaitingForDependencies, cond.Type)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: fake-argocd-ns/operators (application is Synced and Progressing), fake-argocd-ns/syncing (application is being synced), fake-argocd-ns/missing (application does not exist in project default)", cond.Message)
+	})
+
+	t.Run("DependencyOfAnotherProject", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		crds.Spec.Project = "other"
+		crds.Namespace = "other-ns"
+		operators := newFakeDependencyApp("operators", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		operators.Spec.Project = "other"
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "operators")
+		app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: "crds", Namespace: "other-ns"})
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{crds, operators, app}}, nil)
+
+		// the state of applications of other projects is not revealed, regardless of their namespace
+		blocking, err := ctrl.getBlockingDependencies(app)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ApplicationDependencyStatus{{
+			Name:      "operators",
+			Namespace: test.FakeArgoCDNamespace,
+			Message:   "application does not exist in project default",
+		}, {
+			Name:      "crds",
+			Namespace: "other-ns",
+			Message:   "application does not exist in project default",
+		}}, blocking)
+	})
+
+	t.Run("DependencyCycleThroughAnotherProject", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "workloads")
+		crds.Spec.Project = "other"
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{crds, app}}, nil)
+
+		blocking, err := ctrl.getBlockingDependencies(app)
+		require.NoError(t, err)
+		require.Len(t, blocking, 1)
+		assert.Equal(t, "application does not exist in project default", blocking[0].Message)
+	})
+
+	t.Run("DependencyCycle", func(t *testing.T) {
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "workloads")
+		operators := newFakeDependencyApp("operators", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "crds")
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "operators")
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{crds, operators, app}}, nil)
+
+		_, err := ctrl.getBlockingDependencies(app)
+		require.EqualError(t, err, "dependency cycle detected: fake-argocd-ns/workloads -> fake-argocd-ns/operators -> fake-argocd-ns/crds -> fake-argocd-ns/workloads")
+
+		cond := dependenciesCondition(nil, err)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionWaitingForDependencies, cond.Type)
+	})
+
+	t.Run("SelfDependency", func(t *testing.T) {
+		app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "workloads")
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		_, err := ctrl.getBlockingDependencies(app)
+		require.EqualError(t, err, "dependency cycle detected: fake-argocd-ns/workloads -> fake-argocd-ns/workloads")
+	})
+}
+
+func TestDependentsIndex(t *testing.T) {
+	crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	app := newFakeDependencyApp("workloads", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "crds")
+	app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: "operators", Namespace: "other"})
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{crds, app}}, nil)
+
+	keys, err := ctrl.appInformer.GetIndexer().IndexKeys(dependentsIndex, test.FakeArgoCDNamespace+"/crds")
+	require.NoError(t, err)
+	assert.Equal(t, []string{test.FakeArgoCDNamespace + "/workloads"}, keys)
+	keys, err = ctrl.appInformer.GetIndexer().IndexKeys(dependentsIndex, "other/operators")
+	require.NoError(t, err)
+	assert.Equal(t, []string{test.FakeArgoCDNamespace + "/workloads"}, keys)
+
+	// only changes of the sync and health state and of the operation can unblock dependents
+	updated := crds.DeepCopy()
+	updated.Status.ReconciledAt = &metav1.Time{}
+	assert.False(t, dependencyStateChanged(crds, updated))
+	updated.Status.Health.Status = health.HealthStatusDegraded
+	assert.True(t, dependencyStateChanged(crds, updated))
+	updated = crds.DeepCopy()
+	updated.Spec.Project = "other"
+	assert.True(t, dependencyStateChanged(crds, updated))
+}
+
+func TestAutoSyncWaitingForDependencies(t *testing.T) {
+	newController := func(t *testing.T, objs ...runtime.Object) *ApplicationController {
+		t.Helper()
+		return newFakeController(t.Context(), &fakeData{
+			apps: append(objs, &defaultProj),
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "my-config", "namespace": "` + test.FakeDestNamespace + `"}}`},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}, nil)
+	}
+
+	t.Run("Blocked", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "crds"}}
+		// the error of a previous auto-sync is cleared while the auto-sync is held
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionSyncError, Message: "Failed sync attempt"}}
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		// skip the reconciliation of the dependency, so that only the dependent application is refreshed
+		crds.Annotations = map[string]string{common.AnnotationKeyAppSkipReconcile: "true"}
+		ctrl := newController(t, app, crds)
+
+		ctrl.processAppRefreshQueueItem()
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+		cond := updatedApp.Status.Conditions
+		require.Len(t, cond, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionWaitingForDependencies, cond[0].Type)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: fake-argocd-ns/crds (application is OutOfSync and Missing)", cond[0].Message)
+
+		tree := &v1alpha1.ApplicationTree{}
+		require.NoError(t, ctrl.cache.GetAppResourcesTree(app.InstanceName(ctrl.namespace), tree))
+		require.Len(t, tree.BlockingDependencies, 1)
+		assert.Equal(t, "crds", tree.BlockingDependencies[0].Name)
+	})
+
+	t.Run("Satisfied", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "crds"}}
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionWaitingForDependencies, Message: "Waiting"}}
+		crds := newFakeDependencyApp("crds", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		// skip the reconciliation of the dependency, so that only the dependent application is refreshed
+		crds.Annotations = map[string]string{common.AnnotationKeyAppSkipReconcile: "true"}
+		ctrl := newController(t, app, crds)
+
+		ctrl.processAppRefreshQueueItem()
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, updatedApp.Operation)
+		assert.Empty(t, updatedApp.Status.Conditions)
+	})
+}
@@ -158,6 +158,10 @@ type ApplicationController struct {
 	deploymentInformer                informerv1.DeploymentInformer
 
 	hydrator *hydrator.Hydrator
+
+	// selfHealSuppressions contains app namespace/name and the drift events last logged for the app while self-heal
+	// was suppressed, to log them only once
+	selfHealSuppressions sync.Map
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -494,7 +498,7 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 
 // setAppManagedResources will build a list of ResourceDiff based on the provided comparisonResult
 // and persist app resources related data in the cache. Will return the persisted ApplicationTree.
-func (ctrl *ApplicationController) setAppManagedResources(ctx context.Context, destCluster *appv1.Cluster, a *appv1.Application, comparisonResult *comparisonResult) (*appv1.ApplicationTree, error) {
+func (ctrl *ApplicationController) setAppManagedResources(ctx context.Context, destCluster *appv1.Cluster, a *appv1.Application, comparisonResult *comparisonResult, blockingDependencies []appv1.ApplicationDependencyStatus) (*appv1.ApplicationTree, error) {
 	ts := stats.NewTimingStats()
 	defer func() {
 		logCtx := log.WithFields(applog.GetAppLogFields(a))
@@ -514,6 +518,7 @@ func (ctrl *ApplicationController) setAppManagedResources(ctx context.Context, d
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource tree: %w", err)
 	}
+	tree.BlockingDependencies = blockingDependencies
 	err = ctrl.cache.SetAppResourcesTree(a.InstanceName(ctrl.namespace), tree)
 	ts.AddCheckpoint("set_app_resources_tree_ms")
 	if err != nil {
@@ -1700,7 +1705,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	ctrl.setOperationState(ctx, app, state)
 	ts.AddCheckpoint("final_set_operation_state_ms")
-	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
+	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.DryRun()) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
 		if _, err := cache.MetaNamespaceKeyFunc(app); err == nil {
@@ -1991,7 +1996,10 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	ctrl.normalizeApplication(app)
 	ts.AddCheckpoint("normalize_application_ms")
 
-	tree, err := ctrl.setAppManagedResources(ctx, destCluster, app, compareResult)
+	blockingDependencies, dependenciesErr := ctrl.getBlockingDependencies(app)
+	ts.AddCheckpoint("get_blocking_dependencies_ms")
+
+	tree, err := ctrl.setAppManagedResources(ctx, destCluster, app, compareResult, blockingDependencies)
 	ts.AddCheckpoint("set_app_managed_resources_ms")
 	if err != nil {
 		logCtx.WithError(err).Error("Failed to cache app resources")
@@ -1999,23 +2007,51 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false, nil)
+	dependenciesCond := dependenciesCondition(blockingDependencies, dependenciesErr)
+	if dependenciesCond != nil {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{*dependenciesCond},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionWaitingForDependencies: true},
+		)
+	} else {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionWaitingForDependencies: true},
+		)
+	}
+
+	canSync, syncBlockedReason, _ := project.Spec.SyncWindows.Matches(app).CanSyncWithReason(false, nil)
 	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(ctx, app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
-		setOpDuration = opDuration
-		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+		if rolledBack, opDuration := ctrl.autoRollback(ctx, app, compareResult.syncStatus, compareResult.healthStatus); rolledBack {
+			setOpDuration = opDuration
+		}
+		if dependenciesCond == nil {
+			syncErrCond, opDuration := ctrl.autoSync(ctx, app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges, compareResult.managedResources)
+			setOpDuration = opDuration
+			if syncErrCond != nil {
+				app.Status.SetConditions(
+					[]appv1.ApplicationCondition{*syncErrCond},
+					map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				)
+			} else {
+				app.Status.SetConditions(
+					[]appv1.ApplicationCondition{},
+					map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
+				)
+			}
 		} else {
+			logCtx.Info("Auto-sync prevented by dependencies")
+			// the last auto-sync error is stale while the auto-sync is held by the dependencies
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{},
 				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
 			)
 		}
 	} else {
-		logCtx.Info("Sync prevented by sync window")
+		logCtx.WithField("reason", syncBlockedReason).Info("Sync prevented by sync window")
+		if isSelfHealPending(app, compareResult.syncStatus, compareResult.revisionsMayHaveChanges) {
+			ctrl.logResourceDrift(ctx, app, newResourceDrifts(compareResult.managedResources), "blocked by sync window: "+syncBlockedReason)
+		}
 	}
 	ts.AddCheckpoint("auto_sync_ms")
 
@@ -2515,8 +2551,163 @@ func (ctrl *ApplicationController) persistAppStatus(ctx context.Context, orig *a
 	return patchDuration
 }
 
+// autoRollback will initiate a rollback to the last healthy revision for an application configured with
+// automated rollback on degraded health, if the application became Degraded within the configured window after
+// its last sync. It also records the health observed after a sync in the revision history, and clears the state of a
+// previous rollback once a newer revision is desired. It returns true if a rollback operation was initiated.
+func (ctrl *ApplicationController) autoRollback(ctx context.Context, app *appv1.Application, syncStatus *appv1.SyncStatus, healthStatus health.HealthStatusCode) (bool, time.Duration) {
+	_, span := tracer.Start(ctx, "controller.autoRollback")
+	setAppTraceAttrs(span, app)
+	defer span.End()
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+
+	if app.Spec.SyncPolicy == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() || !app.Spec.SyncPolicy.Automated.IsRollbackOnDegradedEnabled() {
+		return false, 0
+	}
+
+	desiredRevisions := []string{syncStatus.Revision}
+	if app.Spec.HasMultipleSources() {
+		desiredRevisions = syncStatus.Revisions
+	}
+	if rollback := app.Status.AutomatedRollback; rollback != nil {
+		if reflect.DeepEqual(rollback.GetRevisions(), desiredRevisions) {
+			// automated sync stays paused until a newer revision arrives, so there is nothing more to do
+			return false, 0
+		}
+		logCtx.Infof("Resuming automated sync: desired revision changed from rolled back revision to %s", desiredRevisions)
+		app.Status.AutomatedRollback = nil
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutomatedRollbackWarning: true},
+		)
+	}
+
+	if app.Operation != nil || len(app.Status.History) == 0 || app.Status.OperationState == nil || !app.Status.OperationState.Phase.Completed() {
+		return false, 0
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		return false, 0
+	}
+
+	last := &app.Status.History[len(app.Status.History)-1]
+	switch healthStatus {
+	case health.HealthStatusHealthy:
+		if last.HealthStatus != health.HealthStatusHealthy {
+			last.HealthStatus = health.HealthStatusHealthy
+		}
+		return false, 0
+	case health.HealthStatusDegraded:
+	default:
+		return false, 0
+	}
+
+	if last.HealthStatus == health.HealthStatusDegraded {
+		// a rollback was already attempted for this deployment
+		return false, 0
+	}
+	window, err := app.Spec.SyncPolicy.Automated.RollbackOnDegraded.GetWindow()
+	if err != nil {
+		logCtx.WithError(err).Warn("Skipping auto-rollback: invalid rollback window")
+		return false, 0
+	}
+	if last.DeployedAt.IsZero() || time.Since(last.DeployedAt.Time) > window {
+		return false, 0
+	}
+
+	// the health of a revision is only recorded while rollback is enabled, so revisions deployed earlier are unverified
+	var target, unverified *appv1.RevisionHistory
+	for i := len(app.Status.History) - 2; i >= 0; i-- {
+		h := app.Status.History[i]
+		if h.Source.IsZero() && h.Sources.IsZero() {
+			continue
+		}
+		if h.Revision == last.Revision && reflect.DeepEqual(h.Revisions, last.Revisions) {
+			continue
+		}
+		if h.HealthStatus == "" && unverified == nil {
+			unverified = &h
+		}
+		if h.HealthStatus == health.HealthStatusHealthy {
+			target = &h
+			break
+		}
+	}
+	targetDescription := "the last healthy revision"
+	if target == nil && unverified != nil && app.Spec.SyncPolicy.Automated.RollbackOnDegraded.FallbackToUnverifiedRevision {
+		target = unverified
+		targetDescription = "a revision with unknown health"
+	}
+	lastHealthStatus := last.HealthStatus
+	last.HealthStatus = health.HealthStatusDegraded
+	if target == nil {
+		message := fmt.Sprintf("Application became Degraded after sync to '%s' but no healthy revision is available to roll back to", strings.Join(desiredRevisions, ", "))
+		if unverified != nil {
+			message += ". Enable fallbackToUnverifiedRevision to roll back to revisions whose health was not recorded"
+		}
+		logCtx.Warn(message)
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionAutomatedRollbackWarning, Message: message}},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutomatedRollbackWarning: true},
+		)
+		return false, 0
+	}
+
+	// Rollback is just a convenience around Sync, initiated the same way as ApplicationService.Rollback
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     target.Revision,
+			Revisions:    target.Revisions,
+			Prune:        app.Spec.SyncPolicy.Automated.GetPrune(),
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Source:       &target.Source,
+			Sources:      target.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Retry:       appv1.RetryStrategy{Limit: 5},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	start := time.Now()
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		if stderrors.Is(err, argo.ErrAnotherOperationInProgress) {
+			logCtx.WithError(err).Warnf("Failed to initiate auto-rollback to %d", target.ID)
+			last.HealthStatus = lastHealthStatus
+			return false, 0
+		}
+		logCtx.WithError(err).Errorf("Failed to initiate auto-rollback to %d", target.ID)
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionAutomatedRollbackWarning, Message: fmt.Sprintf("Failed to initiate rollback to %d: %v", target.ID, err)}},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutomatedRollbackWarning: true},
+		)
+		return false, setOpTime
+	}
+	ctrl.writeBackToInformer(updatedApp)
+	app.Operation = updatedApp.Operation
+
+	app.Status.AutomatedRollback = &appv1.AutomatedRollbackStatus{
+		Revision:     last.Revision,
+		Revisions:    last.Revisions,
+		HistoryID:    target.ID,
+		RolledBackAt: metav1.Now(),
+	}
+	message := fmt.Sprintf("Application became Degraded after sync to '%s': initiated automated rollback to %s %d. Automated sync is paused until a newer revision is available", strings.Join(desiredRevisions, ", "), targetDescription, target.ID)
+	app.Status.SetConditions(
+		[]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionAutomatedRollbackWarning, Message: message}},
+		map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutomatedRollbackWarning: true},
+	)
+	ctrl.logAppEvent(ctx, app, argo.EventInfo{Reason: argo.EventReasonAutomatedRollback, Type: corev1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return true, setOpTime
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
-func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
+func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool, managedResources []managedResource) (*appv1.ApplicationCondition, time.Duration) {
 	_, span := tracer.Start(ctx, "controller.autoSync")
 	setAppTraceAttrs(span, app)
 	defer span.End()
@@ -2541,6 +2732,10 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil, 0
 	}
+	if app.Status.AutomatedRollback != nil {
+		logCtx.Infof("Skipping auto-sync: application was rolled back from %s after becoming Degraded", app.Status.AutomatedRollback.GetRevisions())
+		return nil, 0
+	}
 
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
@@ -2609,13 +2804,16 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 			op.Sync.SelfHealAttemptsCount = app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount
 		}
 
+		drift := newResourceDrifts(managedResources)
 		if remainingTime := ctrl.selfHealRemainingBackoff(app, int(op.Sync.SelfHealAttemptsCount)); remainingTime > 0 {
 			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", lastAttemptedRevisions, ctrl.selfHealTimeout, remainingTime)
+			ctrl.logResourceDrift(ctx, app, drift, fmt.Sprintf("backing off after %d self-heal attempts", op.Sync.SelfHealAttemptsCount))
 			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime)
 			return nil, 0
 		}
 
 		op.Sync.SelfHealAttemptsCount++
+		op.Sync.Drift = drift
 		for _, resource := range resources {
 			if resource.Status != appv1.SyncStatusCodeSynced {
 				op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
@@ -2665,9 +2863,49 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 	message := fmt.Sprintf("Initiated automated sync to '%s'", strings.Join(desiredRevisions, ", "))
 	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
 	logCtx.Info(message)
+	ctrl.logResourceDrift(ctx, app, op.Sync.Drift, "")
 	return nil, setOpTime
 }
 
+// isSelfHealPending returns whether self-heal would sync an application, because its resources drifted from the
+// revisions it was last successfully synced to
+func isSelfHealPending(app *appv1.Application, syncStatus *appv1.SyncStatus, shouldCompareRevisions bool) bool {
+	if app.Spec.SyncPolicy == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() || !app.Spec.SyncPolicy.Automated.GetSelfHeal() ||
+		app.Operation != nil || syncStatus.Status != appv1.SyncStatusCodeOutOfSync {
+		return false
+	}
+	desiredRevisions := []string{syncStatus.Revision}
+	if app.Spec.HasMultipleSources() {
+		desiredRevisions = syncStatus.Revisions
+	}
+	alreadyAttempted, _, lastAttemptedPhase := alreadyAttemptedSync(app, desiredRevisions, shouldCompareRevisions)
+	return alreadyAttempted && lastAttemptedPhase.Successful()
+}
+
+// logResourceDrift logs an event for each drifted resource of an application, either corrected by self-heal or, if
+// suppressedReason is set, not corrected for that reason. Events of suppressed self-heals are logged once, until the
+// drift or the reason changes.
+func (ctrl *ApplicationController) logResourceDrift(ctx context.Context, app *appv1.Application, drift []appv1.ResourceDrift, suppressedReason string) {
+	if suppressedReason == "" {
+		ctrl.selfHealSuppressions.Delete(app.QualifiedName())
+		for _, d := range drift {
+			ctrl.logAppEvent(ctx, app, argo.EventInfo{Reason: argo.EventReasonResourceDrift, Type: corev1.EventTypeWarning}, "Self-heal is correcting drift of "+driftMessage(d))
+		}
+		return
+	}
+	messages := make([]string, len(drift))
+	for i, d := range drift {
+		messages[i] = fmt.Sprintf("Self-heal is suppressed (%s) for drift of %s", suppressedReason, driftMessage(d))
+	}
+	key := strings.Join(messages, "\n")
+	if previous, ok := ctrl.selfHealSuppressions.Swap(app.QualifiedName(), key); ok && previous == key {
+		return
+	}
+	for _, message := range messages {
+		ctrl.logAppEvent(ctx, app, argo.EventInfo{Reason: argo.EventReasonResourceDrift, Type: corev1.EventTypeWarning}, message)
+	}
+}
+
 // alreadyAttemptedSync returns whether the most recently synced revision(s) exactly match the given desiredRevisions
 // and for the same application source. If the revision(s) have changed or the Application source configuration has been updated,
 // it will return false, indicating that a new sync should be attempted.
@@ -2818,6 +3056,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 		refreshTimeout,
 		cache.Indexers{
 			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			dependentsIndex:      dependentsIndexFunc,
 			orphanedIndex: func(obj any) (i []string, e error) {
 				app, ok := obj.(*appv1.Application)
 				if !ok {
@@ -2908,6 +3147,7 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			newApp, newOK := obj.(*appv1.Application)
 			if err == nil && newOK {
 				ctrl.clusterSharding.AddApp(newApp)
+				ctrl.requestDependentsRefresh(newApp)
 			}
 		},
 		UpdateFunc: func(old, new any) {
@@ -2930,6 +3170,9 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 					log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
 				}
+				if dependencyStateChanged(oldApp, newApp) {
+					ctrl.requestDependentsRefresh(newApp)
+				}
 				if ctrl.statusRefreshJitter != 0 && oldApp.ResourceVersion == newApp.ResourceVersion {
 					// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
 					jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
@@ -2963,6 +3206,7 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			delApp, delOK := obj.(*appv1.Application)
 			if err == nil && delOK {
 				ctrl.clusterSharding.DeleteApp(delApp)
+				ctrl.requestDependentsRefresh(delApp)
 			}
 		},
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache/mocks"
+	gitopsdiff "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
@@ -702,7 +703,7 @@ func TestAutoSync(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -719,7 +720,7 @@ func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -740,7 +741,7 @@ func TestAutoSyncMultiSourceWithoutSelfHeal(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"z", "x", "v"},
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -755,7 +756,7 @@ func TestAutoSyncMultiSourceWithoutSelfHeal(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"a", "b", "c"},
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -763,6 +764,75 @@ func TestAutoSyncMultiSourceWithoutSelfHeal(t *testing.T) {
 	})
 }
 
+func TestAutoSyncSelfHealDrift(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	live := kube.MustToUnstructured(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: test.FakeDestNamespace}})
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    "kubectl-edit",
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+	}})
+	managedResources := []managedResource{{
+		Group: "apps", Kind: kube.DeploymentKind, Namespace: test.FakeDestNamespace, Name: "guestbook",
+		Live: live, Target: live,
+		Diff: gitopsdiff.DiffResult{
+			Modified:       true,
+			NormalizedLive: []byte(`{"spec":{"replicas":3}}`),
+			PredictedLive:  []byte(`{"spec":{"replicas":1}}`),
+		},
+	}}
+	driftEvents := func(t *testing.T, ctrl *ApplicationController) []string {
+		t.Helper()
+		events, err := ctrl.kubeClientset.CoreV1().Events(test.FakeArgoCDNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var messages []string
+		for _, event := range events.Items {
+			if event.Reason == argo.EventReasonResourceDrift {
+				messages = append(messages, event.Message)
+			}
+		}
+		return messages
+	}
+
+	t.Run("RecordCorrectedDrift", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = new(true)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true, managedResources)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		require.Len(t, app.Operation.Sync.Drift, 1)
+		assert.Equal(t, []string{"spec.replicas"}, app.Operation.Sync.Drift[0].Fields)
+		require.Len(t, app.Operation.Sync.Drift[0].Managers, 1)
+		assert.Equal(t, "kubectl-edit", app.Operation.Sync.Drift[0].Managers[0].Manager)
+		assert.Equal(t, []string{"Self-heal is correcting drift of apps/Deployment/" + test.FakeDestNamespace + "/guestbook: spec.replicas changed by kubectl-edit"}, driftEvents(t, ctrl))
+	})
+
+	t.Run("RecordSuppressedDriftOnce", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = new(true)
+		app.Status.OperationState.FinishedAt = new(metav1.Now())
+		app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount = 1
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.selfHealTimeout = time.Minute
+		for range 2 {
+			cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true, managedResources)
+			assert.Nil(t, cond)
+		}
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+		assert.Equal(t, []string{"Self-heal is suppressed (backing off after 1 self-heal attempts) for drift of apps/Deployment/" + test.FakeDestNamespace + "/guestbook: spec.replicas changed by kubectl-edit"}, driftEvents(t, ctrl))
+	})
+}
+
 func TestAutoSyncNotAllowEmpty(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy.Automated.Prune = new(true)
@@ -771,7 +841,7 @@ func TestAutoSyncNotAllowEmpty(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 	assert.NotNil(t, cond)
 }
 
@@ -784,10 +854,161 @@ func TestAutoSyncAllowEmpty(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 	assert.Nil(t, cond)
 }
 
+func newFakeAppWithRollbackOnDegraded() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.RollbackOnDegraded = &v1alpha1.RollbackOnDegraded{Window: "10m"}
+	source := app.Spec.GetSource()
+	app.Status.History = v1alpha1.RevisionHistories{{
+		ID:           1,
+		Revision:     "cccccccccccccccccccccccccccccccccccccccc",
+		Source:       source,
+		DeployedAt:   metav1.NewTime(time.Now().Add(-time.Hour)),
+		HealthStatus: health.HealthStatusHealthy,
+	}, {
+		ID:         2,
+		Revision:   "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Source:     source,
+		DeployedAt: metav1.NewTime(time.Now().Add(-time.Minute)),
+	}}
+	return app
+}
+
+func TestAutoRollback(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeSynced,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+
+	t.Run("RollbackToLastHealthyRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusDegraded)
+		assert.True(t, rolledBack)
+		assert.Equal(t, health.HealthStatusDegraded, app.Status.History[1].HealthStatus)
+		require.NotNil(t, app.Status.AutomatedRollback)
+		assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", app.Status.AutomatedRollback.Revision)
+		assert.Equal(t, int64(1), app.Status.AutomatedRollback.HistoryID)
+		require.Len(t, app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionAutomatedRollbackWarning: true}), 1)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		require.NotNil(t, updatedApp.Operation.Sync)
+		assert.Equal(t, "cccccccccccccccccccccccccccccccccccccccc", updatedApp.Operation.Sync.Revision)
+		assert.True(t, updatedApp.Operation.InitiatedBy.Automated)
+
+		events, err := ctrl.kubeClientset.CoreV1().Events(test.FakeArgoCDNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, events.Items, 1)
+		assert.Equal(t, argo.EventReasonAutomatedRollback, events.Items[0].Reason)
+	})
+
+	t.Run("RecordHealthyRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusHealthy)
+		assert.False(t, rolledBack)
+		assert.Equal(t, health.HealthStatusHealthy, app.Status.History[1].HealthStatus)
+		assert.Nil(t, app.Status.AutomatedRollback)
+	})
+
+	t.Run("OutsideOfWindow", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		app.Status.History[1].DeployedAt = metav1.NewTime(time.Now().Add(-30 * time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusDegraded)
+		assert.False(t, rolledBack)
+		assert.Nil(t, app.Status.AutomatedRollback)
+	})
+
+	t.Run("NotEnabled", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		app.Spec.SyncPolicy.Automated.RollbackOnDegraded = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusDegraded)
+		assert.False(t, rolledBack)
+		assert.Empty(t, app.Status.History[1].HealthStatus)
+	})
+
+	t.Run("NoHealthyRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		app.Status.History[0].HealthStatus = ""
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusDegraded)
+		assert.False(t, rolledBack)
+		assert.Nil(t, app.Status.AutomatedRollback)
+		conditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionAutomatedRollbackWarning: true})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "Enable fallbackToUnverifiedRevision")
+	})
+
+	t.Run("FallbackToUnverifiedRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		app.Status.History[0].HealthStatus = ""
+		app.Spec.SyncPolicy.Automated.RollbackOnDegraded.FallbackToUnverifiedRevision = true
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusDegraded)
+		assert.True(t, rolledBack)
+		require.NotNil(t, app.Status.AutomatedRollback)
+		assert.Equal(t, int64(1), app.Status.AutomatedRollback.HistoryID)
+		conditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionAutomatedRollbackWarning: true})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "a revision with unknown health 1")
+	})
+
+	t.Run("NoFallbackToDegradedRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		app.Status.History[0].HealthStatus = health.HealthStatusDegraded
+		app.Spec.SyncPolicy.Automated.RollbackOnDegraded.FallbackToUnverifiedRevision = true
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusDegraded)
+		assert.False(t, rolledBack)
+		assert.Nil(t, app.Status.AutomatedRollback)
+	})
+
+	t.Run("ResumeOnNewerRevision", func(t *testing.T) {
+		app := newFakeAppWithRollbackOnDegraded()
+		app.Status.AutomatedRollback = &v1alpha1.AutomatedRollbackStatus{Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", HistoryID: 1}
+		app.Status.SetConditions(
+			[]v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionAutomatedRollbackWarning, Message: "rolled back"}},
+			map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionAutomatedRollbackWarning: true},
+		)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		rolledBack, _ := ctrl.autoRollback(t.Context(), app, &syncStatus, health.HealthStatusHealthy)
+		assert.False(t, rolledBack)
+		assert.NotNil(t, app.Status.AutomatedRollback)
+
+		newSyncStatus := v1alpha1.SyncStatus{
+			Status:   v1alpha1.SyncStatusCodeOutOfSync,
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		}
+		rolledBack, _ = ctrl.autoRollback(t.Context(), app, &newSyncStatus, health.HealthStatusHealthy)
+		assert.False(t, rolledBack)
+		assert.Nil(t, app.Status.AutomatedRollback)
+		assert.Empty(t, app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionAutomatedRollbackWarning: true}))
+	})
+}
+
+func TestAutoSyncPausedAfterAutoRollback(t *testing.T) {
+	app := newFakeAppWithRollbackOnDegraded()
+	app.Status.AutomatedRollback = &v1alpha1.AutomatedRollbackStatus{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", HistoryID: 1}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
 func TestSkipAutoSync(t *testing.T) {
 	// Verify we skip when we previously synced to it in our most recent history
 	// Set current to 'aaaaa', desired to 'aaaa' and mark system OutOfSync
@@ -798,7 +1019,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -813,7 +1034,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeSynced,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -829,7 +1050,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -845,7 +1066,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -862,7 +1083,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -888,7 +1109,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 		assert.NotNil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -912,7 +1133,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 		assert.NotNil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -928,7 +1149,7 @@ func TestSkipAutoSync(t *testing.T) {
 		}
 		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{
 			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, RequiresPruning: true},
-		}, true)
+		}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -964,7 +1185,7 @@ func TestAutoSyncIndicateError(t *testing.T) {
 			Source:   *app.Spec.Source.DeepCopy(),
 		},
 	}
-	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 	assert.NotNil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -1008,7 +1229,7 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
 		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -1039,7 +1260,7 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"z", "x", "v"},
 		}
-		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// newResourceDrifts returns the drift of the live resources which were changed outside of Argo CD, i.e. the fields
+// which differ from the target state and the field managers which changed them
+func newResourceDrifts(managedResources []managedResource) []appv1.ResourceDrift {
+	var drifts []appv1.ResourceDrift
+	for _, res := range managedResources {
+		if res.Hook || res.Live == nil || res.Target == nil || !res.Diff.Modified {
+			continue
+		}
+		fields, err := driftedFields(res.Diff.NormalizedLive, res.Diff.PredictedLive)
+		if err != nil || len(fields) == 0 {
+			continue
+		}
+		drifts = append(drifts, appv1.ResourceDrift{
+			Group:     res.Group,
+			Kind:      res.Kind,
+			Namespace: res.Namespace,
+			Name:      res.Name,
+			Fields:    fields,
+			Managers:  driftManagers(res.Live, fields),
+		})
+	}
+	return drifts
+}
+
+// driftedFields returns the sorted paths of the fields which differ between the normalized live state and the
+// predicted live state of a resource. Lists are compared as a whole.
+func driftedFields(liveData, predictedLiveData []byte) ([]string, error) {
+	var live, predictedLive map[string]any
+	if err := json.Unmarshal(liveData, &live); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(predictedLiveData, &predictedLive); err != nil {
+		return nil, err
+	}
+	for _, fields := range syncPlanIgnoredFields {
+		unstructured.RemoveNestedField(live, fields...)
+		unstructured.RemoveNestedField(predictedLive, fields...)
+	}
+	var paths []string
+	appendDriftedFields("", live, predictedLive, &paths)
+	slices.Sort(paths)
+	return paths, nil
+}
+
+func appendDriftedFields(prefix string, live, predictedLive map[string]any, paths *[]string) {
+	keys := make(map[string]bool)
+	for key := range live {
+		keys[key] = true
+	}
+	for key := range predictedLive {
+		keys[key] = true
+	}
+	for key := range keys {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		liveValue, liveOk := live[key]
+		predictedValue, predictedOk := predictedLive[key]
+		liveMap, liveIsMap := liveValue.(map[string]any)
+		predictedMap, predictedIsMap := predictedValue.(map[string]any)
+		if liveIsMap && predictedIsMap {
+			appendDriftedFields(path, liveMap, predictedMap, paths)
+			continue
+		}
+		if liveOk != predictedOk || !reflect.DeepEqual(liveValue, predictedValue) {
+			*paths = append(*paths, path)
+		}
+	}
+}
+
+// driftManagers returns the field managers of the live resource, other than Argo CD, which own any of the given
+// fields. Managers of subresources, e.g. status, are ignored.
+func driftManagers(live *unstructured.Unstructured, fields []string) []appv1.ResourceDriftManager {
+	var managers []appv1.ResourceDriftManager
+	for _, mf := range live.GetManagedFields() {
+		if mf.Manager == common.ArgoCDSSAManager || mf.Subresource != "" || mf.FieldsV1 == nil {
+			continue
+		}
+		set := &fieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(mf.FieldsV1.GetRawBytes())); err != nil {
+			continue
+		}
+		owned := managedFieldPaths(set)
+		var managerFields []string
+		for _, field := range fields {
+			if slices.ContainsFunc(owned, func(path string) bool {
+				return path == field || strings.HasPrefix(path, field+".")
+			}) {
+				managerFields = append(managerFields, field)
+			}
+		}
+		if len(managerFields) > 0 {
+			managers = append(managers, appv1.ResourceDriftManager{
+				Manager:   mf.Manager,
+				Operation: string(mf.Operation),
+				Time:      mf.Time,
+				Fields:    managerFields,
+			})
+		}
+	}
+	return managers
+}
+
+// managedFieldPaths returns the paths of the fields of a managed fields set, up to their first list element
+func managedFieldPaths(set *fieldpath.Set) []string {
+	var paths []string
+	set.Iterate(func(path fieldpath.Path) {
+		var names []string
+		for _, element := range path {
+			if element.FieldName == nil {
+				break
+			}
+			names = append(names, *element.FieldName)
+		}
+		if len(names) > 0 {
+			paths = append(paths, strings.Join(names, "."))
+		}
+	})
+	return paths
+}
+
+// driftMessage returns a description of the drift of a resource, e.g.
+// "apps/Deployment/default/guestbook: spec.replicas changed by kubectl-edit"
+func driftMessage(d appv1.ResourceDrift) string {
+	message := fmt.Sprintf("%s/%s/%s/%s: %s changed", d.Group, d.Kind, d.Namespace, d.Name, strings.Join(d.Fields, ", "))
+	if len(d.Managers) > 0 {
+		managers := make([]string, len(d.Managers))
+		for i, m := range d.Managers {
+			managers[i] = m.Manager
+		}
+		message = fmt.Sprintf("%s by %s", message, strings.Join(managers, ", "))
+	}
+	return message
+}
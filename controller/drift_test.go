@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestDriftedFields(t *testing.T) {
+	t.Run("Changed, added and removed fields", func(t *testing.T) {
+		fields, err := driftedFields(
+			[]byte(`{"metadata":{"name":"guestbook","labels":{"a":"1","b":"2"},"resourceVersion":"2"},"spec":{"replicas":3,"template":{"containers":[{"image":"nginx:2"}]}}}`),
+			[]byte(`{"metadata":{"name":"guestbook","labels":{"a":"1","c":"3"},"resourceVersion":"1"},"spec":{"replicas":1,"template":{"containers":[{"image":"nginx:1"}]}}}`),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"metadata.labels.b", "metadata.labels.c", "spec.replicas", "spec.template.containers"}, fields)
+	})
+	t.Run("Ignored fields only", func(t *testing.T) {
+		fields, err := driftedFields(
+			[]byte(`{"metadata":{"generation":2},"status":{"replicas":3}}`),
+			[]byte(`{"metadata":{"generation":1},"status":{"replicas":1}}`),
+		)
+		require.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+	t.Run("Invalid live state", func(t *testing.T) {
+		_, err := driftedFields([]byte(`{`), []byte(`{}`))
+		require.Error(t, err)
+	})
+}
+
+func TestNewResourceDrifts(t *testing.T) {
+	live := kube.MustToUnstructured(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}})
+	editTime := metav1.Unix(1700000000, 0)
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    common.ArgoCDSSAManager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{}}}`)},
+	}, {
+		Manager:    "kubectl-edit",
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		Time:       &editTime,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+	}, {
+		Manager:     "kube-controller-manager",
+		Operation:   metav1.ManagedFieldsOperationUpdate,
+		Subresource: "status",
+		FieldsType:  "FieldsV1",
+		FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:replicas":{}}}`)},
+	}})
+	modified := diff.DiffResult{
+		Modified:       true,
+		NormalizedLive: []byte(`{"spec":{"replicas":3},"status":{"replicas":3}}`),
+		PredictedLive:  []byte(`{"spec":{"replicas":1},"status":{"replicas":3}}`),
+	}
+	drifts := newResourceDrifts([]managedResource{{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+		Live: live, Target: &unstructured.Unstructured{}, Diff: modified,
+	}, {
+		Kind: "Pod", Namespace: "default", Name: "hook",
+		Live: live, Target: &unstructured.Unstructured{}, Diff: modified, Hook: true,
+	}, {
+		Kind: "ConfigMap", Namespace: "default", Name: "pruned",
+		Live: live, Diff: modified,
+	}, {
+		Kind: "ConfigMap", Namespace: "default", Name: "synced",
+		Live: live, Target: &unstructured.Unstructured{},
+	}})
+
+	require.Len(t, drifts, 1)
+	assert.Equal(t, v1alpha1.ResourceDrift{
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: "default",
+		Name:      "guestbook",
+		Fields:    []string{"spec.replicas"},
+		Managers: []v1alpha1.ResourceDriftManager{{
+			Manager:   "kubectl-edit",
+			Operation: "Update",
+			Time:      &editTime,
+			Fields:    []string{"spec.replicas"},
+		}},
+	}, drifts[0])
+	assert.Equal(t, "apps/Deployment/default/guestbook: spec.replicas changed by kubectl-edit", driftMessage(drifts[0]))
+}
+
+func TestDriftManagers_ParentField(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{}}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:    "helm",
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"guestbook\"}":{"f:image":{}}}}}}}`)},
+	}})
+	managers := driftManagers(live, []string{"spec.template.spec.containers", "spec.replicas"})
+	require.Len(t, managers, 1)
+	assert.Equal(t, "helm", managers[0].Manager)
+	assert.Equal(t, []string{"spec.template.spec.containers"}, managers[0].Fields)
+}
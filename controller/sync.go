@@ -1,12 +1,16 @@
 package controller
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
@@ -183,17 +187,23 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 	}
 
 	syncOp := *state.Operation.Sync
+	// a plan is a dry-run of the sync
+	if syncOp.Plan {
+		syncOp.DryRun = true
+	}
 
 	if state.SyncResult == nil {
 		state.SyncResult = newSyncOperationResult(app, syncOp)
 	}
 
-	if isBlocked, err := syncWindowPreventsSync(app, project); isBlocked {
+	if isBlocked, reason, err := syncWindowPreventsSync(app, project); isBlocked {
 		// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 		if state.Phase == common.OperationRunning {
 			state.Message = "Sync operation blocked by sync window"
 			if err != nil {
 				state.Message = fmt.Sprintf("%s: %v", state.Message, err)
+			} else if reason != "" {
+				state.Message = fmt.Sprintf("%s: %s", state.Message, reason)
 			}
 		}
 		return
@@ -238,6 +248,20 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		return
 	}
 
+	syncRevisions := []string{compareResult.syncStatus.Revision}
+	if isMultiSourceSync {
+		syncRevisions = compareResult.syncStatus.Revisions
+	}
+	// a sync plan is applied only if neither the target revision nor the live state of the resources changed since it
+	// was previewed, which is verified once before the first resource is synced
+	if syncOp.PlanID != "" && len(state.SyncResult.Resources) == 0 && state.RetryCount == 0 {
+		if planID := syncPlanID(syncRevisions, compareResult.managedResources); planID != syncOp.PlanID {
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("Sync plan %s is out of date: the target revision or the live state of the resources changed since it was previewed", syncOp.PlanID)
+			return
+		}
+	}
+
 	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, m.db)
 	if err != nil {
 		state.Phase = common.OperationError
@@ -280,6 +304,7 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			Version:     res.Version,
 			Images:      res.Images,
 			Order:       i + 1,
+			Retries:     int(res.Retries),
 		}
 	}
 
@@ -299,6 +324,13 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		clientSideApplyManager = managerValue
 	}
 
+	resourceRetry, err := common.NewResourceRetryStrategy().WithOptions(syncOp.SyncOptions.GetOptionValue)
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = err.Error()
+		return
+	}
+
 	reconciliationResult := compareResult.reconciliationResult
 
 	// if RespectIgnoreDifferences is enabled, it should normalize the target
@@ -407,6 +439,8 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
 		sync.WithDefaultPruneOption(syncOp.SyncOptions.GetOptionValue(common.SyncOptionPrune)),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
+		sync.WithResourceRetry(resourceRetry),
+		sync.WithServerSideDryRun(syncOp.Plan),
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
@@ -475,8 +509,13 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			Status:    res.Status,
 			Message:   res.Message,
 			Images:    res.Images,
+			Retries:   int64(res.Retries),
 		})
 	}
+	if syncOp.Plan {
+		state.SyncResult.Plan = newSyncPlan(resState, compareResult.managedResources)
+		state.SyncResult.Plan.ID = syncPlanID(syncRevisions, compareResult.managedResources)
+	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
@@ -489,6 +528,103 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 	}
 }
 
+// syncPhaseOrder is the order in which the phases of a sync run
+var syncPhaseOrder = map[common.SyncPhase]int{
+	common.SyncPhasePreSync:  -1,
+	common.SyncPhaseSync:     0,
+	common.SyncPhasePostSync: 1,
+	common.SyncPhaseSyncFail: 2,
+}
+
+// newSyncPlan returns the plan of a dry-run sync from the results of its resources, grouped by the phases and waves
+// in the order they would run
+func newSyncPlan(results []common.ResourceSyncResult, managedResources []managedResource) *v1alpha1.SyncPlan {
+	modified := make(map[kube.ResourceKey]bool)
+	for _, res := range managedResources {
+		modified[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Diff.Modified
+	}
+	results = slices.Clone(results)
+	slices.SortStableFunc(results, func(a, b common.ResourceSyncResult) int {
+		return cmp.Or(
+			cmp.Compare(syncPhaseOrder[a.SyncPhase], syncPhaseOrder[b.SyncPhase]),
+			cmp.Compare(a.Wave, b.Wave),
+			cmp.Compare(a.Order, b.Order),
+		)
+	})
+	plan := &v1alpha1.SyncPlan{}
+	for _, res := range results {
+		if len(plan.Steps) == 0 || plan.Steps[len(plan.Steps)-1].Phase != res.SyncPhase || plan.Steps[len(plan.Steps)-1].Wave != int64(res.Wave) {
+			plan.Steps = append(plan.Steps, v1alpha1.SyncPlanStep{Phase: res.SyncPhase, Wave: int64(res.Wave)})
+		}
+		step := &plan.Steps[len(plan.Steps)-1]
+		step.Resources = append(step.Resources, v1alpha1.SyncPlanResource{
+			Group:     res.ResourceKey.Group,
+			Version:   res.Version,
+			Kind:      res.ResourceKey.Kind,
+			Namespace: res.ResourceKey.Namespace,
+			Name:      res.ResourceKey.Name,
+			Action:    res.Action,
+			HookType:  res.HookType,
+			Modified:  modified[res.ResourceKey],
+			Status:    res.Status,
+			Message:   res.Message,
+		})
+	}
+	return plan
+}
+
+// syncPlanIgnoredFields are the fields of the live resources which do not change the ID of a sync plan, as they are
+// updated by the cluster without changing what the sync would do
+var syncPlanIgnoredFields = [][]string{
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"status"},
+}
+
+// syncPlanID returns the ID of a sync plan for the given target revisions and managed resources. The ID changes if
+// the revisions, the set of resources, their target manifests or their normalized live state change.
+func syncPlanID(revisions []string, managedResources []managedResource) string {
+	resources := slices.Clone(managedResources)
+	slices.SortFunc(resources, func(a, b managedResource) int {
+		return cmp.Or(
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	h := sha256.New()
+	for _, revision := range revisions {
+		_, _ = fmt.Fprintf(h, "revision %s\n", revision)
+	}
+	for _, res := range resources {
+		var target map[string]any
+		if res.Target != nil {
+			target = res.Target.Object
+		}
+		var live map[string]any
+		// a live resource which does not exist is normalized to null, which unmarshals to a nil map
+		_ = json.Unmarshal(res.Diff.NormalizedLive, &live)
+		for _, fields := range syncPlanIgnoredFields {
+			unstructured.RemoveNestedField(live, fields...)
+		}
+		_, _ = fmt.Fprintf(h, "resource %s/%s/%s/%s %s %s\n", res.Group, res.Kind, res.Namespace, res.Name, objectHash(target), objectHash(live))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// objectHash returns the SHA-256 of the JSON encoding of an object, which is deterministic as map keys are sorted
+func objectHash(obj map[string]any) string {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		// objects of resources are always JSON-encodable, as they are decoded from JSON
+		data = []byte(err.Error())
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
@@ -724,7 +860,9 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
-func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
+// syncWindowPreventsSync returns true if the sync windows of the project prevent the sync of the application, along
+// with the reason the sync is blocked
+func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, string, error) {
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
 	var operationStartTime *time.Time
@@ -735,12 +873,12 @@ func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject
 			operationStartTime = &t
 		}
 	}
-	canSync, err := window.CanSync(isManual, operationStartTime)
+	canSync, reason, err := window.CanSyncWithReason(isManual, operationStartTime)
 	if err != nil {
 		// prevents sync because sync window has an error
-		return true, err
+		return true, "", err
 	}
-	return !canSync, nil
+	return !canSync, reason, nil
 }
 
 // validateSyncPermissions checks whether the given resource is permitted by the project's
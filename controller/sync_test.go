@@ -3,6 +3,7 @@ package controller
 import (
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	openapi_v2 "github.com/google/gnostic-models/openapiv2"
@@ -10,6 +11,7 @@ import (
 
 	"sigs.k8s.io/yaml"
 
+	gitopsdiff "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
@@ -312,6 +314,162 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
 		assert.Contains(t, opState.Message, "ConfigMap/configmap1 is part of applications fake-argocd-ns/my-app and guestbook")
 	})
+
+	t.Run("will fail the sync if the resource retry options are invalid", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source:      &v1alpha1.ApplicationSource{},
+				SyncOptions: []string{"ResourceRetryLimit=3", "ResourceRetryBackoff=soon"},
+			},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Equal(t, "invalid sync option ResourceRetryBackoff=soon: must be a non-negative duration", opState.Message)
+	})
+
+	t.Run("will record the plan of a plan sync without recording history, and apply it", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source: &v1alpha1.ApplicationSource{},
+				Plan:   true,
+			},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
+		require.NotNil(t, opState.SyncResult.Plan)
+		assert.Empty(t, opState.SyncResult.Plan.Steps)
+		assert.NotEmpty(t, opState.SyncResult.Plan.ID)
+		assert.Empty(t, f.application.Status.History)
+
+		// when
+		f = setup(nil)
+		opState = &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source: &v1alpha1.ApplicationSource{},
+				PlanID: opState.SyncResult.Plan.ID,
+			},
+		}}
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
+	})
+
+	t.Run("will fail the sync if the plan is out of date", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source: &v1alpha1.ApplicationSource{},
+				PlanID: "stale-plan-id",
+			},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+		assert.Equal(t, "Sync plan stale-plan-id is out of date: the target revision or the live state of the resources changed since it was previewed", opState.Message)
+	})
+}
+
+func TestNewSyncPlan(t *testing.T) {
+	t.Parallel()
+
+	deployKey := kube.NewResourceKey("apps", "Deployment", test.FakeDestNamespace, "guestbook")
+	svcKey := kube.NewResourceKey("", "Service", test.FakeDestNamespace, "guestbook")
+	hookKey := kube.NewResourceKey("batch", "Job", test.FakeDestNamespace, "migrate")
+	cmKey := kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "config")
+	results := []synccommon.ResourceSyncResult{
+		{ResourceKey: deployKey, Version: "v1", SyncPhase: synccommon.SyncPhaseSync, Wave: 1, Order: 4, Action: synccommon.SyncActionPatch, Status: synccommon.ResultCodeSynced, Message: "deployment.apps/guestbook configured (server dry run)"},
+		{ResourceKey: svcKey, Version: "v1", SyncPhase: synccommon.SyncPhaseSync, Wave: 1, Order: 3, Action: synccommon.SyncActionCreate, Status: synccommon.ResultCodeSynced},
+		{ResourceKey: hookKey, Version: "v1", SyncPhase: synccommon.SyncPhasePreSync, Order: 1, Action: synccommon.SyncActionHook, HookType: synccommon.HookTypePreSync, Status: synccommon.ResultCodeSynced},
+		{ResourceKey: cmKey, Version: "v1", SyncPhase: synccommon.SyncPhaseSync, Order: 2, Action: synccommon.SyncActionPrune, Status: synccommon.ResultCodePruned},
+	}
+	managedResources := []managedResource{
+		{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook", Diff: gitopsdiff.DiffResult{Modified: true}},
+		{Kind: "Service", Namespace: test.FakeDestNamespace, Name: "guestbook", Diff: gitopsdiff.DiffResult{Modified: true}},
+		{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "config"},
+	}
+
+	plan := newSyncPlan(results, managedResources)
+
+	require.Len(t, plan.Steps, 3)
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhasePreSync), plan.Steps[0].Phase)
+	assert.Equal(t, int64(0), plan.Steps[0].Wave)
+	require.Len(t, plan.Steps[0].Resources, 1)
+	assert.Equal(t, v1alpha1.SyncPlanResource{
+		Group: "batch", Version: "v1", Kind: "Job", Namespace: test.FakeDestNamespace, Name: "migrate",
+		Action: synccommon.SyncActionHook, HookType: synccommon.HookTypePreSync, Status: synccommon.ResultCodeSynced,
+	}, plan.Steps[0].Resources[0])
+
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhaseSync), plan.Steps[1].Phase)
+	assert.Equal(t, int64(0), plan.Steps[1].Wave)
+	require.Len(t, plan.Steps[1].Resources, 1)
+	assert.Equal(t, "config", plan.Steps[1].Resources[0].Name)
+	assert.Equal(t, synccommon.SyncActionPrune, plan.Steps[1].Resources[0].Action)
+	assert.False(t, plan.Steps[1].Resources[0].Modified)
+
+	assert.Equal(t, synccommon.SyncPhase(synccommon.SyncPhaseSync), plan.Steps[2].Phase)
+	assert.Equal(t, int64(1), plan.Steps[2].Wave)
+	require.Len(t, plan.Steps[2].Resources, 2)
+	assert.Equal(t, "Service", plan.Steps[2].Resources[0].Kind)
+	assert.Equal(t, synccommon.SyncActionCreate, plan.Steps[2].Resources[0].Action)
+	assert.True(t, plan.Steps[2].Resources[0].Modified)
+	assert.Equal(t, "Deployment", plan.Steps[2].Resources[1].Kind)
+	assert.Equal(t, synccommon.SyncActionPatch, plan.Steps[2].Resources[1].Action)
+	assert.Equal(t, "deployment.apps/guestbook configured (server dry run)", plan.Steps[2].Resources[1].Message)
+}
+
+func TestSyncPlanID(t *testing.T) {
+	t.Parallel()
+
+	newManagedResource := func(replicas int64, resourceVersion string, readyReplicas int64) managedResource {
+		target := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "guestbook", "namespace": test.FakeDestNamespace},
+			"spec":       map[string]any{"replicas": replicas},
+		}}
+		live := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"` + test.FakeDestNamespace + `","resourceVersion":"` + resourceVersion + `","generation":1},"spec":{"replicas":1},"status":{"readyReplicas":` + strconv.FormatInt(readyReplicas, 10) + `}}`)
+		return managedResource{
+			Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook",
+			Target: target,
+			Diff:   gitopsdiff.DiffResult{NormalizedLive: live},
+		}
+	}
+	configMap := managedResource{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "config", Diff: gitopsdiff.DiffResult{NormalizedLive: []byte("null")}}
+
+	planID := syncPlanID([]string{"abc123"}, []managedResource{newManagedResource(2, "1", 0), configMap})
+
+	assert.Equal(t, planID, syncPlanID([]string{"abc123"}, []managedResource{configMap, newManagedResource(2, "1", 0)}), "the order of the resources does not change the ID")
+	assert.Equal(t, planID, syncPlanID([]string{"abc123"}, []managedResource{newManagedResource(2, "2", 1), configMap}), "the resource version and status do not change the ID")
+	assert.NotEqual(t, planID, syncPlanID([]string{"def456"}, []managedResource{newManagedResource(2, "1", 0), configMap}), "the revision changes the ID")
+	assert.NotEqual(t, planID, syncPlanID([]string{"abc123"}, []managedResource{newManagedResource(3, "1", 0), configMap}), "the target manifest changes the ID")
+	assert.NotEqual(t, planID, syncPlanID([]string{"abc123"}, []managedResource{newManagedResource(2, "1", 0)}), "the set of resources changes the ID")
+
+	liveChanged := newManagedResource(2, "2", 0)
+	liveChanged.Diff.NormalizedLive = []byte(strings.Replace(string(liveChanged.Diff.NormalizedLive), `"replicas":1`, `"replicas":5`, 1))
+	assert.NotEqual(t, planID, syncPlanID([]string{"abc123"}, []managedResource{liveChanged, configMap}), "the live state changes the ID")
 }
 
 func TestSyncWindowDeniesSync(t *testing.T) {
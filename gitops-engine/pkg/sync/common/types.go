@@ -1,6 +1,10 @@
 package common
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -53,11 +57,74 @@ const (
 	SyncOptionClientSideApplyMigration = "ClientSideApplyMigration=true"
 	// Sync option that disables client-side apply migration
 	SyncOptionDisableClientSideApplyMigration = "ClientSideApplyMigration=false"
+	// Sync option that sets how many times applying a resource is retried after a transient error
+	SyncOptionResourceRetryLimit = "ResourceRetryLimit"
+	// Sync option that sets the duration to wait before retrying to apply a resource for the first time
+	SyncOptionResourceRetryBackoff = "ResourceRetryBackoff"
+	// Sync option that sets the maximum duration to wait between two retries to apply a resource
+	SyncOptionResourceRetryMaxBackoff = "ResourceRetryMaxBackoff"
 
 	// Default field manager for client-side apply migration
 	DefaultClientSideApplyMigrationManager = "kubectl-client-side-apply"
+
+	// Default duration to wait before retrying to apply a resource for the first time
+	DefaultResourceRetryBackoff = 1 * time.Second
+	// Default maximum duration to wait between two retries to apply a resource
+	DefaultResourceRetryMaxBackoff = 30 * time.Second
 )
 
+// ResourceRetryStrategy controls how applying a single resource is retried after a transient error, without
+// retrying the whole sync operation. The duration to wait is doubled after each retry.
+type ResourceRetryStrategy struct {
+	// Limit is the maximum number of retries. Retries are disabled if it is zero.
+	Limit int
+	// Backoff is the duration to wait before the first retry
+	Backoff time.Duration
+	// MaxBackoff is the maximum duration to wait between two retries
+	MaxBackoff time.Duration
+}
+
+// NewResourceRetryStrategy returns a strategy with retries disabled and the default backoff
+func NewResourceRetryStrategy() ResourceRetryStrategy {
+	return ResourceRetryStrategy{Backoff: DefaultResourceRetryBackoff, MaxBackoff: DefaultResourceRetryMaxBackoff}
+}
+
+// WithOptions returns a copy of the strategy with the values of the resource retry sync options which are set.
+// getOptionValue returns the value of a key=value sync option, or nil if the option is not set.
+func (s ResourceRetryStrategy) WithOptions(getOptionValue func(optionKey string) *string) (ResourceRetryStrategy, error) {
+	if val := getOptionValue(SyncOptionResourceRetryLimit); val != nil {
+		limit, err := strconv.Atoi(*val)
+		if err != nil || limit < 0 {
+			return s, fmt.Errorf("invalid sync option %s=%s: must be a non-negative integer", SyncOptionResourceRetryLimit, *val)
+		}
+		s.Limit = limit
+	}
+	for key, duration := range map[string]*time.Duration{
+		SyncOptionResourceRetryBackoff:    &s.Backoff,
+		SyncOptionResourceRetryMaxBackoff: &s.MaxBackoff,
+	} {
+		val := getOptionValue(key)
+		if val == nil {
+			continue
+		}
+		d, err := time.ParseDuration(*val)
+		if err != nil || d < 0 {
+			return s, fmt.Errorf("invalid sync option %s=%s: must be a non-negative duration", key, *val)
+		}
+		*duration = d
+	}
+	return s, nil
+}
+
+// NextBackoff returns the duration to wait before the given retry, starting at 1
+func (s ResourceRetryStrategy) NextBackoff(retry int) time.Duration {
+	backoff := s.Backoff
+	for i := 1; i < retry && backoff < s.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, s.MaxBackoff)
+}
+
 type PermissionValidator func(un *unstructured.Unstructured, res *metav1.APIResource) error
 
 type SyncPhase string
@@ -113,6 +180,17 @@ const (
 	ResultCodePruneSkipped ResultCode = "PruneSkipped"
 )
 
+// SyncAction is the change a sync makes to a resource
+type SyncAction string
+
+const (
+	SyncActionCreate  SyncAction = "Create"
+	SyncActionPatch   SyncAction = "Patch"
+	SyncActionReplace SyncAction = "Replace"
+	SyncActionPrune   SyncAction = "Prune"
+	SyncActionHook    SyncAction = "Hook"
+)
+
 type HookType string
 
 const (
@@ -169,4 +247,10 @@ type ResourceSyncResult struct {
 	HookPhase OperationPhase
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase
+	// the number of times applying the resource was retried after a transient error
+	Retries int
+	// the sync wave the resource is applied or pruned in
+	Wave int
+	// the change the sync makes to the resource
+	Action SyncAction
 }
@@ -2,8 +2,10 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewHookType(t *testing.T) {
@@ -59,3 +61,51 @@ func TestNewHookDeletePolicy(t *testing.T) {
 		assert.Equal(t, HookDeletePolicyBeforeHookCreation, p)
 	})
 }
+
+func TestResourceRetryStrategy_WithOptions(t *testing.T) {
+	t.Parallel()
+	options := func(values map[string]string) func(string) *string {
+		return func(key string) *string {
+			if val, ok := values[key]; ok {
+				return &val
+			}
+			return nil
+		}
+	}
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+		strategy, err := NewResourceRetryStrategy().WithOptions(options(nil))
+		require.NoError(t, err)
+		assert.Equal(t, ResourceRetryStrategy{Backoff: DefaultResourceRetryBackoff, MaxBackoff: DefaultResourceRetryMaxBackoff}, strategy)
+	})
+	t.Run("Overridden", func(t *testing.T) {
+		t.Parallel()
+		strategy, err := NewResourceRetryStrategy().WithOptions(options(map[string]string{
+			SyncOptionResourceRetryLimit:      "3",
+			SyncOptionResourceRetryBackoff:    "2s",
+			SyncOptionResourceRetryMaxBackoff: "1m",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, ResourceRetryStrategy{Limit: 3, Backoff: 2 * time.Second, MaxBackoff: time.Minute}, strategy)
+	})
+	t.Run("InvalidLimit", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewResourceRetryStrategy().WithOptions(options(map[string]string{SyncOptionResourceRetryLimit: "-1"}))
+		assert.EqualError(t, err, "invalid sync option ResourceRetryLimit=-1: must be a non-negative integer")
+	})
+	t.Run("InvalidBackoff", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewResourceRetryStrategy().WithOptions(options(map[string]string{SyncOptionResourceRetryBackoff: "soon"}))
+		assert.EqualError(t, err, "invalid sync option ResourceRetryBackoff=soon: must be a non-negative duration")
+	})
+}
+
+func TestResourceRetryStrategy_NextBackoff(t *testing.T) {
+	t.Parallel()
+	strategy := ResourceRetryStrategy{Limit: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, strategy.NextBackoff(1))
+	assert.Equal(t, 2*time.Second, strategy.NextBackoff(2))
+	assert.Equal(t, 4*time.Second, strategy.NextBackoff(3))
+	assert.Equal(t, 5*time.Second, strategy.NextBackoff(4))
+	assert.Equal(t, 5*time.Second, strategy.NextBackoff(5))
+}
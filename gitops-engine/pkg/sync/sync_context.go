@@ -3,7 +3,10 @@ package sync
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -41,6 +44,9 @@ import (
 
 var tracer = otel.Tracer("github.com/argoproj/argo-cd/gitops-engine/pkg/sync")
 
+// transientErrorRegexp matches the messages of API errors which are likely to go away when retrying
+var transientErrorRegexp = regexp.MustCompile(`Error from server \((Conflict|TooManyRequests|InternalError|ServiceUnavailable|Timeout|ServerTimeout)\)|the object has been modified; please apply your changes to the latest version`)
+
 // taskTraceAttrs returns the standard argocd.resource.* span attributes for a sync task.
 func taskTraceAttrs(t *syncTask) []attribute.KeyValue {
 	return []attribute.KeyValue{
@@ -242,6 +248,22 @@ func WithClientSideApplyMigration(enabled bool, manager string) SyncOpt {
 	}
 }
 
+// WithServerSideDryRun validates the resources against the API server, including admission webhooks, when the whole
+// sync operation is a dry-run
+func WithServerSideDryRun(enabled bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.serverSideDryRun = enabled
+	}
+}
+
+// WithResourceRetry sets the strategy used to retry applying a resource after a transient error. The strategy can be
+// overridden for a single resource by the resource retry sync options of its sync-options annotation.
+func WithResourceRetry(strategy common.ResourceRetryStrategy) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.resourceRetry = strategy
+	}
+}
+
 // NewSyncContext creates new instance of a SyncContext
 func NewSyncContext(
 	revision string,
@@ -286,6 +308,7 @@ func NewSyncContext(
 		syncRes:                         map[string]common.ResourceSyncResult{},
 		clientSideApplyMigrationManager: common.DefaultClientSideApplyMigrationManager,
 		enableClientSideApplyMigration:  true,
+		resourceRetry:                   common.NewResourceRetryStrategy(),
 		permissionValidator: func(_ *unstructured.Unstructured, _ *metav1.APIResource) error {
 			return nil
 		},
@@ -400,6 +423,7 @@ type syncContext struct {
 	namespace           string
 
 	dryRun                          bool
+	serverSideDryRun                bool
 	skipDryRunOnMissingResource     bool
 	force                           bool
 	validate                        bool
@@ -415,6 +439,7 @@ type syncContext struct {
 	defaultPruneOption              *string
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
+	resourceRetry                   common.ResourceRetryStrategy
 
 	syncRes   map[string]common.ResourceSyncResult
 	startedAt time.Time
@@ -1170,6 +1195,19 @@ func (sc *syncContext) getSyncTasks(ctx context.Context) (_ syncTasks, successfu
 		}
 	}
 
+	if sc.serverSideDryRun {
+		// the API server cannot validate resources in namespaces which do not exist yet
+		createdNamespaces := map[string]bool{}
+		for _, task := range tasks {
+			if task.liveObj == nil && task.targetObj != nil && task.group() == "" && task.kind() == kubeutil.NamespaceKind {
+				createdNamespaces[task.name()] = true
+			}
+		}
+		for _, task := range tasks {
+			task.skipServerSideDryRun = createdNamespaces[task.namespace()]
+		}
+	}
+
 	tasks.Sort()
 
 	// finally enrich tasks with the result
@@ -1423,15 +1461,18 @@ func (sc *syncContext) applyObject(ctx context.Context, t *syncTask, dryRun, val
 
 	dryRunStrategy := cmdutil.DryRunNone
 	if dryRun {
-		// irrespective of the dry run mode set in the sync context, always run
+		// unless the whole sync is a dry-run validated by the API server, always run
 		// in client dry run mode as the goal is to validate only the
 		// yaml correctness of the rendered manifests.
 		dryRunStrategy = cmdutil.DryRunClient
+		if sc.dryRun && sc.serverSideDryRun && !t.skipServerSideDryRun {
+			dryRunStrategy = cmdutil.DryRunServer
+		}
 	}
 
 	var err error
 	var message string
-	shouldReplace := sc.replace || resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionReplace) || (t.liveObj != nil && resourceutil.HasAnnotationOption(t.liveObj, common.AnnotationSyncOptions, common.SyncOptionReplace))
+	shouldReplace := sc.shouldReplace(t)
 	force := sc.force || resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionForce) || (t.liveObj != nil && resourceutil.HasAnnotationOption(t.liveObj, common.AnnotationSyncOptions, common.SyncOptionForce))
 
 	serverSideApply := sc.shouldUseServerSideApply(t.targetObj, dryRun)
@@ -1449,30 +1490,25 @@ func (sc *syncContext) applyObject(ctx context.Context, t *syncTask, dryRun, val
 		}
 	}
 
-	if shouldReplace {
-		if t.liveObj != nil {
-			// Avoid using `kubectl replace` for CRDs since 'replace' might recreate resource and so delete all CRD instances.
-			// The same thing applies for namespaces, which would delete the namespace as well as everything within it,
-			// so we want to avoid using `kubectl replace` in that case as well.
-			if kubeutil.IsCRD(t.targetObj) || t.targetObj.GetKind() == kubeutil.NamespaceKind {
-				update := t.targetObj.DeepCopy()
-				update.SetResourceVersion(t.liveObj.GetResourceVersion())
-				_, err = sc.resourceOps.UpdateResource(ctx, update, dryRunStrategy)
-				if err == nil {
-					message = fmt.Sprintf("%s/%s updated", t.targetObj.GetKind(), t.targetObj.GetName())
-				} else {
-					message = fmt.Sprintf("error when updating: %v", err.Error())
-				}
-			} else {
-				message, err = sc.resourceOps.ReplaceResource(ctx, t.targetObj, dryRunStrategy, force)
-			}
-		} else {
-			message, err = sc.resourceOps.CreateResource(ctx, t.targetObj, dryRunStrategy, validate)
+	retryStrategy, err := sc.resourceRetry.WithOptions(func(optionKey string) *string {
+		return resourceutil.GetAnnotationOptionValue(t.targetObj, common.AnnotationSyncOptions, optionKey)
+	})
+	if err != nil {
+		return common.ResultCodeSyncFailed, err.Error()
+	}
+	retries := 0
+	for {
+		message, err = sc.applyTask(ctx, t, dryRunStrategy, validate, shouldReplace, force, serverSideApply)
+		if err == nil || retries >= retryStrategy.Limit || !isTransientError(err) || !sc.waitBeforeRetry(ctx, t, err, retryStrategy.NextBackoff(retries+1)) {
+			break
 		}
-	} else {
-		message, err = sc.resourceOps.ApplyResource(ctx, t.targetObj, dryRunStrategy, force, validate, serverSideApply, sc.serverSideApplyManager)
+		retries++
 	}
+	t.retries += retries
 	if err != nil {
+		if retries > 0 {
+			return common.ResultCodeSyncFailed, fmt.Sprintf("%v (retried %d times)", err, retries)
+		}
 		return common.ResultCodeSyncFailed, err.Error()
 	}
 	if kubeutil.IsCRD(t.targetObj) && !dryRun {
@@ -1484,6 +1520,74 @@ func (sc *syncContext) applyObject(ctx context.Context, t *syncTask, dryRun, val
 	return common.ResultCodeSynced, message
 }
 
+// shouldReplace returns true if the target object of a task is created or replaced instead of applied
+func (sc *syncContext) shouldReplace(t *syncTask) bool {
+	return sc.replace || resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionReplace) || (t.liveObj != nil && resourceutil.HasAnnotationOption(t.liveObj, common.AnnotationSyncOptions, common.SyncOptionReplace))
+}
+
+// taskAction returns the change the sync makes to the resource of a task
+func (sc *syncContext) taskAction(t *syncTask) common.SyncAction {
+	switch {
+	case t.isPrune():
+		return common.SyncActionPrune
+	case t.isHook():
+		return common.SyncActionHook
+	case t.liveObj == nil:
+		return common.SyncActionCreate
+	case sc.shouldReplace(t):
+		return common.SyncActionReplace
+	default:
+		return common.SyncActionPatch
+	}
+}
+
+// applyTask creates, replaces or applies the target object of a task once
+func (sc *syncContext) applyTask(ctx context.Context, t *syncTask, dryRunStrategy cmdutil.DryRunStrategy, validate, shouldReplace, force, serverSideApply bool) (string, error) {
+	if !shouldReplace {
+		return sc.resourceOps.ApplyResource(ctx, t.targetObj, dryRunStrategy, force, validate, serverSideApply, sc.serverSideApplyManager)
+	}
+	if t.liveObj == nil {
+		return sc.resourceOps.CreateResource(ctx, t.targetObj, dryRunStrategy, validate)
+	}
+	// Avoid using `kubectl replace` for CRDs since 'replace' might recreate resource and so delete all CRD instances.
+	// The same thing applies for namespaces, which would delete the namespace as well as everything within it,
+	// so we want to avoid using `kubectl replace` in that case as well.
+	if kubeutil.IsCRD(t.targetObj) || t.targetObj.GetKind() == kubeutil.NamespaceKind {
+		update := t.targetObj.DeepCopy()
+		update.SetResourceVersion(t.liveObj.GetResourceVersion())
+		if _, err := sc.resourceOps.UpdateResource(ctx, update, dryRunStrategy); err != nil {
+			return fmt.Sprintf("error when updating: %v", err.Error()), err
+		}
+		return fmt.Sprintf("%s/%s updated", t.targetObj.GetKind(), t.targetObj.GetName()), nil
+	}
+	return sc.resourceOps.ReplaceResource(ctx, t.targetObj, dryRunStrategy, force)
+}
+
+// waitBeforeRetry waits for the backoff before retrying to apply a task, and returns false if the context is done first
+func (sc *syncContext) waitBeforeRetry(ctx context.Context, t *syncTask, err error, backoff time.Duration) bool {
+	sc.log.WithValues("task", t, "backoff", backoff).Info(fmt.Sprintf("Retrying to apply after transient error: %v", err))
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(backoff):
+		return true
+	}
+}
+
+// isTransientError returns true if an error returned when applying a resource is likely to go away when retrying,
+// such as conflicts, throttling, server errors and webhook timeouts
+func isTransientError(err error) bool {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		code := status.Status().Code
+		return code == http.StatusConflict || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+	}
+	// errors returned by kubectl only contain the message of the API error
+	msg := err.Error()
+	return transientErrorRegexp.MatchString(msg) ||
+		(strings.Contains(msg, "failed calling webhook") && (strings.Contains(msg, "context deadline exceeded") || strings.Contains(strings.ToLower(msg), "timeout")))
+}
+
 // pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
 func (sc *syncContext) pruneObject(ctx context.Context, t *syncTask, prune, dryRun bool) (common.ResultCode, string) {
 	ctx, span := tracer.Start(ctx, "sync.prune")
@@ -1804,6 +1908,9 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 		HookType:    task.hookType(),
 		HookPhase:   task.operationState,
 		SyncPhase:   task.phase,
+		Retries:     task.retries,
+		Wave:        task.wave(),
+		Action:      sc.taskAction(task),
 	}
 
 	logCtx := sc.log.WithValues("namespace", task.namespace(), "kind", task.kind(), "name", task.name(), "phase", task.phase)
@@ -1819,6 +1926,12 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 			existing.HookPhase = res.HookPhase
 			existing.Message = res.Message
 		}
+		// tasks are recreated on every sync step, so only the step which applied the resource knows its retries
+		if res.Retries > 0 {
+			existing.Retries = res.Retries
+		}
+		existing.Wave = res.Wave
+		existing.Action = res.Action
 		sc.syncRes[task.resultKey()] = existing
 	} else {
 		logCtx.Info(fmt.Sprintf("Adding resource result, status: '%s', phase: '%s', message: '%s'", res.Status, res.HookPhase, res.Message))
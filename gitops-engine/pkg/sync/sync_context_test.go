@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"strings"
+	gosync "sync"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 	"k8s.io/klog/v2/textlogger"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
@@ -161,6 +163,243 @@ func TestSyncNamespaceCreatedBeforeDryRunWithFailure(t *testing.T) {
 	assert.Equal(t, "invalid object failing dry-run", resources[1].Message)
 }
 
+// flakyResourceOps fails to apply resources with an error a number of times before applying them
+type flakyResourceOps struct {
+	*kubetest.MockResourceOps
+	err      error
+	failures int
+	applies  int
+}
+
+func (r *flakyResourceOps) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	if dryRunStrategy != cmdutil.DryRunNone {
+		return "", nil
+	}
+	r.applies++
+	if r.applies <= r.failures {
+		return "", r.err
+	}
+	return r.MockResourceOps.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+}
+
+func TestSyncResourceRetry(t *testing.T) {
+	conflictErr := apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, "my-pod", errors.New("the object has been modified"))
+	newSyncCtx := func(ops *flakyResourceOps, opts ...SyncOpt) *syncContext {
+		syncCtx := newTestSyncCtx(nil, opts...)
+		ops.MockResourceOps = syncCtx.resourceOps.(*kubetest.MockResourceOps)
+		syncCtx.resourceOps = ops
+		return syncCtx
+	}
+	retry := synccommon.ResourceRetryStrategy{Limit: 2, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	t.Run("SucceedsAfterTransientErrors", func(t *testing.T) {
+		ops := &flakyResourceOps{err: conflictErr, failures: 2}
+		syncCtx := newSyncCtx(ops, WithResourceRetry(retry))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.Sync(t.Context())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		require.Len(t, resources, 1)
+		assert.Equal(t, synccommon.ResultCodeSynced, resources[0].Status)
+		assert.Equal(t, 2, resources[0].Retries)
+		assert.Equal(t, 3, ops.applies)
+	})
+
+	t.Run("FailsWhenRetriesAreExhausted", func(t *testing.T) {
+		ops := &flakyResourceOps{err: errors.New(`Error from server (TooManyRequests): the server has received too many requests`), failures: 5}
+		syncCtx := newSyncCtx(ops, WithResourceRetry(retry))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.Sync(t.Context())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, resources, 1)
+		assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[0].Status)
+		assert.Equal(t, "Error from server (TooManyRequests): the server has received too many requests (retried 2 times)", resources[0].Message)
+		assert.Equal(t, 2, resources[0].Retries)
+		assert.Equal(t, 3, ops.applies)
+	})
+
+	t.Run("DoesNotRetryPermanentErrors", func(t *testing.T) {
+		ops := &flakyResourceOps{err: errors.New(`admission webhook "policy.example.com" denied the request`), failures: 1}
+		syncCtx := newSyncCtx(ops, WithResourceRetry(retry))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.Sync(t.Context())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, resources, 1)
+		assert.Zero(t, resources[0].Retries)
+		assert.Equal(t, 1, ops.applies)
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		ops := &flakyResourceOps{err: conflictErr, failures: 1}
+		syncCtx := newSyncCtx(ops)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.Sync(t.Context())
+
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, 1, ops.applies)
+	})
+
+	t.Run("EnabledByAnnotation", func(t *testing.T) {
+		ops := &flakyResourceOps{err: conflictErr, failures: 1}
+		syncCtx := newSyncCtx(ops)
+		pod := testingutils.NewPod()
+		pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: "ResourceRetryLimit=1,ResourceRetryBackoff=1ms"})
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{pod},
+		})
+		syncCtx.Sync(t.Context())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		require.Len(t, resources, 1)
+		assert.Equal(t, 1, resources[0].Retries)
+	})
+
+	t.Run("InvalidAnnotation", func(t *testing.T) {
+		syncCtx := newSyncCtx(&flakyResourceOps{})
+		pod := testingutils.NewPod()
+		pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: "ResourceRetryLimit=many"})
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{pod},
+		})
+		syncCtx.Sync(t.Context())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, resources, 1)
+		assert.Equal(t, "invalid sync option ResourceRetryLimit=many: must be a non-negative integer", resources[0].Message)
+	})
+}
+
+func TestSyncDryRunResultActions(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(true, true, false, false))
+	createdPod := testingutils.NewPod()
+	createdPod.SetName("created-pod")
+	patchedPod := testingutils.NewPod()
+	patchedPod.SetName("patched-pod")
+	testingutils.Annotate(patchedPod, synccommon.AnnotationSyncWave, "1")
+	replacedPod := withReplaceAnnotation(testingutils.NewPod())
+	replacedPod.SetName("replaced-pod")
+	prunedPod := testingutils.NewPod()
+	prunedPod.SetName("pruned-pod")
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, patchedPod, replacedPod, prunedPod},
+		Target: []*unstructured.Unstructured{createdPod, patchedPod, replacedPod, nil},
+	})
+	syncCtx.hooks = []*unstructured.Unstructured{newHook("pre-sync-hook", synccommon.HookTypePreSync, synccommon.HookDeletePolicyBeforeHookCreation)}
+
+	syncCtx.Sync(t.Context())
+
+	phase, _, resources := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	actions := map[string]synccommon.SyncAction{}
+	waves := map[string]int{}
+	for _, res := range resources {
+		actions[res.ResourceKey.Name] = res.Action
+		waves[res.ResourceKey.Name] = res.Wave
+	}
+	assert.Equal(t, map[string]synccommon.SyncAction{
+		"created-pod":   synccommon.SyncActionCreate,
+		"patched-pod":   synccommon.SyncActionPatch,
+		"replaced-pod":  synccommon.SyncActionReplace,
+		"pruned-pod":    synccommon.SyncActionPrune,
+		"pre-sync-hook": synccommon.SyncActionHook,
+	}, actions)
+	assert.Equal(t, 1, waves["patched-pod"])
+	assert.Equal(t, 0, waves["created-pod"])
+}
+
+// dryRunRecordingResourceOps records the dry-run strategy each resource is applied with
+type dryRunRecordingResourceOps struct {
+	*kubetest.MockResourceOps
+	lock       gosync.Mutex
+	strategies map[string]cmdutil.DryRunStrategy
+}
+
+func (r *dryRunRecordingResourceOps) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	r.lock.Lock()
+	r.strategies[obj.GetKind()+"/"+obj.GetName()] = dryRunStrategy
+	r.lock.Unlock()
+	return r.MockResourceOps.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+}
+
+func TestSyncServerSideDryRun(t *testing.T) {
+	newSyncCtx := func(opts ...SyncOpt) (*syncContext, *dryRunRecordingResourceOps) {
+		syncCtx := newTestSyncCtx(nil, opts...)
+		ops := &dryRunRecordingResourceOps{MockResourceOps: syncCtx.resourceOps.(*kubetest.MockResourceOps), strategies: map[string]cmdutil.DryRunStrategy{}}
+		syncCtx.resourceOps = ops
+		namespace := testingutils.NewNamespace()
+		podInNewNamespace := testingutils.NewPod()
+		podInNewNamespace.SetName("new-pod")
+		podInNewNamespace.SetNamespace(namespace.GetName())
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil, nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod(), namespace, podInNewNamespace},
+		})
+		return syncCtx, ops
+	}
+
+	t.Run("DryRunSync", func(t *testing.T) {
+		syncCtx, ops := newSyncCtx(WithOperationSettings(true, false, false, false), WithServerSideDryRun(true))
+		syncCtx.Sync(t.Context())
+
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Equal(t, cmdutil.DryRunServer, ops.strategies["Pod/my-pod"])
+		assert.Equal(t, cmdutil.DryRunServer, ops.strategies["Namespace/testnamespace"])
+		assert.Equal(t, cmdutil.DryRunClient, ops.strategies["Pod/new-pod"])
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		syncCtx, ops := newSyncCtx(WithOperationSettings(true, false, false, false))
+		syncCtx.Sync(t.Context())
+
+		assert.Equal(t, cmdutil.DryRunClient, ops.strategies["Pod/my-pod"])
+	})
+
+	t.Run("NotUsedToValidateSync", func(t *testing.T) {
+		syncCtx, ops := newSyncCtx(WithServerSideDryRun(true))
+		syncCtx.Sync(t.Context())
+
+		assert.Equal(t, cmdutil.DryRunNone, ops.strategies["Pod/my-pod"])
+	})
+}
+
+func TestIsTransientError(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	assert.True(t, isTransientError(apierrors.NewConflict(gr, "my-pod", errors.New("modified"))))
+	assert.True(t, isTransientError(apierrors.NewTooManyRequests("slow down", 1)))
+	assert.True(t, isTransientError(apierrors.NewInternalError(errors.New("etcd unavailable"))))
+	assert.True(t, isTransientError(apierrors.NewServiceUnavailable("unavailable")))
+	assert.True(t, isTransientError(errors.New(`Error from server (InternalError): error when applying patch: Internal error occurred: failed calling webhook "validate.example.com": failed to call webhook: Post "https://webhook.svc:443/validate": context deadline exceeded`)))
+	assert.True(t, isTransientError(errors.New(`Operation cannot be fulfilled on pods "my-pod": the object has been modified; please apply your changes to the latest version and try again`)))
+	assert.True(t, isTransientError(errors.New(`failed calling webhook "validate.example.com": Post "https://webhook.svc:443/validate": net/http: request canceled (Client.Timeout exceeded while awaiting headers)`)))
+	assert.False(t, isTransientError(apierrors.NewForbidden(gr, "my-pod", errors.New("denied"))))
+	assert.False(t, isTransientError(apierrors.NewBadRequest("invalid")))
+	assert.False(t, isTransientError(errors.New(`admission webhook "validate.example.com" denied the request: missing label`)))
+	assert.False(t, isTransientError(errors.New(`The Pod "my-pod" is invalid: spec.containers: Required value`)))
+}
+
 func TestSyncCreateInSortedOrder(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil)
 	syncCtx.resources = groupResources(ReconciliationResult{
@@ -25,6 +25,10 @@ type syncTask struct {
 	operationState common.OperationPhase
 	message        string
 	waveOverride   *int
+	retries        int
+	// skipServerSideDryRun is true if the resource cannot be validated by the API server before it is created,
+	// because its namespace is created by the same sync
+	skipServerSideDryRun bool
 }
 
 func ternary(val bool, a, b string) string {
@@ -316,7 +316,9 @@ require (
 )
 
 require (
+	github.com/arran4/golang-ical v0.3.2
 	github.com/go-openapi/runtime/server-middleware v0.33.0
+	github.com/teambition/rrule-go v1.8.2
 	k8s.io/streaming v0.36.1
 )
 
@@ -987,23 +987,29 @@ func (m *SyncOptions) GetItems() []string {
 
 // ApplicationSyncRequest is a request to apply the config state to live state
 type ApplicationSyncRequest struct {
-	Name                 *string                           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string                           `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	DryRun               *bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
-	Prune                *bool                             `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
-	Strategy             *v1alpha1.SyncStrategy            `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
-	Resources            []*v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
-	Manifests            []string                          `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
-	Infos                []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
-	RetryStrategy        *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
-	SyncOptions          *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
-	AppNamespace         *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string                           `protobuf:"bytes,13,opt,name=project" json:"project,omitempty"`
-	SourcePositions      []int64                           `protobuf:"varint,14,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
-	Revisions            []string                          `protobuf:"bytes,15,rep,name=revisions" json:"revisions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	Name            *string                           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision        *string                           `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	DryRun          *bool                             `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	Prune           *bool                             `protobuf:"varint,4,opt,name=prune" json:"prune,omitempty"`
+	Strategy        *v1alpha1.SyncStrategy            `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
+	Resources       []*v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
+	Manifests       []string                          `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
+	Infos           []*v1alpha1.Info                  `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
+	RetryStrategy   *v1alpha1.RetryStrategy           `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	SyncOptions     *SyncOptions                      `protobuf:"bytes,11,opt,name=syncOptions" json:"syncOptions,omitempty"`
+	AppNamespace    *string                           `protobuf:"bytes,12,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project         *string                           `protobuf:"bytes,13,opt,name=project" json:"project,omitempty"`
+	SourcePositions []int64                           `protobuf:"varint,14,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
+	Revisions       []string                          `protobuf:"bytes,15,rep,name=revisions" json:"revisions,omitempty"`
+	// plan performs a dry-run of the sync validated by the API server, and records the changes the sync would make in
+	// the plan of the sync result
+	Plan *bool `protobuf:"varint,16,opt,name=plan" json:"plan,omitempty"`
+	// planID applies the last sync plan of the application, if its ID matches. The sync uses the options of the plan,
+	// and is refused if the target revision or the live state of the resources changed since the plan was previewed.
+	PlanID               *string  `protobuf:"bytes,17,opt,name=planID" json:"planID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{} }
@@ -1137,6 +1143,20 @@ func (m *ApplicationSyncRequest) GetRevisions() []string {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetPlan() bool {
+	if m != nil && m.Plan != nil {
+		return *m.Plan
+	}
+	return false
+}
+
+func (m *ApplicationSyncRequest) GetPlanID() string {
+	if m != nil && m.PlanID != nil {
+		return *m.PlanID
+	}
+	return ""
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -3174,196 +3194,197 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3018 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcf, 0x8f, 0x1c, 0x47,
-	0xf5, 0xff, 0xd6, 0xcc, 0xce, 0xee, 0xec, 0x1b, 0xaf, 0x7f, 0x54, 0x6c, 0x7f, 0x3b, 0xe3, 0x8d,
-	0xd9, 0xb4, 0xed, 0x78, 0xb3, 0xf6, 0xce, 0xd8, 0x13, 0x03, 0xc9, 0x26, 0x21, 0x38, 0x6b, 0xc7,
-	0x59, 0x58, 0x3b, 0xa6, 0xd7, 0x89, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x66, 0xa6, 0xd9, 0x9e, 0xee,
-	0x76, 0x77, 0xcf, 0x84, 0x55, 0x88, 0x84, 0x82, 0x90, 0x38, 0xa0, 0x20, 0x20, 0x07, 0x0e, 0xfc,
-	0x4c, 0x14, 0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x41, 0x70, 0x40, 0x42, 0xf0,
-	0x0f, 0x20, 0x0b, 0x71, 0xe0, 0x40, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0x5d, 0xf3, 0xa3,
-	0x67, 0x96, 0x19, 0x88, 0x25, 0x4e, 0xee, 0x57, 0xd3, 0xfd, 0xde, 0xe7, 0xbd, 0x7a, 0xef, 0xd5,
-	0xab, 0xf7, 0xd6, 0x70, 0x3a, 0xa4, 0x41, 0x8f, 0x06, 0x75, 0xe2, 0xfb, 0x8e, 0x6d, 0x92, 0xc8,
-	0xf6, 0xdc, 0xec, 0x73, 0xcd, 0x0f, 0xbc, 0xc8, 0xc3, 0x95, 0xcc, 0x52, 0x75, 0xb9, 0xe5, 0x79,
-	0x2d, 0x87, 0xd6, 0x89, 0x6f, 0xd7, 0x89, 0xeb, 0x7a, 0x11, 0x5f, 0x0e, 0xe3, 0x57, 0xab, 0x97,
-	0x76, 0x1f, 0x0d, 0x6b, 0xb6, 0xc7, 0x7e, 0xed, 0x10, 0xb3, 0x6d, 0xbb, 0x34, 0xd8, 0xab, 0xfb,
-	0xbb, 0x2d, 0xb6, 0x10, 0xd6, 0x3b, 0x34, 0x22, 0xf5, 0xde, 0xc5, 0x7a, 0x8b, 0xba, 0x34, 0x20,
-	0x11, 0xb5, 0xc4, 0x57, 0xdb, 0x2d, 0x3b, 0x6a, 0x77, 0x5f, 0xaa, 0x99, 0x5e, 0xa7, 0x4e, 0x82,
-	0x96, 0xe7, 0x07, 0xde, 0x67, 0xf9, 0xc3, 0xba, 0x69, 0xd5, 0x7b, 0x8f, 0xa4, 0x0c, 0xb2, 0x38,
-	0x7b, 0x17, 0x89, 0xe3, 0xb7, 0xc9, 0x20, 0xb7, 0xab, 0x63, 0xb8, 0x05, 0xd4, 0xf7, 0x84, 0xde,
-	0xfc, 0xd1, 0x8e, 0xbc, 0x60, 0x2f, 0xf3, 0x28, 0xd8, 0x3c, 0x36, 0x86, 0x8d, 0x60, 0x41, 0x7b,
-	0xd4, 0x8d, 0x42, 0xf1, 0x4f, 0xfc, 0xa9, 0xfe, 0x1e, 0x82, 0xc3, 0x97, 0x53, 0xa8, 0x9f, 0xe8,
-	0xd2, 0x60, 0x0f, 0x63, 0x98, 0x73, 0x49, 0x87, 0x6a, 0x68, 0x05, 0xad, 0x2e, 0x1a, 0xfc, 0x19,
-	0x6b, 0xb0, 0x10, 0xd0, 0x66, 0x40, 0xc3, 0xb6, 0x56, 0xe0, 0xcb, 0x92, 0xc4, 0x55, 0x28, 0x33,
-	0x81, 0xd4, 0x8c, 0x42, 0xad, 0xb8, 0x52, 0x5c, 0x5d, 0x34, 0x12, 0x1a, 0xaf, 0xc2, 0xa1, 0x80,
+	// 3031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xdf, 0x8f, 0x1c, 0x47,
+	0xf1, 0xff, 0xf6, 0xee, 0xed, 0xdd, 0x5e, 0xad, 0xcf, 0x3f, 0x3a, 0xb6, 0xbf, 0x93, 0xf5, 0xc5,
+	0x5c, 0xc6, 0x76, 0xbc, 0x39, 0xfb, 0x76, 0xed, 0x8d, 0x81, 0xe4, 0x92, 0x10, 0x9c, 0xb3, 0xe3,
+	0x1c, 0x9c, 0x1d, 0x33, 0xe7, 0xc4, 0x28, 0x3c, 0x40, 0x67, 0xa6, 0x6f, 0x77, 0xb8, 0xd9, 0x99,
+	0xf1, 0xcc, 0xec, 0x86, 0x53, 0x88, 0x84, 0x82, 0x90, 0x78, 0x40, 0x41, 0x40, 0x1e, 0x78, 0xe0,
+	0x67, 0xa2, 0x20, 0x14, 0x81, 0x78, 0x41, 0x08, 0x09, 0x21, 0xc1, 0x43, 0x10, 0x3c, 0x20, 0x21,
+	0xf8, 0x07, 0x90, 0x85, 0x78, 0xe0, 0x81, 0xbc, 0xe4, 0x0f, 0x40, 0xdd, 0xd3, 0x3d, 0x33, 0xbd,
+	0x3f, 0x66, 0xf7, 0xd8, 0x85, 0x58, 0xe2, 0xc9, 0x53, 0xbd, 0x33, 0x55, 0x9f, 0xaa, 0xae, 0xaa,
+	0xae, 0xae, 0x3a, 0xc3, 0xe9, 0x90, 0x06, 0x3d, 0x1a, 0x34, 0x88, 0xef, 0x3b, 0xb6, 0x49, 0x22,
+	0xdb, 0x73, 0xb3, 0xcf, 0x75, 0x3f, 0xf0, 0x22, 0x0f, 0x57, 0x32, 0x4b, 0xd5, 0xe5, 0x96, 0xe7,
+	0xb5, 0x1c, 0xda, 0x20, 0xbe, 0xdd, 0x20, 0xae, 0xeb, 0x45, 0x7c, 0x39, 0x8c, 0x5f, 0xad, 0x5e,
+	0xda, 0x7d, 0x34, 0xac, 0xdb, 0x1e, 0xfb, 0xb5, 0x43, 0xcc, 0xb6, 0xed, 0xd2, 0x60, 0xaf, 0xe1,
+	0xef, 0xb6, 0xd8, 0x42, 0xd8, 0xe8, 0xd0, 0x88, 0x34, 0x7a, 0x17, 0x1b, 0x2d, 0xea, 0xd2, 0x80,
+	0x44, 0xd4, 0x12, 0x5f, 0x6d, 0xb5, 0xec, 0xa8, 0xdd, 0x7d, 0xa9, 0x6e, 0x7a, 0x9d, 0x06, 0x09,
+	0x5a, 0x9e, 0x1f, 0x78, 0x9f, 0xe7, 0x0f, 0x6b, 0xa6, 0xd5, 0xe8, 0x3d, 0x92, 0x32, 0xc8, 0xe2,
+	0xec, 0x5d, 0x24, 0x8e, 0xdf, 0x26, 0x83, 0xdc, 0xae, 0x8e, 0xe1, 0x16, 0x50, 0xdf, 0x13, 0x7a,
+	0xf3, 0x47, 0x3b, 0xf2, 0x82, 0xbd, 0xcc, 0xa3, 0x60, 0xf3, 0xd8, 0x18, 0x36, 0x82, 0x05, 0xed,
+	0x51, 0x37, 0x0a, 0xc5, 0x3f, 0xf1, 0xa7, 0xfa, 0xfb, 0x08, 0x0e, 0x5f, 0x4e, 0xa1, 0x7e, 0xaa,
+	0x4b, 0x83, 0x3d, 0x8c, 0x61, 0xce, 0x25, 0x1d, 0xaa, 0xa1, 0x15, 0x54, 0x5b, 0x34, 0xf8, 0x33,
+	0xd6, 0x60, 0x21, 0xa0, 0x3b, 0x01, 0x0d, 0xdb, 0x5a, 0x81, 0x2f, 0x4b, 0x12, 0x57, 0xa1, 0xcc,
+	0x04, 0x52, 0x33, 0x0a, 0xb5, 0xe2, 0x4a, 0xb1, 0xb6, 0x68, 0x24, 0x34, 0xae, 0xc1, 0xa1, 0x80,
 	0x86, 0x5e, 0x37, 0x30, 0xe9, 0x0b, 0x34, 0x08, 0x6d, 0xcf, 0xd5, 0xe6, 0xf8, 0xd7, 0xfd, 0xcb,
 	0x8c, 0x4b, 0x48, 0x1d, 0x6a, 0x46, 0x5e, 0xa0, 0x95, 0xf8, 0x2b, 0x09, 0xcd, 0xf0, 0x30, 0x9d,
 	0xb5, 0xf9, 0x18, 0x0f, 0x7b, 0xc6, 0x3a, 0x1c, 0x20, 0xbe, 0x7f, 0x83, 0x74, 0x68, 0xe8, 0x13,
 	0x93, 0x6a, 0x0b, 0xfc, 0x37, 0x65, 0x8d, 0x61, 0x16, 0x48, 0xb4, 0x32, 0x07, 0x26, 0x49, 0x7d,
-	0x13, 0x16, 0x6f, 0x78, 0x16, 0x1d, 0xad, 0x6e, 0x3f, 0xfb, 0xc2, 0x20, 0x7b, 0xfd, 0x1d, 0x04,
+	0x03, 0x16, 0x6f, 0x78, 0x16, 0x1d, 0xad, 0x6e, 0x3f, 0xfb, 0xc2, 0x20, 0x7b, 0xfd, 0x5d, 0x04,
 	0xc7, 0x0c, 0xda, 0xb3, 0x19, 0xfe, 0xeb, 0x34, 0x22, 0x16, 0x89, 0x48, 0x3f, 0xc7, 0x42, 0xc2,
 	0xb1, 0x0a, 0xe5, 0x40, 0xbc, 0xac, 0x15, 0xf8, 0x7a, 0x42, 0x0f, 0x48, 0x2b, 0xe6, 0x2b, 0x13,
-	0x9b, 0x50, 0x92, 0x78, 0x05, 0x2a, 0xb1, 0x2d, 0xb7, 0x5c, 0x8b, 0x7e, 0x8e, 0x5b, 0xaf, 0x64,
-	0x64, 0x97, 0xf0, 0x32, 0x2c, 0xf6, 0x62, 0x3b, 0x6f, 0x59, 0xdc, 0x8a, 0x25, 0x23, 0x5d, 0xd0,
-	0xff, 0x86, 0xe0, 0x64, 0xc6, 0x07, 0x0c, 0xb1, 0x33, 0x57, 0xb9, 0x9f, 0x8c, 0x56, 0xe8, 0x3c,
+	0x9b, 0x50, 0x92, 0x78, 0x05, 0x2a, 0xb1, 0x2d, 0x37, 0x5d, 0x8b, 0x7e, 0x81, 0x5b, 0xaf, 0x64,
+	0x64, 0x97, 0xf0, 0x32, 0x2c, 0xf6, 0x62, 0x3b, 0x6f, 0x5a, 0xdc, 0x8a, 0x25, 0x23, 0x5d, 0xd0,
+	0xff, 0x8e, 0xe0, 0x64, 0xc6, 0x07, 0x0c, 0xb1, 0x33, 0x57, 0xb9, 0x9f, 0x8c, 0x56, 0xe8, 0x3c,
 	0x1c, 0x91, 0x9b, 0xd8, 0x6f, 0xa7, 0xc1, 0x1f, 0x98, 0x8a, 0xd9, 0x45, 0xa9, 0x62, 0x76, 0x8d,
-	0x29, 0x22, 0xe9, 0xe7, 0xb7, 0xae, 0x08, 0x35, 0xb3, 0x4b, 0x03, 0x86, 0x2a, 0xe5, 0x1b, 0x6a,
-	0x5e, 0x31, 0x94, 0xfe, 0x77, 0x04, 0x5a, 0x46, 0xd1, 0xeb, 0xc4, 0xb5, 0x9b, 0x34, 0x8c, 0x26,
-	0xdd, 0x33, 0x34, 0xc3, 0x3d, 0x5b, 0x85, 0x43, 0xb1, 0x56, 0x37, 0x59, 0x28, 0xb3, 0xb4, 0xa4,
-	0x95, 0x56, 0x8a, 0xab, 0x45, 0xa3, 0x7f, 0x99, 0xed, 0x9d, 0x94, 0x19, 0x6a, 0xf3, 0xdc, 0x8d,
-	0xd3, 0x05, 0x26, 0xc1, 0xf5, 0x36, 0x89, 0xd9, 0x8e, 0x23, 0xa0, 0x6c, 0x48, 0x52, 0x7f, 0x10,
-	0x16, 0x9f, 0xb1, 0x1d, 0xba, 0xd9, 0xee, 0xba, 0xbb, 0xf8, 0x28, 0x94, 0x4c, 0xf6, 0xc0, 0xb5,
-	0x3b, 0x60, 0xc4, 0x84, 0xfe, 0x35, 0x04, 0x0f, 0x8e, 0xb2, 0xc7, 0x6d, 0x3b, 0x6a, 0xb3, 0xef,
-	0xc3, 0x51, 0x86, 0x31, 0xdb, 0xd4, 0xdc, 0x0d, 0xbb, 0x1d, 0xe9, 0xcc, 0x92, 0x9e, 0xce, 0x30,
-	0xfa, 0x8f, 0x10, 0xac, 0x8e, 0xc5, 0x74, 0x3b, 0x20, 0xbe, 0x4f, 0x03, 0xfc, 0x0c, 0x94, 0xee,
-	0xb0, 0x1f, 0x78, 0xe8, 0x56, 0x1a, 0xb5, 0x5a, 0xf6, 0x44, 0x18, 0xcb, 0xe5, 0xd9, 0xff, 0x33,
-	0xe2, 0xcf, 0x71, 0x4d, 0x9a, 0xa7, 0xc0, 0xf9, 0x1c, 0x57, 0xf8, 0x24, 0x56, 0x64, 0xef, 0xf3,
-	0xd7, 0x9e, 0x9e, 0x87, 0x39, 0x9f, 0x04, 0x91, 0x7e, 0x0c, 0xee, 0x53, 0x03, 0xc7, 0xf7, 0xdc,
-	0x90, 0xea, 0xbf, 0x54, 0xfd, 0x6c, 0x33, 0xa0, 0x24, 0xa2, 0x06, 0xbd, 0xd3, 0xa5, 0x61, 0x84,
-	0x77, 0x21, 0x7b, 0x48, 0x71, 0xab, 0x56, 0x1a, 0x5b, 0xb5, 0x34, 0x85, 0xd7, 0x64, 0x0a, 0xe7,
-	0x0f, 0x9f, 0x36, 0xad, 0x5a, 0xef, 0x91, 0x9a, 0xbf, 0xdb, 0xaa, 0xb1, 0x73, 0x45, 0x41, 0x26,
-	0xcf, 0x95, 0xac, 0xaa, 0x46, 0x96, 0x3b, 0x3e, 0x0e, 0xf3, 0x5d, 0x3f, 0xa4, 0x41, 0xc4, 0x35,
-	0x2b, 0x1b, 0x82, 0x62, 0xfb, 0xd7, 0x23, 0x8e, 0x6d, 0x91, 0x28, 0xde, 0x9f, 0xb2, 0x91, 0xd0,
-	0xfa, 0xaf, 0x54, 0xf4, 0xcf, 0xfb, 0xd6, 0xfb, 0x85, 0x3e, 0x8b, 0xb2, 0xa0, 0xa2, 0xcc, 0x7a,
-	0x50, 0x51, 0xf5, 0xa0, 0x9f, 0xa9, 0xf8, 0xaf, 0x50, 0x87, 0xa6, 0xf8, 0x87, 0x39, 0xb3, 0x06,
-	0x0b, 0x26, 0x09, 0x4d, 0x62, 0x49, 0x29, 0x92, 0x64, 0x29, 0xce, 0x0f, 0x3c, 0x9f, 0xb4, 0x38,
-	0xa7, 0x9b, 0x9e, 0x63, 0x9b, 0x7b, 0x42, 0xdc, 0xe0, 0x0f, 0x03, 0x8e, 0x3f, 0x97, 0xef, 0xf8,
-	0x25, 0x15, 0xf6, 0x29, 0xa8, 0xec, 0xec, 0xb9, 0xe6, 0x73, 0x7e, 0x1c, 0xf6, 0x47, 0xa1, 0x64,
-	0x47, 0xb4, 0x13, 0x6a, 0x88, 0x87, 0x7c, 0x4c, 0xe8, 0xff, 0x2c, 0xc1, 0xf1, 0x8c, 0x6e, 0xec,
-	0x83, 0x3c, 0xcd, 0xf2, 0xf2, 0xd7, 0x71, 0x98, 0xb7, 0x82, 0x3d, 0xa3, 0xeb, 0x0a, 0x07, 0x10,
-	0x14, 0x13, 0xec, 0x07, 0x5d, 0x37, 0x86, 0x5f, 0x36, 0x62, 0x02, 0x37, 0xa1, 0x1c, 0x46, 0xac,
-	0x74, 0x69, 0xed, 0x71, 0xe0, 0x95, 0xc6, 0xc7, 0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34,
-	0x12, 0xde, 0xf8, 0x0e, 0xcb, 0x76, 0x71, 0x0a, 0x0c, 0xb5, 0x85, 0x95, 0xe2, 0x6a, 0xa5, 0xb1,
-	0x33, 0xbd, 0xa0, 0xe7, 0x7c, 0x56, 0x76, 0x65, 0xce, 0x36, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47,
-	0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9, 0x02, 0xfe, 0x24, 0x94, 0x6c, 0xb7, 0xe9, 0x85, 0xda, 0x22,
-	0x07, 0xf3, 0xf4, 0x74, 0x60, 0xb6, 0xdc, 0xa6, 0x67, 0xc4, 0x0c, 0xf1, 0x1d, 0x58, 0x0a, 0x68,
-	0x14, 0xec, 0x49, 0x2b, 0x68, 0xc0, 0xed, 0xfa, 0xf1, 0xe9, 0x24, 0x18, 0x59, 0x96, 0x86, 0x2a,
-	0x01, 0x6f, 0x40, 0x25, 0x4c, 0x7d, 0x4c, 0xab, 0x70, 0x81, 0x9a, 0xc2, 0x28, 0xe3, 0x83, 0x46,
-	0xf6, 0xe5, 0x01, 0xef, 0x3e, 0x90, 0xef, 0xdd, 0x4b, 0x63, 0xcf, 0xbb, 0x83, 0x13, 0x9c, 0x77,
-	0x87, 0xfa, 0xce, 0x3b, 0xfd, 0x5d, 0x04, 0xcb, 0x03, 0xc9, 0x69, 0xc7, 0xa7, 0xb9, 0x61, 0x40,
-	0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f, 0xaa, 0x4a, 0xe3, 0xfa, 0xcc, 0xb2, 0x15, 0x97, 0xcb, 0x59,
-	0xe7, 0x25, 0xd4, 0x29, 0xf3, 0xc2, 0x77, 0x11, 0xfc, 0x7f, 0x46, 0xe6, 0x4d, 0x12, 0x99, 0xed,
-	0x3c, 0x65, 0x59, 0xfc, 0xb2, 0x77, 0xc4, 0xb9, 0x1c, 0x13, 0xcc, 0xaa, 0xfc, 0xe1, 0xd6, 0x9e,
-	0xcf, 0x00, 0xb2, 0x5f, 0xd2, 0x85, 0x29, 0xcb, 0xaa, 0x1f, 0x23, 0xa8, 0x66, 0x73, 0xb8, 0xe7,
-	0x38, 0x2f, 0x11, 0x73, 0x37, 0x0f, 0xe4, 0x41, 0x28, 0xd8, 0x16, 0x47, 0x58, 0x34, 0x0a, 0xb6,
-	0xb5, 0xcf, 0x64, 0xd4, 0x0f, 0x77, 0x3e, 0x1f, 0xee, 0x82, 0x0a, 0xf7, 0xbd, 0x3e, 0xb8, 0x32,
-	0x25, 0xe4, 0xc0, 0x5d, 0x86, 0x45, 0xb7, 0xaf, 0xc4, 0x4d, 0x17, 0x86, 0x94, 0xb6, 0x85, 0x81,
-	0xd2, 0x56, 0x83, 0x85, 0x5e, 0x72, 0x01, 0x62, 0x3f, 0x4b, 0x92, 0xa9, 0xd8, 0x0a, 0xbc, 0xae,
-	0x2f, 0x8c, 0x1e, 0x13, 0x0c, 0xc5, 0xae, 0xed, 0xb2, 0x62, 0x9d, 0xa3, 0x60, 0xcf, 0xfb, 0xbf,
-	0xf2, 0x28, 0x6a, 0xff, 0xa4, 0x00, 0x1f, 0x18, 0xa2, 0xf6, 0x58, 0x7f, 0xba, 0x37, 0x74, 0x4f,
-	0xbc, 0x7a, 0x61, 0xa4, 0x57, 0x97, 0xc7, 0x79, 0xf5, 0x62, 0xbe, 0xbd, 0x40, 0xb5, 0xd7, 0x0f,
-	0x0b, 0xb0, 0x32, 0xc4, 0x5e, 0xe3, 0xcb, 0x89, 0x7b, 0xc6, 0x60, 0x4d, 0x2f, 0x30, 0xe5, 0xb5,
-	0x20, 0x26, 0x58, 0x9c, 0x79, 0x81, 0xdf, 0x26, 0x2e, 0xf7, 0x8e, 0xb2, 0x21, 0xa8, 0x29, 0x4d,
-	0x75, 0x05, 0x34, 0x69, 0x9e, 0xcb, 0x66, 0x9c, 0xa4, 0x02, 0xd2, 0xa1, 0x11, 0x0d, 0xc2, 0x51,
-	0x29, 0xaa, 0x47, 0x9c, 0x2e, 0x95, 0x29, 0x8a, 0x13, 0xfa, 0xeb, 0x85, 0x7e, 0x36, 0x46, 0xd7,
-	0xbd, 0xf7, 0x0d, 0x7d, 0x1c, 0xe6, 0x09, 0x47, 0x2b, 0x5c, 0x53, 0x50, 0x03, 0x26, 0x2d, 0xe7,
-	0x9b, 0x74, 0x51, 0x31, 0xe9, 0x46, 0x41, 0x43, 0xfa, 0xbb, 0x05, 0xa8, 0x8e, 0x32, 0xc8, 0x0b,
-	0x8d, 0xff, 0x35, 0x93, 0x60, 0x02, 0x5a, 0x30, 0xc2, 0xcb, 0x34, 0xe0, 0xc5, 0xd9, 0x19, 0xe5,
-	0xc4, 0x1e, 0xe5, 0x92, 0xc6, 0x48, 0x36, 0xfa, 0x97, 0x10, 0x9c, 0x50, 0x3f, 0x0b, 0xb7, 0xed,
-	0x30, 0x92, 0x17, 0x3b, 0xdc, 0x84, 0x85, 0x58, 0x95, 0xb8, 0x2c, 0xaf, 0x34, 0xb6, 0xa7, 0x2d,
-	0xd6, 0x94, 0xdd, 0x95, 0xcc, 0xf5, 0xc7, 0xe0, 0xc4, 0xd0, 0x13, 0x4a, 0xc0, 0xa8, 0x42, 0x59,
-	0x16, 0xa8, 0x62, 0xf7, 0x13, 0x5a, 0x7f, 0x6b, 0x4e, 0x2d, 0x17, 0x3c, 0x6b, 0xdb, 0x6b, 0xe5,
-	0x74, 0x71, 0xf2, 0x3d, 0x86, 0xed, 0x86, 0x67, 0x65, 0x1a, 0x36, 0x92, 0x64, 0xdf, 0x99, 0x9e,
-	0x1b, 0x11, 0xdb, 0xa5, 0x81, 0xa8, 0x68, 0xd2, 0x05, 0xb6, 0xd3, 0xa1, 0xed, 0x9a, 0x74, 0x87,
-	0x9a, 0x9e, 0x6b, 0x85, 0xdc, 0x65, 0x8a, 0x86, 0xb2, 0x86, 0x9f, 0x85, 0x45, 0x4e, 0xdf, 0xb2,
-	0x3b, 0xf1, 0x11, 0x5e, 0x69, 0xac, 0xd5, 0xe2, 0xa6, 0x6c, 0x2d, 0xdb, 0x94, 0x4d, 0x6d, 0xd8,
-	0xa1, 0x11, 0xa9, 0xf5, 0x2e, 0xd6, 0xd8, 0x17, 0x46, 0xfa, 0x31, 0xc3, 0x12, 0x11, 0xdb, 0xd9,
-	0xb6, 0x5d, 0x7e, 0x69, 0x60, 0xa2, 0xd2, 0x05, 0xe6, 0x8d, 0x4d, 0xcf, 0x71, 0xbc, 0x97, 0x65,
-	0xce, 0x8b, 0x29, 0xf6, 0x55, 0xd7, 0x8d, 0x6c, 0x87, 0xcb, 0x8f, 0x7d, 0x2d, 0x5d, 0xe0, 0x5f,
-	0xd9, 0x4e, 0x44, 0x03, 0x91, 0xec, 0x04, 0x95, 0xf8, 0x7b, 0x25, 0x6e, 0x16, 0xca, 0x5c, 0x1b,
-	0x47, 0xc6, 0x81, 0x6c, 0x64, 0xf4, 0x47, 0xdb, 0xd2, 0x90, 0x8e, 0x17, 0xef, 0x9d, 0xd2, 0x9e,
-	0xed, 0x75, 0x59, 0x3d, 0xcc, 0xcb, 0x46, 0x49, 0x0f, 0x44, 0xcb, 0xa1, 0xfc, 0x68, 0x39, 0xac,
-	0x46, 0x0b, 0xbf, 0xd5, 0x44, 0x66, 0x7b, 0x93, 0x84, 0x54, 0x3b, 0xc2, 0x59, 0xa7, 0x0b, 0xfa,
-	0xaf, 0x11, 0x94, 0xb7, 0xbd, 0xd6, 0x55, 0x37, 0x0a, 0xf6, 0xf8, 0xfd, 0xd7, 0x73, 0x23, 0xea,
-	0x4a, 0x6f, 0x92, 0x24, 0xdb, 0xa2, 0xc8, 0xee, 0xd0, 0x9d, 0x88, 0x74, 0x7c, 0x51, 0x3d, 0xef,
-	0x6b, 0x8b, 0x92, 0x8f, 0x99, 0xd9, 0x1c, 0x12, 0x46, 0x3c, 0xe5, 0x94, 0x0d, 0xfe, 0xcc, 0x14,
-	0x4c, 0x5e, 0xd8, 0x89, 0x02, 0x91, 0x6f, 0x94, 0xb5, 0xac, 0x03, 0x96, 0x62, 0x6c, 0x82, 0xd4,
-	0x3b, 0x70, 0x7f, 0x72, 0xad, 0xbb, 0x45, 0x83, 0x8e, 0xed, 0x92, 0xfc, 0x73, 0x79, 0x82, 0x96,
-	0x6e, 0x4e, 0x57, 0xc1, 0x53, 0x42, 0x92, 0xdd, 0x92, 0x6e, 0xdb, 0xae, 0xe5, 0xbd, 0x9c, 0x13,
-	0x5a, 0xd3, 0x09, 0xfc, 0x93, 0xda, 0x95, 0xcd, 0x48, 0x4c, 0xf2, 0xc0, 0xb3, 0xb0, 0xc4, 0x32,
-	0x46, 0x8f, 0x8a, 0x1f, 0x44, 0x52, 0xd2, 0x47, 0xb5, 0xc1, 0x52, 0x1e, 0x86, 0xfa, 0x21, 0xde,
-	0x86, 0x43, 0x24, 0x0c, 0xed, 0x96, 0x4b, 0x2d, 0xc9, 0xab, 0x30, 0x31, 0xaf, 0xfe, 0x4f, 0xe3,
-	0x86, 0x0a, 0x7f, 0x43, 0xec, 0xb7, 0x24, 0xf5, 0x2f, 0x22, 0x38, 0x36, 0x94, 0x49, 0x12, 0x57,
-	0x28, 0x73, 0x8e, 0x54, 0xa1, 0x1c, 0x9a, 0x6d, 0x6a, 0x75, 0x1d, 0x59, 0x2a, 0x24, 0x34, 0xfb,
-	0xcd, 0xea, 0xc6, 0xbb, 0x2f, 0xce, 0xb1, 0x84, 0xc6, 0x27, 0x01, 0x3a, 0xc4, 0xed, 0x12, 0x87,
-	0x43, 0x98, 0xe3, 0x10, 0x32, 0x2b, 0xfa, 0x32, 0x54, 0x87, 0xb9, 0x8e, 0xe8, 0xde, 0xfd, 0x03,
-	0xc1, 0x41, 0x99, 0x72, 0xc5, 0xee, 0xae, 0xc2, 0xa1, 0x8c, 0x19, 0x6e, 0xa4, 0x1b, 0xdd, 0xbf,
-	0x3c, 0x26, 0x9d, 0x4a, 0x2f, 0x29, 0xaa, 0x83, 0x95, 0x9e, 0x32, 0x1a, 0x99, 0xf8, 0xc0, 0x45,
-	0x33, 0xba, 0x19, 0x7c, 0x1e, 0xb4, 0xeb, 0xc4, 0x25, 0x2d, 0x6a, 0x25, 0x6a, 0x27, 0x2e, 0xf6,
-	0x99, 0x6c, 0x1b, 0x6a, 0xea, 0xa6, 0x4f, 0x52, 0x44, 0xdb, 0xcd, 0xa6, 0x6c, 0x69, 0xbd, 0x51,
-	0x50, 0xfd, 0x9c, 0xcf, 0xaa, 0x76, 0x6c, 0x8b, 0xbf, 0x14, 0x9b, 0x5f, 0x83, 0x05, 0xa1, 0x8a,
-	0x4c, 0x50, 0x82, 0x9c, 0x2e, 0xc4, 0xb0, 0x0f, 0x4b, 0x8e, 0xdd, 0xa3, 0x89, 0xd6, 0xda, 0xdc,
-	0xcc, 0x95, 0x54, 0x05, 0x30, 0x47, 0x8a, 0x48, 0xd0, 0xa2, 0xd1, 0xf5, 0xa4, 0xe3, 0x54, 0xe2,
-	0x2d, 0x8e, 0xfe, 0x65, 0xfd, 0xfb, 0x6a, 0x6f, 0x5e, 0x35, 0xcb, 0x7f, 0x6f, 0x7b, 0x78, 0xad,
-	0xe1, 0x59, 0x76, 0xd3, 0xa6, 0xf1, 0x7d, 0xbd, 0x6c, 0x24, 0xb4, 0x1e, 0x40, 0x79, 0xdb, 0x76,
-	0x77, 0xb7, 0xdc, 0xa6, 0xc7, 0x9c, 0x35, 0xb2, 0x23, 0x47, 0xee, 0x50, 0x4c, 0xe0, 0xc3, 0x50,
-	0xec, 0x06, 0x8e, 0x08, 0x5e, 0xf6, 0x88, 0x57, 0xa0, 0x62, 0xd1, 0xd0, 0x0c, 0x6c, 0x5f, 0x84,
-	0x2e, 0x9f, 0xf1, 0x64, 0x96, 0x58, 0x08, 0xd9, 0xa6, 0xe7, 0x6e, 0x3a, 0x24, 0x0c, 0x65, 0x65,
-	0x91, 0x2c, 0xe8, 0x4f, 0xc0, 0x12, 0x93, 0x99, 0x7a, 0xe8, 0x39, 0xd5, 0x04, 0xc7, 0x14, 0xd5,
-	0x24, 0x3c, 0xe9, 0x6c, 0x04, 0xee, 0x63, 0x05, 0xdd, 0x65, 0xdf, 0x17, 0x4c, 0x26, 0xbc, 0x5d,
-	0x14, 0x87, 0x15, 0x46, 0x43, 0x07, 0x18, 0x8d, 0xbb, 0x67, 0x01, 0xf7, 0x6d, 0x9c, 0x6d, 0x52,
-	0xfc, 0x75, 0x04, 0x73, 0x4c, 0x34, 0x7e, 0x60, 0x54, 0x46, 0xe5, 0xbe, 0x5e, 0x9d, 0x5d, 0x77,
-	0x8a, 0x49, 0xd3, 0x97, 0x5f, 0xfb, 0xf3, 0x5f, 0xbf, 0x51, 0x38, 0x8e, 0x8f, 0xf2, 0x29, 0x78,
-	0xef, 0x62, 0x76, 0x2e, 0x1d, 0xe2, 0x2f, 0x20, 0xc0, 0xa2, 0xc0, 0xcd, 0x8c, 0xfc, 0xf0, 0xb9,
-	0x51, 0x10, 0x87, 0x8c, 0x06, 0xab, 0x47, 0x6a, 0x62, 0xa0, 0xcc, 0x17, 0xb9, 0xd0, 0x35, 0x2e,
-	0xf4, 0x34, 0xd6, 0x87, 0x09, 0xad, 0xbf, 0xc2, 0xac, 0xf8, 0xaa, 0x18, 0x43, 0xe3, 0x37, 0x11,
-	0x94, 0x6e, 0xf3, 0xcb, 0xfc, 0x18, 0xc3, 0xec, 0xcc, 0xcc, 0x30, 0x5c, 0x1c, 0x47, 0xab, 0x9f,
-	0xe2, 0x48, 0x1f, 0xc0, 0x27, 0x24, 0xd2, 0x30, 0x0a, 0x28, 0xe9, 0x28, 0x80, 0x2f, 0x20, 0xfc,
-	0x36, 0x82, 0xf9, 0x78, 0x8a, 0x83, 0xcf, 0x8c, 0x42, 0xa9, 0x4c, 0x79, 0xaa, 0xb3, 0x1b, 0x89,
-	0xe8, 0x0f, 0x73, 0x8c, 0xa7, 0xf4, 0xa1, 0x5b, 0xb8, 0xa1, 0x0c, 0x4c, 0xde, 0x40, 0x50, 0xbc,
-	0x46, 0xc7, 0xfa, 0xd8, 0x0c, 0xc1, 0x0d, 0x18, 0x70, 0xc8, 0x56, 0xe3, 0xb7, 0x10, 0xdc, 0x7f,
-	0x8d, 0x46, 0xc3, 0xab, 0x19, 0xbc, 0x3a, 0xbe, 0xc4, 0x10, 0xae, 0x76, 0x6e, 0x82, 0x37, 0x93,
-	0x63, 0xbc, 0xce, 0x91, 0x3d, 0x8c, 0xcf, 0xe6, 0x39, 0x61, 0xb8, 0xe7, 0x9a, 0x2f, 0x0b, 0x1c,
-	0xbf, 0x47, 0x70, 0xb8, 0x7f, 0x9c, 0x8f, 0xf5, 0xbe, 0x2b, 0xe5, 0x90, 0x69, 0x7f, 0xf5, 0xc6,
-	0xb4, 0x59, 0x57, 0x65, 0xaa, 0x5f, 0xe6, 0xc8, 0x1f, 0xc7, 0x8f, 0xe5, 0x21, 0x4f, 0x5a, 0xe2,
-	0xf5, 0x57, 0xe4, 0xe3, 0xab, 0xfc, 0xaf, 0x56, 0x38, 0xec, 0x3f, 0x20, 0x38, 0x2a, 0xf9, 0x6e,
-	0xb6, 0x49, 0x10, 0x5d, 0xa1, 0xec, 0x42, 0x14, 0x4e, 0xa4, 0xcf, 0x94, 0xa7, 0x48, 0x56, 0x9e,
-	0x7e, 0x95, 0xeb, 0xf2, 0x14, 0x7e, 0x72, 0xdf, 0xba, 0x98, 0x8c, 0x8d, 0x25, 0x60, 0xbf, 0x83,
-	0xe0, 0xe0, 0x35, 0x1a, 0x3d, 0xb7, 0xb9, 0xb5, 0xaf, 0x9d, 0x99, 0xd2, 0xd1, 0x33, 0xe2, 0xf4,
-	0x2b, 0x5c, 0x91, 0x8f, 0xe0, 0x27, 0xf6, 0xad, 0x88, 0x67, 0xda, 0xc9, 0xbe, 0xbc, 0x86, 0xe0,
-	0xc0, 0xb5, 0xcc, 0x31, 0x3f, 0x3a, 0x9d, 0x28, 0x23, 0xeb, 0xea, 0x72, 0x2d, 0xf3, 0x47, 0x3f,
-	0xf2, 0xa7, 0xc4, 0xd5, 0xd7, 0x39, 0xb6, 0xb3, 0xf8, 0x4c, 0x1e, 0xb6, 0x74, 0xa4, 0xf5, 0x26,
-	0x82, 0x63, 0x59, 0x10, 0xe9, 0xa8, 0xff, 0x83, 0xfb, 0x1b, 0xa0, 0x8b, 0x31, 0xfc, 0x18, 0x74,
-	0x0d, 0x8e, 0xee, 0xbc, 0x3e, 0x3c, 0x10, 0x3b, 0x03, 0x28, 0x36, 0xd0, 0xda, 0x2a, 0xc2, 0xbf,
-	0x41, 0x30, 0x1f, 0x4f, 0x77, 0x46, 0xdb, 0x48, 0x19, 0x4d, 0xcf, 0x32, 0xab, 0x09, 0xaf, 0xad,
-	0x5e, 0x18, 0x6e, 0xd0, 0xec, 0xf7, 0x72, 0x6b, 0x6b, 0xdc, 0xca, 0x6a, 0x3a, 0xfe, 0x39, 0x02,
-	0x48, 0x27, 0x54, 0xf8, 0xe1, 0x7c, 0x3d, 0x32, 0x53, 0xac, 0xea, 0x6c, 0x67, 0x54, 0x7a, 0x8d,
-	0xeb, 0xb3, 0x5a, 0x5d, 0xc9, 0xcd, 0x85, 0x3e, 0x35, 0x37, 0xe2, 0x69, 0xd6, 0xf7, 0x10, 0x94,
-	0xf8, 0x60, 0x00, 0x9f, 0x1e, 0x85, 0x39, 0x3b, 0x37, 0x98, 0xa5, 0xe9, 0x1f, 0xe2, 0x50, 0x57,
-	0x1a, 0x79, 0x07, 0xca, 0x06, 0x5a, 0xc3, 0x3d, 0x98, 0x8f, 0x5b, 0xf1, 0xa3, 0xdd, 0x43, 0x69,
-	0xd5, 0x57, 0x57, 0x72, 0x8a, 0x9a, 0xd8, 0x51, 0xc5, 0x59, 0xb6, 0x36, 0xee, 0x2c, 0x9b, 0x63,
-	0xc7, 0x0d, 0x3e, 0x95, 0x77, 0x18, 0xfd, 0x07, 0x0c, 0x73, 0x8e, 0xa3, 0x3b, 0xa3, 0xaf, 0x8c,
-	0x3b, 0xcf, 0x98, 0x75, 0xbe, 0x89, 0xe0, 0x70, 0xff, 0x9d, 0x0e, 0x9f, 0x18, 0xda, 0x1e, 0x15,
-	0x67, 0xab, 0x6a, 0xc5, 0x51, 0xf7, 0x41, 0xfd, 0xa3, 0x1c, 0xc5, 0x06, 0x7e, 0x74, 0x6c, 0x64,
-	0xdc, 0x90, 0x59, 0x87, 0x31, 0x5a, 0x4f, 0xc7, 0xed, 0x3f, 0x40, 0x70, 0x50, 0xbd, 0xcd, 0x8c,
-	0xae, 0x37, 0x87, 0x5c, 0x06, 0xab, 0xb5, 0xc9, 0x5e, 0x4e, 0x10, 0x7f, 0x98, 0x23, 0xbe, 0x88,
-	0xeb, 0x23, 0x11, 0xc7, 0x48, 0xe3, 0x3f, 0x92, 0x5c, 0x0f, 0x6d, 0x8b, 0xae, 0x5b, 0x0c, 0xd5,
-	0x2f, 0x10, 0x1c, 0x90, 0x06, 0xb8, 0x15, 0x50, 0x9a, 0x6f, 0xbf, 0xd9, 0x45, 0x2c, 0x93, 0xa5,
-	0x3f, 0xc1, 0x51, 0x7f, 0x08, 0x5f, 0x9a, 0xd0, 0xce, 0xd2, 0xbe, 0xeb, 0x11, 0x43, 0xfa, 0x5b,
-	0x04, 0x47, 0x6e, 0xc7, 0x01, 0xfa, 0x3e, 0xe1, 0xdf, 0xe4, 0xf8, 0x9f, 0xc4, 0x8f, 0xe7, 0x14,
-	0xd6, 0xe3, 0xd4, 0xb8, 0x80, 0xf0, 0x4f, 0x11, 0x94, 0xe5, 0x3c, 0x19, 0x9f, 0x1d, 0x19, 0xc1,
-	0xea, 0xc4, 0x79, 0x96, 0x51, 0x27, 0xaa, 0x48, 0xfd, 0x74, 0xee, 0xb1, 0x2f, 0xe4, 0xb3, 0xc8,
-	0x7b, 0x03, 0x01, 0x4e, 0x7a, 0x4a, 0x49, 0x97, 0x09, 0x3f, 0xa4, 0x88, 0x1a, 0xd9, 0xb8, 0xac,
-	0x9e, 0x1d, 0xfb, 0x9e, 0x7a, 0xe6, 0xaf, 0xe5, 0x9e, 0xf9, 0x5e, 0x22, 0xff, 0x75, 0x04, 0x95,
-	0x6b, 0x34, 0xb9, 0xe8, 0xe5, 0xd8, 0x52, 0x1d, 0x87, 0x57, 0x57, 0xc7, 0xbf, 0x28, 0x10, 0x9d,
-	0xe7, 0x88, 0x1e, 0xc2, 0xf9, 0xa6, 0x92, 0x00, 0xbe, 0x85, 0x60, 0xe9, 0x66, 0xd6, 0x45, 0xf1,
-	0xf9, 0x71, 0x92, 0x94, 0x23, 0x67, 0x72, 0x5c, 0x8f, 0x70, 0x5c, 0xeb, 0xfa, 0x44, 0xb8, 0x36,
-	0xc4, 0x64, 0xf9, 0x3b, 0x28, 0xee, 0x14, 0xf4, 0x4d, 0x83, 0xfe, 0x5d, 0xbb, 0xe5, 0x0c, 0x95,
-	0xf4, 0x4b, 0x1c, 0x5f, 0x0d, 0x9f, 0x9f, 0x04, 0x5f, 0x5d, 0x8c, 0x88, 0xf0, 0xb7, 0x11, 0x1c,
-	0xe1, 0xe3, 0xc0, 0x2c, 0x63, 0x9c, 0x37, 0x01, 0x4b, 0x87, 0x87, 0x13, 0x9c, 0x85, 0x4f, 0xc5,
-	0xf9, 0x47, 0xdf, 0x17, 0xa8, 0x0d, 0x31, 0xe8, 0xfb, 0x72, 0x01, 0xb1, 0xfd, 0xbd, 0x6f, 0x00,
-	0xdf, 0x0b, 0x8d, 0x3e, 0x03, 0x8e, 0x1e, 0x6f, 0x4e, 0x80, 0x71, 0x83, 0x63, 0xbc, 0xa4, 0xd7,
-	0xf7, 0x83, 0xb1, 0xde, 0x6b, 0xb0, 0x30, 0xfd, 0x2a, 0x82, 0x83, 0xb2, 0x3e, 0x10, 0xfe, 0xb7,
-	0x3e, 0x6e, 0x6b, 0xf7, 0x5b, 0x4f, 0x88, 0x80, 0x58, 0x9b, 0x2c, 0x20, 0xde, 0x46, 0xb0, 0x20,
-	0xa6, 0x75, 0x39, 0x55, 0x57, 0x66, 0x9c, 0x57, 0xed, 0x6b, 0x75, 0x89, 0x71, 0x8e, 0xfe, 0x29,
-	0x2e, 0xf6, 0x79, 0x9c, 0x6b, 0x16, 0xdf, 0xb3, 0xc2, 0xfa, 0x2b, 0x62, 0x96, 0xf2, 0x6a, 0xdd,
-	0xf1, 0x5a, 0xe1, 0x8b, 0x3a, 0xce, 0xad, 0x2d, 0xd8, 0x3b, 0x17, 0x10, 0x8e, 0x60, 0x91, 0xb9,
-	0x2f, 0xef, 0x9f, 0xe1, 0x95, 0xbe, 0x6e, 0xdb, 0x40, 0x6b, 0xad, 0x5a, 0x1d, 0xe8, 0xc7, 0xa5,
-	0xc5, 0x84, 0xe8, 0x6c, 0xe0, 0x07, 0x73, 0xc5, 0x72, 0x41, 0x5f, 0x41, 0x70, 0x24, 0x1b, 0x8f,
-	0xb1, 0xf8, 0x89, 0xa3, 0x31, 0x0f, 0x85, 0xb8, 0x9f, 0xe0, 0xb5, 0x89, 0xdc, 0x88, 0xc3, 0x79,
-	0xfa, 0x99, 0xdf, 0xdd, 0x3d, 0x89, 0xfe, 0x78, 0xf7, 0x24, 0xfa, 0xcb, 0xdd, 0x93, 0xe8, 0xc5,
-	0x47, 0x27, 0xfb, 0x4f, 0x21, 0xa6, 0x63, 0x53, 0x37, 0xca, 0xb2, 0xff, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x64, 0xec, 0x77, 0x47, 0xd6, 0x32, 0x00, 0x00,
+	0x29, 0x22, 0xe9, 0xe7, 0x37, 0xaf, 0x08, 0x35, 0xb3, 0x4b, 0x03, 0x86, 0x2a, 0xe5, 0x1b, 0x6a,
+	0x5e, 0x31, 0x94, 0xfe, 0x0f, 0x04, 0x5a, 0x46, 0xd1, 0xeb, 0xc4, 0xb5, 0x77, 0x68, 0x18, 0x4d,
+	0xba, 0x67, 0x68, 0x86, 0x7b, 0x56, 0x83, 0x43, 0xb1, 0x56, 0x37, 0x59, 0x28, 0xb3, 0xb4, 0xa4,
+	0x95, 0x56, 0x8a, 0xb5, 0xa2, 0xd1, 0xbf, 0xcc, 0xf6, 0x4e, 0xca, 0x0c, 0xb5, 0x79, 0xee, 0xc6,
+	0xe9, 0x02, 0x93, 0xe0, 0x7a, 0x1b, 0xc4, 0x6c, 0xc7, 0x11, 0x50, 0x36, 0x24, 0xa9, 0x3f, 0x08,
+	0x8b, 0xcf, 0xd8, 0x0e, 0xdd, 0x68, 0x77, 0xdd, 0x5d, 0x7c, 0x14, 0x4a, 0x26, 0x7b, 0xe0, 0xda,
+	0x1d, 0x30, 0x62, 0x42, 0xff, 0x06, 0x82, 0x07, 0x47, 0xd9, 0xe3, 0xb6, 0x1d, 0xb5, 0xd9, 0xf7,
+	0xe1, 0x28, 0xc3, 0x98, 0x6d, 0x6a, 0xee, 0x86, 0xdd, 0x8e, 0x74, 0x66, 0x49, 0x4f, 0x67, 0x18,
+	0xfd, 0x1d, 0x04, 0xb5, 0xb1, 0x98, 0x6e, 0x07, 0xc4, 0xf7, 0x69, 0x80, 0x9f, 0x81, 0xd2, 0x1d,
+	0xf6, 0x03, 0x0f, 0xdd, 0x4a, 0xb3, 0x5e, 0xcf, 0x9e, 0x08, 0x63, 0xb9, 0x3c, 0xfb, 0x7f, 0x46,
+	0xfc, 0x39, 0xae, 0x4b, 0xf3, 0x14, 0x38, 0x9f, 0xe3, 0x0a, 0x9f, 0xc4, 0x8a, 0xec, 0x7d, 0xfe,
+	0xda, 0xd3, 0xf3, 0x30, 0xe7, 0x93, 0x20, 0xd2, 0x8f, 0xc1, 0x7d, 0x6a, 0xe0, 0xf8, 0x9e, 0x1b,
+	0x52, 0xfd, 0x57, 0xaa, 0x9f, 0x6d, 0x04, 0x94, 0x44, 0xd4, 0xa0, 0x77, 0xba, 0x34, 0x8c, 0xf0,
+	0x2e, 0x64, 0x0f, 0x29, 0x6e, 0xd5, 0x4a, 0x73, 0xb3, 0x9e, 0xa6, 0xf0, 0xba, 0x4c, 0xe1, 0xfc,
+	0xe1, 0xb3, 0xa6, 0x55, 0xef, 0x3d, 0x52, 0xf7, 0x77, 0x5b, 0x75, 0x76, 0xae, 0x28, 0xc8, 0xe4,
+	0xb9, 0x92, 0x55, 0xd5, 0xc8, 0x72, 0xc7, 0xc7, 0x61, 0xbe, 0xeb, 0x87, 0x34, 0x88, 0xb8, 0x66,
+	0x65, 0x43, 0x50, 0x6c, 0xff, 0x7a, 0xc4, 0xb1, 0x2d, 0x12, 0xc5, 0xfb, 0x53, 0x36, 0x12, 0x5a,
+	0xff, 0xb5, 0x8a, 0xfe, 0x79, 0xdf, 0xfa, 0xa0, 0xd0, 0x67, 0x51, 0x16, 0x54, 0x94, 0x59, 0x0f,
+	0x2a, 0xaa, 0x1e, 0xf4, 0x73, 0x15, 0xff, 0x15, 0xea, 0xd0, 0x14, 0xff, 0x30, 0x67, 0xd6, 0x60,
+	0xc1, 0x24, 0xa1, 0x49, 0x2c, 0x29, 0x45, 0x92, 0x2c, 0xc5, 0xf9, 0x81, 0xe7, 0x93, 0x16, 0xe7,
+	0x74, 0xd3, 0x73, 0x6c, 0x73, 0x4f, 0x88, 0x1b, 0xfc, 0x61, 0xc0, 0xf1, 0xe7, 0xf2, 0x1d, 0xbf,
+	0xa4, 0xc2, 0x3e, 0x05, 0x95, 0xed, 0x3d, 0xd7, 0x7c, 0xce, 0x8f, 0xc3, 0xfe, 0x28, 0x94, 0xec,
+	0x88, 0x76, 0x42, 0x0d, 0xf1, 0x90, 0x8f, 0x09, 0xfd, 0x9d, 0x79, 0x38, 0x9e, 0xd1, 0x8d, 0x7d,
+	0x90, 0xa7, 0x59, 0x5e, 0xfe, 0x3a, 0x0e, 0xf3, 0x56, 0xb0, 0x67, 0x74, 0x5d, 0xe1, 0x00, 0x82,
+	0x62, 0x82, 0xfd, 0xa0, 0xeb, 0xc6, 0xf0, 0xcb, 0x46, 0x4c, 0xe0, 0x1d, 0x28, 0x87, 0x11, 0x2b,
+	0x5d, 0x5a, 0x7b, 0x1c, 0x78, 0xa5, 0xf9, 0x89, 0xe9, 0x36, 0x9d, 0x41, 0xdf, 0x16, 0x1c, 0x8d,
+	0x84, 0x37, 0xbe, 0xc3, 0xb2, 0x5d, 0x9c, 0x02, 0x43, 0x6d, 0x61, 0xa5, 0x58, 0xab, 0x34, 0xb7,
+	0xa7, 0x17, 0xf4, 0x9c, 0x4f, 0x83, 0xd8, 0xbf, 0x04, 0x6f, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47,
+	0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9, 0x02, 0xfe, 0x34, 0x94, 0x6c, 0x77, 0xc7, 0x0b, 0xb5, 0x45,
+	0x0e, 0xe6, 0xe9, 0xe9, 0xc0, 0x6c, 0xba, 0x3b, 0x9e, 0x11, 0x33, 0xc4, 0x77, 0x60, 0x29, 0xa0,
+	0x51, 0xb0, 0x27, 0xad, 0xa0, 0x01, 0xb7, 0xeb, 0x27, 0xa7, 0x93, 0x60, 0x64, 0x59, 0x1a, 0xaa,
+	0x04, 0xbc, 0x0e, 0x95, 0x30, 0xf5, 0x31, 0xad, 0xc2, 0x05, 0x6a, 0x0a, 0xa3, 0x8c, 0x0f, 0x1a,
+	0xd9, 0x97, 0x07, 0xbc, 0xfb, 0x40, 0xbe, 0x77, 0x2f, 0x8d, 0x3d, 0xef, 0x0e, 0x4e, 0x70, 0xde,
+	0x1d, 0xea, 0x3f, 0xef, 0x30, 0xcc, 0xf9, 0x0e, 0x71, 0xb5, 0xc3, 0xdc, 0x39, 0xf9, 0x33, 0xf3,
+	0x64, 0xf6, 0xef, 0xe6, 0x15, 0xed, 0x08, 0x17, 0x2a, 0x28, 0xfd, 0x3d, 0x04, 0xcb, 0x03, 0x89,
+	0x6c, 0xdb, 0xa7, 0xb9, 0x21, 0x43, 0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f, 0xb5, 0x4a, 0xf3, 0xfa,
+	0xcc, 0x32, 0x1b, 0x97, 0xcb, 0x59, 0xe7, 0x25, 0xdf, 0x29, 0x73, 0xc8, 0xf7, 0x11, 0xfc, 0x7f,
+	0x46, 0xe6, 0x4d, 0x12, 0x99, 0xed, 0x3c, 0x65, 0x59, 0xac, 0xb3, 0x77, 0xc4, 0x19, 0x1e, 0x13,
+	0x6c, 0x07, 0xf8, 0xc3, 0xad, 0x3d, 0x9f, 0x01, 0x64, 0xbf, 0xa4, 0x0b, 0x53, 0x96, 0x60, 0x3f,
+	0x41, 0x50, 0xcd, 0xe6, 0x7b, 0xcf, 0x71, 0x5e, 0x22, 0xe6, 0x6e, 0x1e, 0xc8, 0x83, 0x50, 0xb0,
+	0x2d, 0x8e, 0xb0, 0x68, 0x14, 0x6c, 0x6b, 0x9f, 0x89, 0xab, 0x1f, 0xee, 0x7c, 0x3e, 0xdc, 0x05,
+	0x15, 0xee, 0xfb, 0x7d, 0x70, 0x65, 0xfa, 0xc8, 0x81, 0xbb, 0x0c, 0x8b, 0x6e, 0x5f, 0x39, 0x9c,
+	0x2e, 0x0c, 0x29, 0x83, 0x0b, 0x03, 0x65, 0xb0, 0x06, 0x0b, 0xbd, 0xe4, 0xb2, 0xc4, 0x7e, 0x96,
+	0x24, 0x53, 0xb1, 0x15, 0x78, 0x5d, 0x5f, 0x18, 0x3d, 0x26, 0x18, 0x8a, 0x5d, 0xdb, 0x65, 0x85,
+	0x3d, 0x47, 0xc1, 0x9e, 0xf7, 0x7f, 0x3d, 0x52, 0xd4, 0xfe, 0x69, 0x01, 0x3e, 0x34, 0x44, 0xed,
+	0xb1, 0xfe, 0x74, 0x6f, 0xe8, 0x9e, 0x78, 0xf5, 0xc2, 0x48, 0xaf, 0x2e, 0x8f, 0xf3, 0xea, 0xc5,
+	0x7c, 0x7b, 0x81, 0x6a, 0xaf, 0x1f, 0x17, 0x60, 0x65, 0x88, 0xbd, 0xc6, 0x97, 0x1e, 0xf7, 0x8c,
+	0xc1, 0x76, 0xbc, 0xc0, 0x94, 0x57, 0x88, 0x98, 0x60, 0x71, 0xe6, 0x05, 0x7e, 0x9b, 0xb8, 0xdc,
+	0x3b, 0xca, 0x86, 0xa0, 0xa6, 0x34, 0xd5, 0x15, 0xd0, 0xa4, 0x79, 0x2e, 0x9b, 0x71, 0x92, 0x0a,
+	0x48, 0x87, 0x46, 0x34, 0x08, 0x47, 0xa5, 0xa8, 0x1e, 0x71, 0xba, 0x54, 0xa6, 0x28, 0x4e, 0xe8,
+	0xaf, 0x17, 0xfa, 0xd9, 0x18, 0x5d, 0xf7, 0xde, 0x37, 0xf4, 0x71, 0x98, 0x27, 0x1c, 0xad, 0x70,
+	0x4d, 0x41, 0x0d, 0x98, 0xb4, 0x9c, 0x6f, 0xd2, 0x45, 0xc5, 0xa4, 0xeb, 0x05, 0x0d, 0xe9, 0xef,
+	0x15, 0xa0, 0x3a, 0xca, 0x20, 0x2f, 0x34, 0xff, 0xd7, 0x4c, 0x82, 0x09, 0x68, 0xc1, 0x08, 0x2f,
+	0xd3, 0x80, 0x17, 0x72, 0x67, 0x94, 0x13, 0x7b, 0x94, 0x4b, 0x1a, 0x23, 0xd9, 0xe8, 0x5f, 0x41,
+	0x70, 0x42, 0xfd, 0x2c, 0xdc, 0xb2, 0xc3, 0x48, 0x5e, 0x02, 0xf1, 0x0e, 0x2c, 0xc4, 0xaa, 0xc4,
+	0x25, 0x7c, 0xa5, 0xb9, 0x35, 0x6d, 0x61, 0xa7, 0xec, 0xae, 0x64, 0xae, 0x3f, 0x06, 0x27, 0x86,
+	0x9e, 0x50, 0x02, 0x46, 0x15, 0xca, 0xb2, 0x98, 0x15, 0xbb, 0x9f, 0xd0, 0xfa, 0x5b, 0x73, 0x6a,
+	0xb9, 0xe0, 0x59, 0x5b, 0x5e, 0x2b, 0xa7, 0xe3, 0x93, 0xef, 0x31, 0x6c, 0x37, 0x3c, 0x2b, 0xd3,
+	0xdc, 0x91, 0x24, 0xfb, 0xce, 0xf4, 0xdc, 0x88, 0xd8, 0x2e, 0x0d, 0x44, 0x45, 0x93, 0x2e, 0xb0,
+	0x9d, 0x0e, 0x6d, 0xd7, 0xa4, 0xdb, 0xd4, 0xf4, 0x5c, 0x2b, 0xe4, 0x2e, 0x53, 0x34, 0x94, 0x35,
+	0xfc, 0x2c, 0x2c, 0x72, 0xfa, 0x96, 0xdd, 0x89, 0x8f, 0xf0, 0x4a, 0x73, 0xb5, 0x1e, 0x37, 0x70,
+	0xeb, 0xd9, 0x06, 0x6e, 0x6a, 0x43, 0xd6, 0xc0, 0xad, 0xf7, 0x2e, 0xd6, 0xd9, 0x17, 0x46, 0xfa,
+	0x31, 0xc3, 0x12, 0x11, 0xdb, 0xd9, 0xb2, 0x5d, 0x7e, 0xc1, 0x60, 0xa2, 0xd2, 0x05, 0xe6, 0x8d,
+	0x3b, 0x9e, 0xe3, 0x78, 0x2f, 0xcb, 0x9c, 0x17, 0x53, 0xec, 0xab, 0xae, 0x1b, 0xd9, 0x0e, 0x97,
+	0x1f, 0xfb, 0x5a, 0xba, 0xc0, 0xbf, 0xb2, 0x9d, 0x88, 0x06, 0x22, 0xd9, 0x09, 0x2a, 0xf1, 0xf7,
+	0x0a, 0x5f, 0x4d, 0x72, 0x6d, 0x1c, 0x19, 0x07, 0xb2, 0x91, 0xd1, 0x1f, 0x6d, 0x4b, 0x43, 0xba,
+	0x63, 0xbc, 0xcf, 0x4a, 0x7b, 0xb6, 0xd7, 0x65, 0xb5, 0x33, 0x2f, 0x1b, 0x25, 0x3d, 0x10, 0x2d,
+	0x87, 0xf2, 0xa3, 0xe5, 0xb0, 0x1a, 0x2d, 0xfc, 0x06, 0x14, 0x99, 0xed, 0x0d, 0x12, 0x52, 0x5e,
+	0x43, 0x97, 0x8d, 0x74, 0x41, 0xff, 0x0d, 0x82, 0xf2, 0x96, 0xd7, 0xba, 0xea, 0x46, 0xc1, 0x1e,
+	0x63, 0xc2, 0x76, 0x8e, 0xba, 0xd2, 0x9b, 0x24, 0xc9, 0xb6, 0x28, 0xb2, 0x3b, 0x74, 0x3b, 0x22,
+	0x1d, 0x5f, 0x54, 0xcf, 0xfb, 0xda, 0xa2, 0xe4, 0x63, 0x66, 0x36, 0x87, 0x84, 0x11, 0x4f, 0x39,
+	0x65, 0x83, 0x3f, 0x33, 0x05, 0x93, 0x17, 0xb6, 0xa3, 0x40, 0xe4, 0x1b, 0x65, 0x2d, 0xeb, 0x80,
+	0xa5, 0x18, 0x9b, 0x20, 0xf5, 0x0e, 0xdc, 0x9f, 0x5c, 0x01, 0x6f, 0xd1, 0xa0, 0x63, 0xbb, 0x24,
+	0xff, 0x5c, 0x9e, 0xa0, 0xfd, 0x9b, 0xd3, 0x81, 0xf0, 0x94, 0x90, 0x64, 0x37, 0xaa, 0xdb, 0xb6,
+	0x6b, 0x79, 0x2f, 0xe7, 0x84, 0xd6, 0x74, 0x02, 0xff, 0xac, 0x76, 0x70, 0x33, 0x12, 0x93, 0x3c,
+	0xf0, 0x2c, 0x2c, 0xb1, 0x8c, 0xd1, 0xa3, 0xe2, 0x07, 0x91, 0x94, 0xf4, 0x51, 0x2d, 0xb3, 0x94,
+	0x87, 0xa1, 0x7e, 0x88, 0xb7, 0xe0, 0x10, 0x09, 0x43, 0xbb, 0xe5, 0x52, 0x4b, 0xf2, 0x2a, 0x4c,
+	0xcc, 0xab, 0xff, 0xd3, 0xb8, 0xf9, 0xc2, 0xdf, 0x10, 0xfb, 0x2d, 0x49, 0xfd, 0xcb, 0x08, 0x8e,
+	0x0d, 0x65, 0x92, 0xc4, 0x15, 0xca, 0x9c, 0x23, 0x6c, 0x7e, 0x60, 0xb6, 0xa9, 0xd5, 0x75, 0x64,
+	0xa9, 0x90, 0xd0, 0xec, 0x37, 0xab, 0x1b, 0xef, 0xbe, 0x38, 0xc7, 0x12, 0x1a, 0x9f, 0x04, 0xe8,
+	0x10, 0xb7, 0x4b, 0x1c, 0x0e, 0x61, 0x8e, 0x43, 0xc8, 0xac, 0xe8, 0xcb, 0x50, 0x1d, 0xe6, 0x3a,
+	0xa2, 0xd3, 0xf7, 0x4f, 0x04, 0x07, 0x65, 0xca, 0x15, 0xbb, 0x5b, 0x83, 0x43, 0x19, 0x33, 0xdc,
+	0x48, 0x37, 0xba, 0x7f, 0x79, 0x4c, 0x3a, 0x95, 0x5e, 0x52, 0x54, 0x87, 0x30, 0x3d, 0x65, 0x8c,
+	0x32, 0xf1, 0x81, 0x8b, 0x66, 0x74, 0x33, 0xf8, 0x22, 0x68, 0xd7, 0x89, 0x4b, 0x5a, 0xd4, 0x4a,
+	0xd4, 0x4e, 0x5c, 0xec, 0x73, 0xd9, 0x96, 0xd5, 0xd4, 0x0d, 0xa2, 0xa4, 0x88, 0xb6, 0x77, 0x76,
+	0x64, 0xfb, 0xeb, 0x8d, 0x82, 0xea, 0xe7, 0x7c, 0xae, 0xb5, 0x6d, 0x5b, 0xfc, 0xa5, 0xd8, 0xfc,
+	0x1a, 0x2c, 0x08, 0x55, 0x64, 0x82, 0x12, 0xe4, 0x74, 0x21, 0x86, 0x7d, 0x58, 0x72, 0xec, 0x1e,
+	0x4d, 0xb4, 0xd6, 0xe6, 0x66, 0xae, 0xa4, 0x2a, 0x80, 0x39, 0x52, 0x44, 0x82, 0x16, 0x8d, 0xae,
+	0x27, 0xdd, 0xa9, 0x12, 0x6f, 0x87, 0xf4, 0x2f, 0xeb, 0x3f, 0x54, 0xfb, 0xf8, 0xaa, 0x59, 0xfe,
+	0x7b, 0xdb, 0xc3, 0x6b, 0x0d, 0xcf, 0xb2, 0x77, 0x6c, 0x1a, 0xdf, 0xd7, 0xcb, 0x46, 0x42, 0xeb,
+	0x01, 0x94, 0xb7, 0x6c, 0x77, 0x97, 0x35, 0xc0, 0x98, 0xb3, 0x46, 0x76, 0xe4, 0xc8, 0x1d, 0x8a,
+	0x09, 0x7c, 0x18, 0x8a, 0xdd, 0xc0, 0x11, 0xc1, 0xcb, 0x1e, 0xd9, 0x3c, 0xc8, 0xa2, 0xa1, 0x19,
+	0xd8, 0xbe, 0x08, 0x5d, 0x3e, 0x0f, 0xca, 0x2c, 0xb1, 0x10, 0xb2, 0x4d, 0xcf, 0xdd, 0x70, 0x48,
+	0x18, 0xca, 0xca, 0x22, 0x59, 0xd0, 0x9f, 0x80, 0x25, 0x26, 0x33, 0xf5, 0xd0, 0x73, 0xaa, 0x09,
+	0x8e, 0x29, 0xaa, 0x49, 0x78, 0xd2, 0xd9, 0x08, 0xdc, 0xc7, 0x0a, 0xba, 0xcb, 0xbe, 0x2f, 0x98,
+	0x4c, 0x78, 0xbb, 0x28, 0x0e, 0x2b, 0x8c, 0x86, 0x0e, 0x3b, 0x9a, 0x77, 0xcf, 0x02, 0xee, 0xdb,
+	0x38, 0xdb, 0xa4, 0xf8, 0x9b, 0x08, 0xe6, 0x98, 0x68, 0xfc, 0xc0, 0xa8, 0x8c, 0xca, 0x7d, 0xbd,
+	0x3a, 0xbb, 0xee, 0x14, 0x93, 0xa6, 0x2f, 0xbf, 0xf6, 0x97, 0xbf, 0x7d, 0xab, 0x70, 0x1c, 0x1f,
+	0xe5, 0x13, 0xf3, 0xde, 0xc5, 0xec, 0x0c, 0x3b, 0xc4, 0x5f, 0x42, 0x80, 0x45, 0x81, 0x9b, 0x19,
+	0x0f, 0xe2, 0x73, 0xa3, 0x20, 0x0e, 0x19, 0x23, 0x56, 0x8f, 0xd4, 0xc5, 0xf0, 0x99, 0x2f, 0x72,
+	0xa1, 0xab, 0x5c, 0xe8, 0x69, 0xac, 0x0f, 0x13, 0xda, 0x78, 0x85, 0x59, 0xf1, 0x55, 0x31, 0xb2,
+	0xc6, 0x6f, 0x22, 0x28, 0xdd, 0xe6, 0x97, 0xf9, 0x31, 0x86, 0xd9, 0x9e, 0x99, 0x61, 0xb8, 0x38,
+	0x8e, 0x56, 0x3f, 0xc5, 0x91, 0x3e, 0x80, 0x4f, 0x48, 0xa4, 0x61, 0x14, 0x50, 0xd2, 0x51, 0x00,
+	0x5f, 0x40, 0xf8, 0x6d, 0x04, 0xf3, 0xf1, 0xc4, 0x07, 0x9f, 0x19, 0x85, 0x52, 0x99, 0x08, 0x55,
+	0x67, 0x37, 0x3e, 0xd1, 0x1f, 0xe6, 0x18, 0x4f, 0xe9, 0x43, 0xb7, 0x70, 0x5d, 0x19, 0xae, 0xbc,
+	0x81, 0xa0, 0x78, 0x8d, 0x8e, 0xf5, 0xb1, 0x19, 0x82, 0x1b, 0x30, 0xe0, 0x90, 0xad, 0xc6, 0x6f,
+	0x21, 0xb8, 0xff, 0x1a, 0x8d, 0x86, 0x57, 0x33, 0xb8, 0x36, 0xbe, 0xc4, 0x10, 0xae, 0x76, 0x6e,
+	0x82, 0x37, 0x93, 0x63, 0xbc, 0xc1, 0x91, 0x3d, 0x8c, 0xcf, 0xe6, 0x39, 0x21, 0x6b, 0x86, 0xbf,
+	0x2c, 0x70, 0xfc, 0x01, 0xc1, 0xe1, 0xfe, 0xd1, 0x3f, 0xd6, 0xfb, 0xae, 0x94, 0x43, 0xfe, 0x32,
+	0xa0, 0x7a, 0x63, 0xda, 0xac, 0xab, 0x32, 0xd5, 0x2f, 0x73, 0xe4, 0x8f, 0xe3, 0xc7, 0xf2, 0x90,
+	0x27, 0xed, 0xf3, 0xc6, 0x2b, 0xf2, 0xf1, 0xd5, 0x46, 0x47, 0xb0, 0xc0, 0x7f, 0x44, 0x70, 0x54,
+	0xf2, 0xdd, 0x68, 0x93, 0x20, 0xba, 0x42, 0xd9, 0x85, 0x28, 0x9c, 0x48, 0x9f, 0x29, 0x4f, 0x91,
+	0xac, 0x3c, 0xfd, 0x2a, 0xd7, 0xe5, 0x29, 0xfc, 0xe4, 0xbe, 0x75, 0x31, 0x19, 0x1b, 0x4b, 0xc0,
+	0x7e, 0x17, 0xc1, 0xc1, 0x6b, 0x34, 0x7a, 0x6e, 0x63, 0x73, 0x5f, 0x3b, 0x33, 0xa5, 0xa3, 0x67,
+	0xc4, 0xe9, 0x57, 0xb8, 0x22, 0x1f, 0xc3, 0x4f, 0xec, 0x5b, 0x11, 0xcf, 0xb4, 0x93, 0x7d, 0x79,
+	0x0d, 0xc1, 0x81, 0x6b, 0x99, 0x63, 0x7e, 0x74, 0x3a, 0x51, 0xc6, 0xdb, 0xd5, 0xe5, 0x7a, 0xe6,
+	0x0f, 0x84, 0xe4, 0x4f, 0x89, 0xab, 0xaf, 0x71, 0x6c, 0x67, 0xf1, 0x99, 0x3c, 0x6c, 0xe9, 0xf8,
+	0xeb, 0x4d, 0x04, 0xc7, 0xb2, 0x20, 0xd2, 0x3f, 0x0b, 0xf8, 0xf0, 0xfe, 0x86, 0xed, 0x62, 0x64,
+	0x3f, 0x06, 0x5d, 0x93, 0xa3, 0x3b, 0xaf, 0x0f, 0x0f, 0xc4, 0xce, 0x00, 0x8a, 0x75, 0xb4, 0x5a,
+	0x43, 0xf8, 0xb7, 0x08, 0xe6, 0xe3, 0xe9, 0xce, 0x68, 0x1b, 0x29, 0x63, 0xec, 0x59, 0x66, 0x35,
+	0xe1, 0xb5, 0xd5, 0x0b, 0xc3, 0x0d, 0x9a, 0xfd, 0x5e, 0x6e, 0x6d, 0x9d, 0x5b, 0x59, 0x4d, 0xc7,
+	0xbf, 0x40, 0x00, 0xe9, 0x84, 0x0a, 0x3f, 0x9c, 0xaf, 0x47, 0x66, 0x8a, 0x55, 0x9d, 0xed, 0x8c,
+	0x4a, 0xaf, 0x73, 0x7d, 0x6a, 0xd5, 0x95, 0xdc, 0x5c, 0xe8, 0x53, 0x73, 0x3d, 0x9e, 0x66, 0xfd,
+	0x00, 0x41, 0x89, 0x0f, 0x06, 0xf0, 0xe9, 0x51, 0x98, 0xb3, 0x73, 0x83, 0x59, 0x9a, 0xfe, 0x21,
+	0x0e, 0x75, 0xa5, 0x99, 0x77, 0xa0, 0xac, 0xa3, 0x55, 0xdc, 0x83, 0xf9, 0xb8, 0x15, 0x3f, 0xda,
+	0x3d, 0x94, 0x56, 0x7d, 0x75, 0x25, 0xa7, 0xa8, 0x89, 0x1d, 0x55, 0x9c, 0x65, 0xab, 0xe3, 0xce,
+	0xb2, 0x39, 0x76, 0xdc, 0xe0, 0x53, 0x79, 0x87, 0xd1, 0x7f, 0xc0, 0x30, 0xe7, 0x38, 0xba, 0x33,
+	0xfa, 0xca, 0xb8, 0xf3, 0x8c, 0x59, 0xe7, 0xdb, 0x08, 0x0e, 0xf7, 0xdf, 0xe9, 0xf0, 0x89, 0xa1,
+	0xed, 0x51, 0x71, 0xb6, 0xaa, 0x56, 0x1c, 0x75, 0x1f, 0xd4, 0x3f, 0xce, 0x51, 0xac, 0xe3, 0x47,
+	0xc7, 0x46, 0xc6, 0x0d, 0x99, 0x75, 0x18, 0xa3, 0xb5, 0x74, 0x34, 0xff, 0x23, 0x04, 0x07, 0xd5,
+	0xdb, 0xcc, 0xe8, 0x7a, 0x73, 0xc8, 0x65, 0xb0, 0x5a, 0x9f, 0xec, 0xe5, 0x04, 0xf1, 0x47, 0x39,
+	0xe2, 0x8b, 0xb8, 0x31, 0x12, 0x71, 0x8c, 0x34, 0xfe, 0x83, 0xca, 0xb5, 0xd0, 0xb6, 0xe8, 0x9a,
+	0xc5, 0x50, 0xfd, 0x12, 0xc1, 0x01, 0x69, 0x80, 0x5b, 0x01, 0xa5, 0xf9, 0xf6, 0x9b, 0x5d, 0xc4,
+	0x32, 0x59, 0xfa, 0x13, 0x1c, 0xf5, 0x47, 0xf0, 0xa5, 0x09, 0xed, 0x2c, 0xed, 0xbb, 0x16, 0x31,
+	0xa4, 0xbf, 0x43, 0x70, 0xe4, 0x76, 0x1c, 0xa0, 0x1f, 0x10, 0xfe, 0x0d, 0x8e, 0xff, 0x49, 0xfc,
+	0x78, 0x4e, 0x61, 0x3d, 0x4e, 0x8d, 0x0b, 0x08, 0xff, 0x0c, 0x41, 0x59, 0xce, 0x93, 0xf1, 0xd9,
+	0x91, 0x11, 0xac, 0x4e, 0x9c, 0x67, 0x19, 0x75, 0xa2, 0x8a, 0xd4, 0x4f, 0xe7, 0x1e, 0xfb, 0x42,
+	0x3e, 0x8b, 0xbc, 0x37, 0x10, 0xe0, 0xa4, 0xa7, 0x94, 0x74, 0x99, 0xf0, 0x43, 0x8a, 0xa8, 0x91,
+	0x8d, 0xcb, 0xea, 0xd9, 0xb1, 0xef, 0xa9, 0x67, 0xfe, 0x6a, 0xee, 0x99, 0xef, 0x25, 0xf2, 0x5f,
+	0x47, 0x50, 0xb9, 0x46, 0x93, 0x8b, 0x5e, 0x8e, 0x2d, 0xd5, 0x71, 0x78, 0xb5, 0x36, 0xfe, 0x45,
+	0x81, 0xe8, 0x3c, 0x47, 0xf4, 0x10, 0xce, 0x37, 0x95, 0x04, 0xf0, 0x1d, 0x04, 0x4b, 0x37, 0xb3,
+	0x2e, 0x8a, 0xcf, 0x8f, 0x93, 0xa4, 0x1c, 0x39, 0x93, 0xe3, 0x7a, 0x84, 0xe3, 0x5a, 0xd3, 0x27,
+	0xc2, 0xb5, 0x2e, 0x26, 0xcb, 0xdf, 0x43, 0x71, 0xa7, 0xa0, 0x6f, 0x1a, 0xf4, 0xef, 0xda, 0x2d,
+	0x67, 0xa8, 0xa4, 0x5f, 0xe2, 0xf8, 0xea, 0xf8, 0xfc, 0x24, 0xf8, 0x1a, 0x62, 0x44, 0x84, 0xbf,
+	0x8b, 0xe0, 0x08, 0x1f, 0x07, 0x66, 0x19, 0xe3, 0xbc, 0x09, 0x58, 0x3a, 0x3c, 0x9c, 0xe0, 0x2c,
+	0x7c, 0x2a, 0xce, 0x3f, 0xfa, 0xbe, 0x40, 0xad, 0x8b, 0x41, 0xdf, 0x57, 0x0b, 0x88, 0xed, 0xef,
+	0x7d, 0x03, 0xf8, 0x5e, 0x68, 0xf6, 0x19, 0x70, 0xf4, 0x78, 0x73, 0x02, 0x8c, 0xeb, 0x1c, 0xe3,
+	0x25, 0xbd, 0xb1, 0x1f, 0x8c, 0x8d, 0x5e, 0x93, 0x85, 0xe9, 0xd7, 0x11, 0x1c, 0x94, 0xf5, 0x81,
+	0xf0, 0xbf, 0xb5, 0x71, 0x5b, 0xbb, 0xdf, 0x7a, 0x42, 0x04, 0xc4, 0xea, 0x64, 0x01, 0xf1, 0x36,
+	0x82, 0x05, 0x31, 0xad, 0xcb, 0xa9, 0xba, 0x32, 0xe3, 0xbc, 0x6a, 0x5f, 0xab, 0x4b, 0x8c, 0x73,
+	0xf4, 0xcf, 0x70, 0xb1, 0xcf, 0xe3, 0x5c, 0xb3, 0xf8, 0x9e, 0x15, 0x36, 0x5e, 0x11, 0xb3, 0x94,
+	0x57, 0x1b, 0x8e, 0xd7, 0x0a, 0x5f, 0xd4, 0x71, 0x6e, 0x6d, 0xc1, 0xde, 0xb9, 0x80, 0x70, 0x04,
+	0x8b, 0xcc, 0x7d, 0x79, 0xff, 0x0c, 0xab, 0x46, 0x18, 0xd2, 0x5a, 0xab, 0x56, 0x07, 0xfa, 0x71,
+	0x69, 0x31, 0x21, 0x3a, 0x1b, 0xf8, 0xc1, 0x5c, 0xb1, 0x5c, 0xd0, 0xd7, 0x10, 0x1c, 0xc9, 0xc6,
+	0x63, 0x2c, 0x7e, 0xe2, 0x68, 0xcc, 0x43, 0x21, 0xee, 0x27, 0x78, 0x75, 0x22, 0x37, 0xe2, 0x70,
+	0x9e, 0x7e, 0xe6, 0xf7, 0x77, 0x4f, 0xa2, 0x3f, 0xdd, 0x3d, 0x89, 0xfe, 0x7a, 0xf7, 0x24, 0x7a,
+	0xf1, 0xd1, 0xc9, 0xfe, 0x03, 0x89, 0xe9, 0xd8, 0xd4, 0x8d, 0xb2, 0xec, 0xff, 0x35, 0x00, 0x41,
+	0xc6, 0xce, 0x31, 0x02, 0x33, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5508,6 +5529,27 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PlanID != nil {
+		i -= len(*m.PlanID)
+		copy(dAtA[i:], *m.PlanID)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PlanID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Plan != nil {
+		i--
+		if *m.Plan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -7869,6 +7911,13 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.Plan != nil {
+		n += 3
+	}
+	if m.PlanID != nil {
+		l = len(*m.PlanID)
+		n += 2 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11468,6 +11517,60 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Plan = &b
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PlanID = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -564,6 +564,187 @@ func (m *ApplicationSetGenerateResponse) GetApplications() []*v1alpha1.Applicati
 	return nil
 }
 
+// ApplicationSetApplicationDiff is an Application which would be created, updated or deleted by an applicationset
+type ApplicationSetApplicationDiff struct {
+	// the action which would be performed on the application: create, update or delete
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// the application in the cluster, normalized for the comparison. Empty for a creation
+	LiveState *v1alpha1.Application `protobuf:"bytes,2,opt,name=liveState,proto3" json:"liveState,omitempty"`
+	// the application which would be written. Empty for a deletion
+	TargetState          *v1alpha1.Application `protobuf:"bytes,3,opt,name=targetState,proto3" json:"targetState,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ApplicationSetApplicationDiff) Reset()         { *m = ApplicationSetApplicationDiff{} }
+func (m *ApplicationSetApplicationDiff) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetApplicationDiff) ProtoMessage()    {}
+func (*ApplicationSetApplicationDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetApplicationDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetApplicationDiff.Merge(m, src)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetApplicationDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetApplicationDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetApplicationDiff proto.InternalMessageInfo
+
+func (m *ApplicationSetApplicationDiff) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetLiveState() *v1alpha1.Application {
+	if m != nil {
+		return m.LiveState
+	}
+	return nil
+}
+
+func (m *ApplicationSetApplicationDiff) GetTargetState() *v1alpha1.Application {
+	if m != nil {
+		return m.TargetState
+	}
+	return nil
+}
+
+// ApplicationSetDiffResponse is a response for applicationset diff request
+type ApplicationSetDiffResponse struct {
+	Diffs                []*ApplicationSetApplicationDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ApplicationSetDiffResponse) Reset()         { *m = ApplicationSetDiffResponse{} }
+func (m *ApplicationSetDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetDiffResponse) ProtoMessage()    {}
+func (*ApplicationSetDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{10}
+}
+func (m *ApplicationSetDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetDiffResponse.Merge(m, src)
+}
+func (m *ApplicationSetDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetDiffResponse proto.InternalMessageInfo
+
+func (m *ApplicationSetDiffResponse) GetDiffs() []*ApplicationSetApplicationDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// ApplicationSetRolloutRequest is a request to promote or abort a progressive sync step which requires manual approval
+type ApplicationSetRolloutRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// the 1-based index of the step. Default 0 selects the first step which is waiting for approval
+	Step                 int64    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetRolloutRequest) Reset()         { *m = ApplicationSetRolloutRequest{} }
+func (m *ApplicationSetRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetRolloutRequest) ProtoMessage()    {}
+func (*ApplicationSetRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{11}
+}
+func (m *ApplicationSetRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetRolloutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutRequest.Merge(m, src)
+}
+func (m *ApplicationSetRolloutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutRequest) GetStep() int64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
@@ -574,6 +755,9 @@ func init() {
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
+	proto.RegisterType((*ApplicationSetApplicationDiff)(nil), "applicationset.ApplicationSetApplicationDiff")
+	proto.RegisterType((*ApplicationSetDiffResponse)(nil), "applicationset.ApplicationSetDiffResponse")
+	proto.RegisterType((*ApplicationSetRolloutRequest)(nil), "applicationset.ApplicationSetRolloutRequest")
 }
 
 func init() {
@@ -581,57 +765,69 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcd, 0x6e, 0x13, 0x3b,
-	0x14, 0xc7, 0xe5, 0xa6, 0xcd, 0x4d, 0xdd, 0xea, 0x5e, 0x5d, 0x4b, 0xb7, 0xcd, 0x1d, 0x20, 0x44,
-	0x23, 0xb5, 0x0d, 0x2d, 0xf1, 0xd0, 0x96, 0x0d, 0x65, 0xc5, 0x97, 0xaa, 0x4a, 0x15, 0x82, 0x09,
-	0x6a, 0x25, 0x58, 0x20, 0x77, 0x72, 0x94, 0x0e, 0x4d, 0x66, 0x06, 0xdb, 0x89, 0x54, 0x55, 0xb0,
-	0x40, 0x62, 0xcd, 0x02, 0xc1, 0x03, 0xc0, 0x86, 0x35, 0xb0, 0x62, 0xc3, 0x82, 0x0d, 0x4b, 0x24,
-	0x5e, 0x00, 0x55, 0x3c, 0x08, 0xb2, 0x67, 0xf2, 0x31, 0x26, 0x1f, 0x95, 0x08, 0xac, 0x12, 0x7b,
-	0xec, 0x73, 0x7e, 0x3e, 0xe7, 0xf8, 0xef, 0x83, 0x97, 0x05, 0xf0, 0x16, 0x70, 0x87, 0x45, 0x51,
-	0xdd, 0xf7, 0x98, 0xf4, 0xc3, 0x40, 0x80, 0x34, 0x86, 0x34, 0xe2, 0xa1, 0x0c, 0xc9, 0xdf, 0xe9,
-	0x59, 0xeb, 0x74, 0x2d, 0x0c, 0x6b, 0x75, 0x70, 0x58, 0xe4, 0x3b, 0x2c, 0x08, 0x42, 0x19, 0x7f,
-	0x89, 0x57, 0x5b, 0xdb, 0x35, 0x5f, 0xee, 0x37, 0xf7, 0xa8, 0x17, 0x36, 0x1c, 0xc6, 0x6b, 0x61,
-	0xc4, 0xc3, 0x07, 0xfa, 0x4f, 0xd9, 0xab, 0x3a, 0xad, 0x75, 0x27, 0x3a, 0xa8, 0xa9, 0x9d, 0xa2,
-	0xd7, 0x97, 0xd3, 0x5a, 0x65, 0xf5, 0x68, 0x9f, 0xad, 0x3a, 0x35, 0x08, 0x80, 0x33, 0x09, 0xd5,
-	0xc4, 0xda, 0xa5, 0x11, 0xd6, 0x92, 0x63, 0x40, 0x0b, 0x02, 0x29, 0x92, 0x9f, 0x78, 0xab, 0xbd,
-	0x83, 0xe7, 0xae, 0x74, 0x5d, 0x54, 0x40, 0x6e, 0x82, 0xbc, 0xdd, 0x04, 0x7e, 0x48, 0x08, 0x9e,
-	0x0c, 0x58, 0x03, 0xf2, 0xa8, 0x88, 0x4a, 0xd3, 0xae, 0xfe, 0x4f, 0x4a, 0xf8, 0x1f, 0x16, 0x45,
-	0x02, 0xe4, 0x4d, 0xd6, 0x00, 0x11, 0x31, 0x0f, 0xf2, 0x13, 0xfa, 0xb3, 0x39, 0x6d, 0x1f, 0xe1,
-	0xf9, 0xb4, 0xdd, 0x6d, 0x5f, 0x24, 0x86, 0x2d, 0x9c, 0x53, 0x80, 0xe0, 0x49, 0x91, 0x47, 0xc5,
-	0x4c, 0x69, 0xda, 0xed, 0x8c, 0xd5, 0x37, 0x01, 0x75, 0xf0, 0x64, 0xc8, 0x13, 0xcb, 0x9d, 0x71,
-	0x3f, 0xe7, 0x99, 0xfe, 0xce, 0x3f, 0x20, 0x9c, 0x4f, 0x7b, 0xdf, 0x65, 0xd2, 0xdb, 0x1f, 0x7c,
-	0xae, 0x5e, 0xa4, 0x89, 0x21, 0x48, 0x99, 0xbe, 0x48, 0x95, 0x5e, 0xa4, 0xc9, 0x0e, 0x52, 0xef,
-	0xb4, 0x5a, 0xc9, 0x41, 0x84, 0x4d, 0xee, 0xc1, 0x0e, 0x70, 0xe1, 0x87, 0x41, 0x7e, 0x2a, 0x5e,
-	0x69, 0x4c, 0xdb, 0x6f, 0x90, 0x99, 0x12, 0x17, 0x44, 0xa4, 0x8a, 0x8a, 0xe4, 0xf1, 0x5f, 0x09,
-	0x56, 0x42, 0xdf, 0x1e, 0x12, 0x89, 0x8d, 0xfa, 0xd3, 0xd1, 0x9b, 0x59, 0xdb, 0xa6, 0xdd, 0xd2,
-	0xa0, 0xed, 0xd2, 0xd0, 0x7f, 0xee, 0x7b, 0x55, 0xda, 0x5a, 0xa7, 0xd1, 0x41, 0x8d, 0xaa, 0x42,
-	0xa3, 0x3d, 0xdb, 0x69, 0xbb, 0xd0, 0xa8, 0xc1, 0x61, 0xf8, 0xb0, 0x3f, 0x21, 0x7c, 0x2a, 0xbd,
-	0xe4, 0x1a, 0x07, 0x26, 0xc1, 0x85, 0x87, 0x4d, 0x10, 0xfd, 0xa8, 0xd0, 0xef, 0xa7, 0x22, 0x73,
-	0x38, 0xdb, 0x8c, 0x04, 0xf0, 0x38, 0x06, 0x39, 0x37, 0x19, 0xa9, 0xf9, 0x2a, 0x3f, 0x74, 0x9b,
-	0x81, 0x4e, 0x63, 0xce, 0x4d, 0x46, 0xf6, 0x3d, 0xf3, 0x10, 0xd7, 0xa1, 0x0e, 0xdd, 0x43, 0xfc,
-	0xda, 0x3d, 0xd8, 0x35, 0xef, 0xc1, 0x1d, 0x0e, 0x30, 0x8e, 0x0b, 0xf6, 0x02, 0xe1, 0x33, 0xe6,
-	0xcd, 0x8d, 0x55, 0xa1, 0x7f, 0xf4, 0x2b, 0x7f, 0x20, 0xfa, 0x15, 0x90, 0xf6, 0x33, 0x84, 0x0b,
-	0x83, 0xb8, 0x92, 0x32, 0x6e, 0xe0, 0xd9, 0xde, 0x94, 0x69, 0x11, 0x98, 0x59, 0xdb, 0x1a, 0x1b,
-	0x96, 0x9b, 0x32, 0xbf, 0xf6, 0x16, 0xe3, 0xff, 0xd2, 0x44, 0x15, 0xe0, 0x2d, 0xdf, 0x03, 0xf2,
-	0x1a, 0xe1, 0xcc, 0x26, 0x48, 0xb2, 0x48, 0x0d, 0x49, 0xef, 0x2f, 0x89, 0xd6, 0x58, 0x23, 0x67,
-	0x2f, 0x3e, 0xf9, 0xfa, 0xfd, 0xf9, 0x44, 0x91, 0x14, 0xf4, 0x1b, 0xd1, 0x5a, 0x35, 0xde, 0x15,
-	0xe1, 0x1c, 0xa9, 0x92, 0x78, 0x44, 0x5e, 0x22, 0x9c, 0x6b, 0xc7, 0x90, 0x94, 0x47, 0xa1, 0xa6,
-	0x6a, 0xc0, 0xa2, 0x27, 0x5d, 0x1e, 0xa7, 0xc6, 0x5e, 0xd1, 0x4c, 0x0b, 0x76, 0x71, 0x10, 0x53,
-	0xfb, 0xe9, 0xd9, 0x40, 0xcb, 0xe4, 0x15, 0xc2, 0x93, 0x4a, 0xd6, 0xc9, 0xd2, 0x70, 0x2f, 0x1d,
-	0xe9, 0xb7, 0x6e, 0x8d, 0x33, 0x80, 0xca, 0xac, 0x7d, 0x56, 0x03, 0xff, 0x4f, 0xe6, 0x07, 0x00,
-	0x93, 0xf7, 0x08, 0x67, 0x63, 0x55, 0x22, 0x2b, 0xc3, 0x31, 0x53, 0xda, 0x35, 0xe6, 0x5c, 0x3b,
-	0x1a, 0xf3, 0x9c, 0x3d, 0x08, 0x73, 0xc3, 0x14, 0xb1, 0xa7, 0x08, 0x67, 0x63, 0x1d, 0x1a, 0x85,
-	0x9d, 0x52, 0x2b, 0x6b, 0x44, 0x29, 0x77, 0x12, 0x9d, 0x14, 0xdf, 0xf2, 0xa8, 0xe2, 0xfb, 0x88,
-	0xf0, 0xac, 0x9b, 0xbc, 0x50, 0x4a, 0xba, 0x46, 0xe5, 0xba, 0x23, 0x6f, 0xe3, 0xcd, 0xb5, 0x32,
-	0x6b, 0x5f, 0xd4, 0xcc, 0x94, 0x9c, 0x1f, 0xce, 0xec, 0xb4, 0x5f, 0xd4, 0xb2, 0x54, 0xc0, 0x8f,
-	0x31, 0x51, 0x95, 0xd2, 0x3e, 0xc4, 0x0d, 0xdd, 0xfd, 0x9c, 0xf8, 0xca, 0xff, 0x4b, 0x93, 0x76,
-	0x49, 0xef, 0xd3, 0x25, 0x57, 0xd6, 0x18, 0x4b, 0x64, 0x61, 0x04, 0x46, 0xbc, 0x91, 0xbc, 0x43,
-	0x78, 0x4a, 0xb7, 0x1f, 0xa4, 0x34, 0xdc, 0x67, 0xb7, 0x47, 0xb1, 0x76, 0xc6, 0x19, 0x3b, 0x6d,
-	0x57, 0xe3, 0xff, 0x2c, 0x39, 0x42, 0x72, 0x60, 0x0d, 0xf3, 0x04, 0x17, 0xd0, 0xd5, 0xad, 0xcf,
-	0xc7, 0x05, 0xf4, 0xe5, 0xb8, 0x80, 0xbe, 0x1d, 0x17, 0xd0, 0xdd, 0xcb, 0x27, 0x6b, 0x57, 0xbd,
-	0xba, 0x0f, 0x81, 0xd9, 0x1f, 0xef, 0x65, 0x75, 0xa7, 0xb9, 0xfe, 0x23, 0x00, 0x00, 0xff, 0xff,
-	0x10, 0xae, 0xd0, 0xd1, 0x4e, 0x0b, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x80, 0x35, 0x4d, 0x5b, 0xda, 0x69, 0x55, 0xc4, 0x48, 0xec, 0x06, 0x53, 0x42, 0x19, 0x69,
+	0xb7, 0x25, 0xdb, 0xd8, 0xb4, 0x45, 0x42, 0x5b, 0x4e, 0xcb, 0x2e, 0x5a, 0xad, 0x54, 0xa1, 0xc5,
+	0x41, 0x5d, 0x09, 0x0e, 0x68, 0xea, 0xbc, 0xb8, 0xa6, 0x8e, 0x6d, 0xc6, 0x93, 0x48, 0xab, 0x15,
+	0x1c, 0x90, 0xb8, 0x20, 0x21, 0x0e, 0x08, 0x7e, 0x00, 0x5c, 0xb8, 0xc3, 0x89, 0x0b, 0x87, 0x3d,
+	0xc0, 0x0d, 0x24, 0xfe, 0x00, 0xaa, 0xf8, 0x21, 0x68, 0xc6, 0xe3, 0xc4, 0x1e, 0x92, 0x38, 0x08,
+	0x83, 0xf6, 0x14, 0x3f, 0x67, 0xe6, 0xbd, 0xef, 0xcd, 0x7b, 0x6f, 0xde, 0x33, 0x6e, 0xa7, 0xc0,
+	0x47, 0xc0, 0x1d, 0x96, 0x24, 0x61, 0xe0, 0x31, 0x11, 0xc4, 0x51, 0x0a, 0xc2, 0x10, 0xed, 0x84,
+	0xc7, 0x22, 0x26, 0x5b, 0xe5, 0xb7, 0xd6, 0xb6, 0x1f, 0xc7, 0x7e, 0x08, 0x0e, 0x4b, 0x02, 0x87,
+	0x45, 0x51, 0x2c, 0xb2, 0x7f, 0xb2, 0xd5, 0xd6, 0x89, 0x1f, 0x88, 0xf3, 0xe1, 0x99, 0xed, 0xc5,
+	0x03, 0x87, 0x71, 0x3f, 0x4e, 0x78, 0xfc, 0x81, 0x7a, 0xe8, 0x78, 0x3d, 0x67, 0x74, 0xe4, 0x24,
+	0x17, 0xbe, 0xdc, 0x99, 0x16, 0x6d, 0x39, 0xa3, 0x03, 0x16, 0x26, 0xe7, 0xec, 0xc0, 0xf1, 0x21,
+	0x02, 0xce, 0x04, 0xf4, 0xb4, 0xb6, 0x9b, 0x15, 0xda, 0xb4, 0x1b, 0x30, 0x82, 0x48, 0xa4, 0xfa,
+	0x27, 0xdb, 0x4a, 0x4f, 0xf1, 0x95, 0x5b, 0x13, 0x13, 0x5d, 0x10, 0x77, 0x41, 0xbc, 0x3d, 0x04,
+	0xfe, 0x90, 0x10, 0xbc, 0x1c, 0xb1, 0x01, 0x34, 0xd1, 0x0e, 0xda, 0x5b, 0x77, 0xd5, 0x33, 0xd9,
+	0xc3, 0x4f, 0xb3, 0x24, 0x49, 0x41, 0xbc, 0xc5, 0x06, 0x90, 0x26, 0xcc, 0x83, 0xe6, 0x92, 0xfa,
+	0xdb, 0x7c, 0x4d, 0x1f, 0xe1, 0xab, 0x65, 0xbd, 0x27, 0x41, 0xaa, 0x15, 0x5b, 0x78, 0x4d, 0x02,
+	0x82, 0x27, 0xd2, 0x26, 0xda, 0x69, 0xec, 0xad, 0xbb, 0x63, 0x59, 0xfe, 0x97, 0x42, 0x08, 0x9e,
+	0x88, 0xb9, 0xd6, 0x3c, 0x96, 0xa7, 0x19, 0x6f, 0x4c, 0x37, 0xfe, 0x23, 0xc2, 0xcd, 0xb2, 0xf5,
+	0x07, 0x4c, 0x78, 0xe7, 0xb3, 0xfd, 0x2a, 0x22, 0x2d, 0xcd, 0x41, 0x6a, 0x4c, 0x45, 0xea, 0x16,
+	0x91, 0x96, 0xc7, 0x48, 0xc5, 0xd7, 0x72, 0x25, 0x87, 0x34, 0x1e, 0x72, 0x0f, 0x4e, 0x81, 0xa7,
+	0x41, 0x1c, 0x35, 0x57, 0xb2, 0x95, 0xc6, 0x6b, 0xfa, 0x1d, 0x32, 0x43, 0xe2, 0x42, 0x9a, 0xc8,
+	0xa4, 0x22, 0x4d, 0xfc, 0x94, 0xc6, 0xd2, 0xf4, 0xb9, 0x48, 0x04, 0x36, 0xf2, 0x4f, 0x9d, 0xde,
+	0xc6, 0xe1, 0x89, 0x3d, 0x49, 0x0d, 0x3b, 0x4f, 0x0d, 0xf5, 0xf0, 0xbe, 0xd7, 0xb3, 0x47, 0x47,
+	0x76, 0x72, 0xe1, 0xdb, 0x32, 0xd1, 0xec, 0xc2, 0x76, 0x3b, 0x4f, 0x34, 0xdb, 0xe0, 0x30, 0x6c,
+	0xd0, 0xc7, 0x08, 0x3f, 0x5f, 0x5e, 0x72, 0x9b, 0x03, 0x13, 0xe0, 0xc2, 0x87, 0x43, 0x48, 0xa7,
+	0x51, 0xa1, 0xff, 0x9e, 0x8a, 0x5c, 0xc1, 0xab, 0xc3, 0x24, 0x05, 0x9e, 0x9d, 0xc1, 0x9a, 0xab,
+	0x25, 0xf9, 0xbe, 0xc7, 0x1f, 0xba, 0xc3, 0x48, 0x85, 0x71, 0xcd, 0xd5, 0x12, 0x7d, 0xcf, 0x74,
+	0xe2, 0x0e, 0x84, 0x30, 0x71, 0xe2, 0xdf, 0xd5, 0xc1, 0x03, 0xb3, 0x0e, 0xde, 0xe1, 0x00, 0x75,
+	0x14, 0xd8, 0x57, 0x08, 0xbf, 0x60, 0x56, 0x6e, 0x76, 0x2b, 0x4c, 0x3f, 0xfd, 0xee, 0xff, 0x70,
+	0xfa, 0x5d, 0x10, 0xf4, 0x0b, 0x84, 0x5b, 0xb3, 0xb8, 0x74, 0x1a, 0x0f, 0xf0, 0x66, 0x31, 0x64,
+	0xea, 0x12, 0xd8, 0x38, 0xbc, 0x57, 0x1b, 0x96, 0x5b, 0x52, 0x4f, 0x3f, 0x5f, 0x32, 0x4f, 0xaa,
+	0x20, 0xdd, 0x09, 0xfa, 0x7d, 0x99, 0x19, 0xcc, 0x93, 0x92, 0x8e, 0x85, 0x96, 0x88, 0x8f, 0xd7,
+	0xc3, 0x60, 0x04, 0x5d, 0xc1, 0x04, 0xe8, 0x82, 0xaa, 0x91, 0x72, 0xa2, 0x9b, 0x5c, 0xe0, 0x0d,
+	0xc1, 0xb8, 0x0f, 0x22, 0x33, 0xd5, 0xa8, 0xdb, 0x54, 0x51, 0x3b, 0x65, 0xd8, 0x32, 0xf2, 0x3d,
+	0xe8, 0xf7, 0xc7, 0xc1, 0xb9, 0x8d, 0x57, 0x7a, 0x41, 0xbf, 0x9f, 0x47, 0xa5, 0x63, 0x1b, 0xdd,
+	0x6e, 0xee, 0x49, 0xba, 0xd9, 0x5e, 0x9a, 0xe0, 0x6d, 0x23, 0x4d, 0xe2, 0x30, 0x8c, 0x87, 0xa2,
+	0x96, 0x9a, 0x92, 0xbb, 0x53, 0x01, 0x89, 0x3a, 0xa6, 0x86, 0xab, 0x9e, 0x0f, 0x7f, 0xdd, 0xc2,
+	0xcf, 0x96, 0x4d, 0x76, 0x81, 0x8f, 0x02, 0x0f, 0xc8, 0xb7, 0x08, 0x37, 0xee, 0x82, 0x20, 0xd7,
+	0xe7, 0x7b, 0x92, 0xf7, 0x3d, 0xab, 0xd6, 0xf2, 0xa0, 0xd7, 0x3f, 0xf9, 0xfd, 0xcf, 0x2f, 0x97,
+	0x76, 0x48, 0x4b, 0x0d, 0x02, 0xa3, 0x03, 0x63, 0x78, 0x48, 0x9d, 0x47, 0xd2, 0xf9, 0x8f, 0xc8,
+	0xd7, 0x08, 0xaf, 0xe5, 0x85, 0x42, 0x3a, 0x55, 0xa8, 0xa5, 0x42, 0xb7, 0xec, 0x45, 0x97, 0x67,
+	0x21, 0xa6, 0x37, 0x14, 0xd3, 0x35, 0xba, 0x33, 0x8b, 0x29, 0x9f, 0x2f, 0x8e, 0x51, 0x9b, 0x7c,
+	0x86, 0xf0, 0xb2, 0x2a, 0x92, 0x7f, 0x08, 0xd5, 0x9e, 0xbf, 0xbc, 0x98, 0x73, 0x74, 0x57, 0x01,
+	0xbd, 0x44, 0xb7, 0x67, 0x01, 0xc9, 0xac, 0x92, 0x30, 0xdf, 0x20, 0xbc, 0x2c, 0x07, 0x09, 0xb2,
+	0x3b, 0x5f, 0xfb, 0x78, 0xd8, 0xb0, 0xee, 0xd7, 0x19, 0x4d, 0xa9, 0x96, 0xbe, 0xa8, 0x60, 0x9f,
+	0x23, 0x57, 0x67, 0xc0, 0x92, 0x1f, 0x10, 0x5e, 0xcd, 0xfa, 0x20, 0xb9, 0x31, 0x1f, 0xb3, 0xd4,
+	0x2d, 0x6b, 0x4e, 0x3c, 0x47, 0x61, 0xbe, 0x4c, 0x67, 0x61, 0x1e, 0x9b, 0x6d, 0xf3, 0x53, 0x84,
+	0x57, 0xb3, 0xce, 0x57, 0x85, 0x5d, 0xea, 0x8f, 0x56, 0x45, 0x5d, 0x8d, 0x83, 0xac, 0x2b, 0xa1,
+	0x5d, 0x55, 0x09, 0x3f, 0x21, 0xbc, 0xe9, 0xea, 0x99, 0x48, 0x36, 0xcb, 0xaa, 0x58, 0x8f, 0x1b,
+	0x6a, 0xbd, 0xb1, 0x96, 0x6a, 0xe9, 0xab, 0x8a, 0xd9, 0x26, 0xfb, 0xf3, 0x99, 0x9d, 0x7c, 0x86,
+	0xeb, 0x08, 0x09, 0xfc, 0x31, 0x26, 0x32, 0x53, 0x72, 0x27, 0xde, 0x54, 0xf3, 0xf6, 0xc2, 0xf7,
+	0xcf, 0x33, 0xb6, 0x1e, 0xd0, 0xd5, 0x3e, 0x95, 0x72, 0x1d, 0x85, 0xb1, 0x4b, 0xae, 0x55, 0x60,
+	0x64, 0x1b, 0xc9, 0xf7, 0x08, 0xaf, 0xa8, 0x81, 0x97, 0xec, 0xcd, 0xb7, 0x39, 0x99, 0x8a, 0xad,
+	0xd3, 0x3a, 0xcf, 0x4e, 0xe9, 0x55, 0xf8, 0x7f, 0xbf, 0xff, 0x52, 0xc1, 0x81, 0x0d, 0x4c, 0x0f,
+	0x5e, 0x41, 0xe4, 0x67, 0x84, 0xb7, 0xee, 0xf3, 0x78, 0x10, 0x0b, 0xd0, 0xdd, 0x82, 0xec, 0x57,
+	0xa4, 0x56, 0xa9, 0xa9, 0xd4, 0x5c, 0x3f, 0x37, 0x15, 0xf8, 0x11, 0xb5, 0xab, 0x42, 0x9f, 0x41,
+	0x38, 0x49, 0x86, 0x2e, 0x6f, 0xa9, 0xc7, 0x08, 0x6f, 0xde, 0x3a, 0x8b, 0xb9, 0x78, 0x12, 0xfc,
+	0x78, 0x4d, 0xf9, 0x71, 0x40, 0xf7, 0x17, 0xf4, 0x83, 0x49, 0xf0, 0x63, 0xd4, 0x7e, 0xe3, 0xde,
+	0x2f, 0x97, 0x2d, 0xf4, 0xdb, 0x65, 0x0b, 0xfd, 0x71, 0xd9, 0x42, 0xef, 0xbe, 0xbe, 0xd8, 0x07,
+	0xab, 0x17, 0x06, 0x10, 0x99, 0x5f, 0xc8, 0x67, 0xab, 0xea, 0x5b, 0xf3, 0xe8, 0xaf, 0x01, 0x00,
+	0xc7, 0x8d, 0x23, 0xc6, 0x50, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -650,6 +846,8 @@ type ApplicationSetServiceClient interface {
 	Get(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// Generate generates
 	Generate(ctx context.Context, in *ApplicationSetGenerateRequest, opts ...grpc.CallOption) (*ApplicationSetGenerateResponse, error)
+	// Diff returns the applications which would be created, updated or deleted by an applicationset
+	Diff(ctx context.Context, in *ApplicationSetGenerateRequest, opts ...grpc.CallOption) (*ApplicationSetDiffResponse, error)
 	//List returns list of applicationset
 	List(ctx context.Context, in *ApplicationSetListQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetList, error)
 	//Create creates an applicationset
@@ -661,6 +859,10 @@ type ApplicationSetServiceClient interface {
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*events.EventList, error)
 	Watch(ctx context.Context, in *ApplicationSetWatchQuery, opts ...grpc.CallOption) (ApplicationSetService_WatchClient, error)
+	// PromoteRollout approves a progressive sync step which requires manual approval
+	PromoteRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
+	// AbortRollout rejects a progressive sync step which requires manual approval, halting the rollout
+	AbortRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 }
 
 type applicationSetServiceClient struct {
@@ -689,6 +891,15 @@ func (c *applicationSetServiceClient) Generate(ctx context.Context, in *Applicat
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) Diff(ctx context.Context, in *ApplicationSetGenerateRequest, opts ...grpc.CallOption) (*ApplicationSetDiffResponse, error) {
+	out := new(ApplicationSetDiffResponse)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationSetServiceClient) List(ctx context.Context, in *ApplicationSetListQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetList, error) {
 	out := new(v1alpha1.ApplicationSetList)
 	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/List", in, out, opts...)
@@ -766,12 +977,32 @@ func (x *applicationSetServiceWatchClient) Recv() (*v1alpha1.ApplicationSetWatch
 	return m, nil
 }
 
+func (c *applicationSetServiceClient) PromoteRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/PromoteRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) AbortRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/AbortRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationSetServiceServer is the server API for ApplicationSetService service.
 type ApplicationSetServiceServer interface {
 	// Get returns an applicationset by name
 	Get(context.Context, *ApplicationSetGetQuery) (*v1alpha1.ApplicationSet, error)
 	// Generate generates
 	Generate(context.Context, *ApplicationSetGenerateRequest) (*ApplicationSetGenerateResponse, error)
+	// Diff returns the applications which would be created, updated or deleted by an applicationset
+	Diff(context.Context, *ApplicationSetGenerateRequest) (*ApplicationSetDiffResponse, error)
 	//List returns list of applicationset
 	List(context.Context, *ApplicationSetListQuery) (*v1alpha1.ApplicationSetList, error)
 	//Create creates an applicationset
@@ -783,6 +1014,10 @@ type ApplicationSetServiceServer interface {
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(context.Context, *ApplicationSetGetQuery) (*events.EventList, error)
 	Watch(*ApplicationSetWatchQuery, ApplicationSetService_WatchServer) error
+	// PromoteRollout approves a progressive sync step which requires manual approval
+	PromoteRollout(context.Context, *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error)
+	// AbortRollout rejects a progressive sync step which requires manual approval, halting the rollout
+	AbortRollout(context.Context, *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error)
 }
 
 // UnimplementedApplicationSetServiceServer can be embedded to have forward compatible implementations.
@@ -795,6 +1030,9 @@ func (*UnimplementedApplicationSetServiceServer) Get(ctx context.Context, req *A
 func (*UnimplementedApplicationSetServiceServer) Generate(ctx context.Context, req *ApplicationSetGenerateRequest) (*ApplicationSetGenerateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) Diff(ctx context.Context, req *ApplicationSetGenerateRequest) (*ApplicationSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
 func (*UnimplementedApplicationSetServiceServer) List(ctx context.Context, req *ApplicationSetListQuery) (*v1alpha1.ApplicationSetList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -813,6 +1051,12 @@ func (*UnimplementedApplicationSetServiceServer) ListResourceEvents(ctx context.
 func (*UnimplementedApplicationSetServiceServer) Watch(req *ApplicationSetWatchQuery, srv ApplicationSetService_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) PromoteRollout(ctx context.Context, req *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRollout not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) AbortRollout(ctx context.Context, req *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortRollout not implemented")
+}
 
 func RegisterApplicationSetServiceServer(s *grpc.Server, srv ApplicationSetServiceServer) {
 	s.RegisterService(&_ApplicationSetService_serviceDesc, srv)
@@ -854,6 +1098,24 @@ func _ApplicationSetService_Generate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetGenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).Diff(ctx, req.(*ApplicationSetGenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationSetService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationSetListQuery)
 	if err := dec(in); err != nil {
@@ -965,6 +1227,42 @@ func (x *applicationSetServiceWatchServer) Send(m *v1alpha1.ApplicationSetWatchE
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApplicationSetService_PromoteRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).PromoteRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/PromoteRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).PromoteRollout(ctx, req.(*ApplicationSetRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).AbortRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/AbortRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).AbortRollout(ctx, req.(*ApplicationSetRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "applicationset.ApplicationSetService",
 	HandlerType: (*ApplicationSetServiceServer)(nil),
@@ -977,6 +1275,10 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Generate",
 			Handler:    _ApplicationSetService_Generate_Handler,
 		},
+		{
+			MethodName: "Diff",
+			Handler:    _ApplicationSetService_Diff_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _ApplicationSetService_List_Handler,
@@ -997,6 +1299,14 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListResourceEvents",
 			Handler:    _ApplicationSetService_ListResourceEvents_Handler,
 		},
+		{
+			MethodName: "PromoteRollout",
+			Handler:    _ApplicationSetService_PromoteRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _ApplicationSetService_AbortRollout_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1430,50 +1740,195 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApplicationSetApplicationDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ApplicationSetGetQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ApplicationSetApplicationDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetApplicationDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if m.TargetState != nil {
+		{
+			size, err := m.TargetState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.LiveState != nil {
+		{
+			size, err := m.LiveState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSetListQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationSetDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplicationset(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Selector)
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Step != 0 {
+		i = encodeVarintApplicationset(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApplicationSetGetQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetListQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	l = len(m.Selector)
 	if l > 0 {
 		n += 1 + l + sovApplicationset(uint64(l))
 	}
@@ -1637,6 +2092,71 @@ func (m *ApplicationSetGenerateResponse) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetApplicationDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.LiveState != nil {
+		l = m.LiveState.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.TargetState != nil {
+		l = m.TargetState.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 1 + sovApplicationset(uint64(m.Step))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplicationset(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2764,6 +3284,380 @@ func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetApplicationDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiveState == nil {
+				m.LiveState = &v1alpha1.Application{}
+			}
+			if err := m.LiveState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetState == nil {
+				m.TargetState = &v1alpha1.Application{}
+			}
+			if err := m.TargetState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diffs = append(m.Diffs, &ApplicationSetApplicationDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplicationset(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -999,6 +999,93 @@ func (_c *ProjectServiceClient_ListLinks_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// SimulateSyncWindows provides a mock function for the type ProjectServiceClient
+func (_mock *ProjectServiceClient) SimulateSyncWindows(ctx context.Context, in *project.SyncWindowsSimulationRequest, opts ...grpc.CallOption) (*project.SyncWindowsSimulationResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateSyncWindows")
+	}
+
+	var r0 *project.SyncWindowsSimulationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.SyncWindowsSimulationRequest, ...grpc.CallOption) (*project.SyncWindowsSimulationResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.SyncWindowsSimulationRequest, ...grpc.CallOption) *project.SyncWindowsSimulationResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.SyncWindowsSimulationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *project.SyncWindowsSimulationRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectServiceClient_SimulateSyncWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateSyncWindows'
+type ProjectServiceClient_SimulateSyncWindows_Call struct {
+	*mock.Call
+}
+
+// SimulateSyncWindows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *project.SyncWindowsSimulationRequest
+//   - opts ...grpc.CallOption
+func (_e *ProjectServiceClient_Expecter) SimulateSyncWindows(ctx any, in any, opts ...any) *ProjectServiceClient_SimulateSyncWindows_Call {
+	return &ProjectServiceClient_SimulateSyncWindows_Call{Call: _e.mock.On("SimulateSyncWindows",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ProjectServiceClient_SimulateSyncWindows_Call) Run(run func(ctx context.Context, in *project.SyncWindowsSimulationRequest, opts ...grpc.CallOption)) *ProjectServiceClient_SimulateSyncWindows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *project.SyncWindowsSimulationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*project.SyncWindowsSimulationRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ProjectServiceClient_SimulateSyncWindows_Call) Return(syncWindowsSimulationResponse *project.SyncWindowsSimulationResponse, err error) *ProjectServiceClient_SimulateSyncWindows_Call {
+	_c.Call.Return(syncWindowsSimulationResponse, err)
+	return _c
+}
+
+func (_c *ProjectServiceClient_SimulateSyncWindows_Call) RunAndReturn(run func(ctx context.Context, in *project.SyncWindowsSimulationRequest, opts ...grpc.CallOption) (*project.SyncWindowsSimulationResponse, error)) *ProjectServiceClient_SimulateSyncWindows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type ProjectServiceClient
 func (_mock *ProjectServiceClient) Update(ctx context.Context, in *project.ProjectUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	// grpc.CallOption
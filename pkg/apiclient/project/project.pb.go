@@ -519,6 +519,168 @@ func (m *SyncWindowsResponse) GetWindows() []*v1alpha1.SyncWindow {
 	return nil
 }
 
+// SyncWindowsSimulationRequest is a request to simulate the sync windows of a project over a time range
+type SyncWindowsSimulationRequest struct {
+	// name is the name of the project
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// application is the name of an application of the project, whose name and destination are matched against the
+	// sync windows
+	Application string `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	// appNamespace is the namespace of the application
+	AppNamespace string `protobuf:"bytes,3,opt,name=appNamespace,proto3" json:"appNamespace,omitempty"`
+	// namespace is the destination namespace matched against the sync windows, it overrides the one of the application
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// cluster is the name or the server URL of the destination cluster matched against the sync windows, it overrides
+	// the one of the application
+	Cluster string `protobuf:"bytes,5,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// from is the start of the time range in RFC 3339 format, defaults to the current time
+	From string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the end of the time range in RFC 3339 format, defaults to seven days after its start
+	To                   string   `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowsSimulationRequest) Reset()         { *m = SyncWindowsSimulationRequest{} }
+func (m *SyncWindowsSimulationRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowsSimulationRequest) ProtoMessage()    {}
+func (*SyncWindowsSimulationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
+}
+func (m *SyncWindowsSimulationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowsSimulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowsSimulationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowsSimulationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowsSimulationRequest.Merge(m, src)
+}
+func (m *SyncWindowsSimulationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowsSimulationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowsSimulationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowsSimulationRequest proto.InternalMessageInfo
+
+func (m *SyncWindowsSimulationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncWindowsSimulationRequest) GetApplication() string {
+	if m != nil {
+		return m.Application
+	}
+	return ""
+}
+
+func (m *SyncWindowsSimulationRequest) GetAppNamespace() string {
+	if m != nil {
+		return m.AppNamespace
+	}
+	return ""
+}
+
+func (m *SyncWindowsSimulationRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SyncWindowsSimulationRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *SyncWindowsSimulationRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SyncWindowsSimulationRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+// SyncWindowsSimulationResponse contains the periods during which the sync windows allow or deny syncs
+type SyncWindowsSimulationResponse struct {
+	Periods []*v1alpha1.SyncWindowPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	// windows are the sync windows which match the application, namespace and cluster
+	Windows              []*v1alpha1.SyncWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SyncWindowsSimulationResponse) Reset()         { *m = SyncWindowsSimulationResponse{} }
+func (m *SyncWindowsSimulationResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowsSimulationResponse) ProtoMessage()    {}
+func (*SyncWindowsSimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
+}
+func (m *SyncWindowsSimulationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowsSimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowsSimulationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowsSimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowsSimulationResponse.Merge(m, src)
+}
+func (m *SyncWindowsSimulationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowsSimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowsSimulationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowsSimulationResponse proto.InternalMessageInfo
+
+func (m *SyncWindowsSimulationResponse) GetPeriods() []*v1alpha1.SyncWindowPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *SyncWindowsSimulationResponse) GetWindows() []*v1alpha1.SyncWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 type GlobalProjectsResponse struct {
 	Items                []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -530,7 +692,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +742,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +810,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,6 +856,8 @@ func init() {
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
+	proto.RegisterType((*SyncWindowsSimulationRequest)(nil), "project.SyncWindowsSimulationRequest")
+	proto.RegisterType((*SyncWindowsSimulationResponse)(nil), "project.SyncWindowsSimulationResponse")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
@@ -702,70 +866,79 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x97, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0x3b, 0xbb, 0xdb, 0x75, 0x43, 0xb7, 0x0d, 0x83,
-	0xb6, 0x8a, 0x0a, 0xb5, 0xd5, 0x06, 0x24, 0xfe, 0x9c, 0xd8, 0x6e, 0x15, 0x90, 0x7a, 0x00, 0x17,
-	0x04, 0xe2, 0xb0, 0xc8, 0xb1, 0x9f, 0xb2, 0x43, 0x1c, 0x7b, 0xf0, 0x4c, 0xb2, 0x0d, 0x51, 0x2f,
-	0x48, 0x80, 0xc4, 0x81, 0xc3, 0x72, 0xe2, 0x0b, 0xf0, 0x3d, 0xb8, 0x71, 0x44, 0xe2, 0x0b, 0xa0,
-	0x8a, 0x0f, 0x82, 0x3c, 0x1e, 0x3b, 0x71, 0x52, 0xc3, 0xa2, 0x06, 0x4e, 0x1e, 0x8f, 0xdf, 0xfc,
-	0x7e, 0xbf, 0xf7, 0x66, 0xe6, 0x37, 0x63, 0xd8, 0x16, 0x18, 0x0f, 0x31, 0xb6, 0x79, 0x1c, 0x7d,
-	0x81, 0x9e, 0xcc, 0x9e, 0x16, 0x8f, 0x23, 0x19, 0x91, 0x1b, 0xfa, 0xb5, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0xcb, 0x99, 0xed, 0x86, 0x61, 0x24, 0x5d, 0xc9, 0xa2, 0x50, 0xa4, 0x61, 0xf5,
-	0xd3, 0x2e, 0x93, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x1b, 0x77, 0xa3, 0x64, 0x94, 0x6a,
-	0x1c, 0x78, 0xbe, 0x3d, 0x6c, 0xd9, 0xbc, 0xd7, 0x4d, 0x46, 0x0a, 0xdb, 0xe5, 0x3c, 0x60, 0x9e,
-	0x1a, 0x6b, 0x0f, 0x0f, 0xdd, 0x80, 0x3f, 0x71, 0x0f, 0xed, 0x2e, 0x86, 0x18, 0xbb, 0x12, 0x7d,
-	0x8d, 0x76, 0xfc, 0x0f, 0x68, 0x5a, 0xf1, 0x34, 0xd6, 0x54, 0x5b, 0x83, 0xbc, 0xf5, 0x7c, 0x20,
-	0x38, 0xc4, 0x50, 0x0a, 0xfd, 0x48, 0x87, 0xd2, 0x67, 0x06, 0xdc, 0xf9, 0x20, 0xcd, 0xfb, 0x38,
-	0x46, 0x57, 0xa2, 0x83, 0x5f, 0x0e, 0x50, 0x48, 0xd2, 0x81, 0xac, 0x1e, 0xa6, 0xd1, 0x30, 0x9a,
-	0xab, 0x47, 0xef, 0x59, 0x13, 0x16, 0x2b, 0x63, 0x51, 0x8d, 0xcf, 0x3d, 0xdf, 0x1a, 0xb6, 0x2c,
-	0xde, 0xeb, 0x5a, 0x49, 0xe2, 0xd6, 0xb4, 0xc0, 0x2c, 0x71, 0xeb, 0x5d, 0xce, 0x35, 0x8f, 0x93,
-	0x01, 0x93, 0x4d, 0xa8, 0x0d, 0xb8, 0xc0, 0x58, 0x9a, 0x95, 0x86, 0xd1, 0xbc, 0xe9, 0xe8, 0x37,
-	0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x51, 0xd4, 0xc3, 0xf0, 0x11, 0x06, 0x38, 0x11, 0x66, 0x16, 0x85,
-	0xad, 0x4c, 0xe0, 0x08, 0x2c, 0xc5, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x6d, 0xb2, 0x01, 0x55,
-	0xe6, 0x4a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x27, 0x69, 0x92, 0x75, 0xa8, 0x30, 0xdf, 0x5c, 0x52,
-	0x31, 0x15, 0xe6, 0xd3, 0x9f, 0x8c, 0x22, 0x5b, 0xb1, 0x0c, 0xe5, 0x6c, 0x0d, 0x58, 0xf5, 0x51,
-	0x78, 0x31, 0xe3, 0x49, 0xa2, 0x9a, 0x74, 0xba, 0x2b, 0xd7, 0x53, 0x9d, 0xd2, 0xb3, 0x0d, 0x2b,
-	0x78, 0xce, 0x59, 0x8c, 0xe2, 0xfd, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x0e, 0xad, 0x6d, 0x39, 0xd7,
-	0xf6, 0x5a, 0x3e, 0x39, 0x4a, 0x9a, 0x83, 0x82, 0x47, 0xa1, 0x40, 0x72, 0x07, 0x96, 0x65, 0xd2,
-	0xa1, 0x35, 0xa5, 0x2f, 0x94, 0xc2, 0x9a, 0x8e, 0xfe, 0x70, 0x80, 0xf1, 0x28, 0xe1, 0x0f, 0xdd,
-	0x3e, 0xea, 0x20, 0xd5, 0xa6, 0x5f, 0xe5, 0x88, 0x1f, 0x73, 0xff, 0xff, 0x9d, 0x6e, 0xfa, 0x22,
-	0xbc, 0x70, 0xd2, 0xe7, 0x72, 0x94, 0xa5, 0x41, 0xf7, 0x60, 0xe3, 0x6c, 0x14, 0x7a, 0x9f, 0xb0,
-	0xd0, 0x8f, 0x9e, 0x8a, 0x72, 0xd1, 0x23, 0xb8, 0x3d, 0x15, 0x97, 0x57, 0xa1, 0x03, 0x37, 0x9e,
-	0xa6, 0x5d, 0xa6, 0xd1, 0xa8, 0x5e, 0x5f, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9, 0x39, 0x6c, 0xb6,
-	0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x63, 0x58, 0x66, 0x12, 0xfb, 0x0b, 0xe2, 0x9e,
-	0xaa, 0x57, 0x0a, 0x4b, 0x7f, 0xa9, 0x82, 0xf9, 0x08, 0xa5, 0xcb, 0x02, 0xf4, 0xe7, 0xc8, 0x39,
-	0xac, 0x77, 0x0b, 0xb2, 0x16, 0xae, 0x62, 0x06, 0x7f, 0x7a, 0x81, 0x54, 0xfe, 0x2b, 0x3f, 0x08,
-	0x60, 0x2d, 0x46, 0x1e, 0x09, 0x26, 0xa3, 0x98, 0xa1, 0x30, 0xab, 0x8b, 0xc8, 0xc9, 0xc9, 0x10,
-	0x47, 0x4e, 0x01, 0x9d, 0xb8, 0x70, 0xd3, 0x0b, 0x06, 0x42, 0x62, 0x2c, 0xcc, 0x25, 0xc5, 0x74,
-	0x72, 0x3d, 0xa6, 0xe3, 0x14, 0xcd, 0xc9, 0x61, 0xe9, 0x01, 0xdc, 0x3b, 0x65, 0x42, 0xea, 0x44,
-	0x4f, 0x59, 0xd8, 0x13, 0xd9, 0x86, 0xbb, 0x62, 0x9d, 0x1f, 0x3d, 0x5b, 0x83, 0x75, 0x1d, 0x7b,
-	0x86, 0xf1, 0x90, 0x79, 0x48, 0xbe, 0x37, 0x60, 0x35, 0x75, 0x24, 0xe5, 0x00, 0x84, 0x5a, 0xd9,
-	0xa1, 0x55, 0xea, 0x59, 0xf5, 0xfb, 0x57, 0xc6, 0xe4, 0xbb, 0xee, 0xcd, 0xaf, 0x7f, 0xff, 0xf3,
-	0xc7, 0xca, 0x11, 0x3d, 0x50, 0x07, 0xdc, 0xf0, 0x30, 0x3b, 0x06, 0x85, 0x3d, 0xd6, 0xad, 0x0b,
-	0x3b, 0xf1, 0x2a, 0x61, 0x8f, 0x93, 0xc7, 0x85, 0xad, 0xdc, 0xe5, 0x6d, 0x63, 0x9f, 0x7c, 0x6b,
-	0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x27, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6, 0xb8, 0xf7, 0xdf,
-	0x51, 0x2a, 0xde, 0xd8, 0x6f, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xf2, 0x82, 0xfc, 0x60, 0x40,
-	0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0x56, 0x29, 0x7d, 0x49, 0x09, 0xbe, 0x4b,
-	0x37, 0x66, 0x05, 0x27, 0x95, 0xf9, 0xc6, 0x80, 0xa5, 0x64, 0xa6, 0xc9, 0xdd, 0x59, 0x39, 0xca,
-	0xd5, 0xea, 0xa7, 0x8b, 0x92, 0x91, 0x90, 0x50, 0x53, 0x49, 0x21, 0x64, 0x4e, 0x0a, 0x39, 0x07,
-	0xd2, 0x46, 0x39, 0x63, 0x1b, 0x65, 0xa2, 0x5e, 0xce, 0xbb, 0xcb, 0x7c, 0x86, 0x36, 0x15, 0x13,
-	0x25, 0x8d, 0xf9, 0x59, 0x4a, 0x56, 0xec, 0x85, 0xed, 0xeb, 0x91, 0xe4, 0x3b, 0x03, 0xaa, 0x6d,
-	0x2c, 0xe5, 0x5a, 0xdc, 0x3c, 0xec, 0x2a, 0x49, 0x5b, 0xe4, 0x5e, 0x89, 0x24, 0x32, 0x86, 0x5b,
-	0x6d, 0x94, 0x45, 0xd7, 0x2e, 0x93, 0xb5, 0x9b, 0x77, 0x5f, 0xed, 0xf2, 0xd4, 0x52, 0x6c, 0x4d,
-	0xb2, 0x57, 0x56, 0x80, 0xd4, 0x26, 0xf3, 0x09, 0xf8, 0xd9, 0x80, 0x5a, 0x7a, 0xb2, 0xce, 0xaf,
-	0xcc, 0xc2, 0x89, 0xbb, 0xc0, 0x8a, 0xb4, 0x94, 0xc6, 0x83, 0x7a, 0xb3, 0x74, 0x2b, 0x59, 0x7d,
-	0x94, 0xae, 0xef, 0x4a, 0xd7, 0x52, 0xa2, 0x93, 0x15, 0xfb, 0x29, 0xd4, 0xd2, 0x8d, 0x5a, 0x56,
-	0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0xbf, 0x5f, 0x5a, 0xff, 0xc7, 0x00, 0xc9, 0x2a, 0x3d, 0x51, 0xd7,
-	0xcc, 0x32, 0xf4, 0x5b, 0x96, 0xbe, 0x86, 0xaa, 0x30, 0xb5, 0xaa, 0xf7, 0x14, 0x70, 0x83, 0xec,
-	0x94, 0x95, 0x3a, 0x1d, 0x41, 0xc6, 0x70, 0xbb, 0x8d, 0x72, 0xea, 0x42, 0x70, 0x26, 0x93, 0x72,
-	0x6f, 0xe5, 0x44, 0xb3, 0x77, 0x8a, 0xfa, 0xf6, 0x55, 0x9f, 0xf2, 0x84, 0x5e, 0x55, 0xbc, 0x0f,
-	0xc8, 0x2b, 0x65, 0xbc, 0x62, 0x14, 0x7a, 0xfa, 0x3e, 0x40, 0x38, 0xac, 0x24, 0x62, 0x95, 0x95,
-	0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf2, 0xf5, 0x7a, 0x61, 0xf2, 0xf4, 0x27, 0xcd, 0xfb, 0x40, 0xf1,
-	0xee, 0x92, 0xfb, 0x65, 0xbc, 0x41, 0x12, 0xfe, 0xf0, 0xe1, 0xaf, 0x97, 0x3b, 0xc6, 0x6f, 0x97,
-	0x3b, 0xc6, 0x1f, 0x97, 0x3b, 0xc6, 0x67, 0xaf, 0x3f, 0xdf, 0xdf, 0x87, 0x17, 0x30, 0x0c, 0xf3,
-	0x1f, 0x9c, 0x4e, 0x4d, 0x5d, 0xf6, 0x5b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x27, 0x88,
-	0x4d, 0x01, 0x0d, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0xe4, 0x44,
+	0x17, 0x95, 0xbb, 0x93, 0xce, 0xe4, 0x26, 0x93, 0x2f, 0x53, 0xc9, 0x64, 0x1c, 0x7f, 0xf9, 0x69,
+	0x8c, 0x12, 0xb5, 0xc2, 0xc4, 0x56, 0x92, 0x41, 0xe2, 0x67, 0xc5, 0x64, 0xa2, 0x80, 0x14, 0x8d,
+	0x06, 0x07, 0x04, 0x62, 0x31, 0xc8, 0xb1, 0x2f, 0x1d, 0x13, 0xb7, 0x5d, 0xb8, 0xaa, 0x7b, 0xd2,
+	0x44, 0xd9, 0x20, 0x01, 0x12, 0x0b, 0x16, 0xb0, 0x82, 0x07, 0x60, 0xcb, 0x33, 0xb0, 0x63, 0x89,
+	0x04, 0x0f, 0x80, 0x22, 0xb6, 0xbc, 0x03, 0xaa, 0x72, 0xd9, 0x6d, 0xa7, 0xe3, 0x99, 0xa0, 0xe9,
+	0x61, 0xd5, 0xe5, 0xea, 0x5b, 0xe7, 0x9c, 0x7b, 0xeb, 0xb8, 0xea, 0x1a, 0x96, 0x18, 0x26, 0x3d,
+	0x4c, 0x6c, 0x9a, 0xc4, 0x9f, 0xa2, 0xc7, 0xb3, 0x5f, 0x8b, 0x26, 0x31, 0x8f, 0xc9, 0x84, 0x7a,
+	0x34, 0x96, 0xda, 0x71, 0xdc, 0x0e, 0xd1, 0x76, 0x69, 0x60, 0xbb, 0x51, 0x14, 0x73, 0x97, 0x07,
+	0x71, 0xc4, 0xd2, 0x30, 0xe3, 0xa0, 0x1d, 0xf0, 0xe3, 0xee, 0x91, 0xe5, 0xc5, 0x1d, 0xdb, 0x4d,
+	0xda, 0xb1, 0x58, 0x25, 0x07, 0x9b, 0x9e, 0x6f, 0xf7, 0x76, 0x6c, 0x7a, 0xd2, 0x16, 0x2b, 0x99,
+	0xed, 0x52, 0x1a, 0x06, 0x9e, 0x5c, 0x6b, 0xf7, 0xb6, 0xdc, 0x90, 0x1e, 0xbb, 0x5b, 0x76, 0x1b,
+	0x23, 0x4c, 0x5c, 0x8e, 0xbe, 0x42, 0xdb, 0x7d, 0x06, 0x9a, 0x52, 0x5c, 0xc4, 0x2a, 0x8c, 0x15,
+	0xc8, 0xeb, 0xd7, 0x03, 0xc1, 0x1e, 0x46, 0x9c, 0xa9, 0x9f, 0x74, 0xa9, 0xf9, 0x9d, 0x06, 0xf3,
+	0x8f, 0xd2, 0xbc, 0x77, 0x13, 0x74, 0x39, 0x3a, 0xf8, 0x59, 0x17, 0x19, 0x27, 0x47, 0x90, 0xd5,
+	0x43, 0xd7, 0x9a, 0x5a, 0x6b, 0x6a, 0xfb, 0x6d, 0x6b, 0xc0, 0x62, 0x65, 0x2c, 0x72, 0xf0, 0xb1,
+	0xe7, 0x5b, 0xbd, 0x1d, 0x8b, 0x9e, 0xb4, 0x2d, 0x91, 0xb8, 0x55, 0x14, 0x98, 0x25, 0x6e, 0xbd,
+	0x45, 0xa9, 0xe2, 0x71, 0x32, 0x60, 0xb2, 0x00, 0x8d, 0x2e, 0x65, 0x98, 0x70, 0xbd, 0xd6, 0xd4,
+	0x5a, 0x37, 0x1c, 0xf5, 0x64, 0x9e, 0xc0, 0xa2, 0x8a, 0x7d, 0x2f, 0x3e, 0xc1, 0xe8, 0x01, 0x86,
+	0x38, 0x10, 0xa6, 0x97, 0x85, 0x4d, 0x0e, 0xe0, 0x08, 0x8c, 0x25, 0x71, 0x88, 0x12, 0x6c, 0xd2,
+	0x91, 0x63, 0x32, 0x0b, 0xf5, 0xc0, 0xe5, 0x7a, 0xbd, 0xa9, 0xb5, 0xea, 0x8e, 0x18, 0x92, 0x19,
+	0xa8, 0x05, 0xbe, 0x3e, 0x26, 0x63, 0x6a, 0x81, 0x6f, 0xfe, 0xa0, 0x95, 0xd9, 0xca, 0x65, 0xa8,
+	0x66, 0x6b, 0xc2, 0x94, 0x8f, 0xcc, 0x4b, 0x02, 0x2a, 0x12, 0x55, 0xa4, 0xc5, 0xa9, 0x5c, 0x4f,
+	0xbd, 0xa0, 0x67, 0x09, 0x26, 0xf1, 0x94, 0x06, 0x09, 0xb2, 0x77, 0x22, 0x29, 0xa2, 0xee, 0x0c,
+	0x26, 0x94, 0xb6, 0xf1, 0x5c, 0xdb, 0x5d, 0x98, 0x2f, 0x4a, 0x73, 0x90, 0xd1, 0x38, 0x62, 0x48,
+	0xe6, 0x61, 0x9c, 0x8b, 0x09, 0xa5, 0x29, 0x7d, 0x30, 0x4d, 0x98, 0x56, 0xd1, 0xef, 0x76, 0x31,
+	0xe9, 0x0b, 0xfe, 0xc8, 0xed, 0xa0, 0x0a, 0x92, 0x63, 0xf3, 0xf3, 0x1c, 0xf1, 0x7d, 0xea, 0xff,
+	0xb7, 0xdb, 0x6d, 0xfe, 0x0f, 0x6e, 0xee, 0x75, 0x28, 0xef, 0x67, 0x69, 0x98, 0xeb, 0x30, 0x7b,
+	0xd8, 0x8f, 0xbc, 0x0f, 0x82, 0xc8, 0x8f, 0x9f, 0xb0, 0x6a, 0xd1, 0x7d, 0x98, 0x2b, 0xc4, 0xe5,
+	0x55, 0x38, 0x82, 0x89, 0x27, 0xe9, 0x94, 0xae, 0x35, 0xeb, 0xcf, 0xaf, 0x79, 0xc0, 0xe1, 0x64,
+	0xc0, 0xe6, 0x1f, 0x1a, 0x2c, 0x15, 0xb8, 0x0f, 0x83, 0x4e, 0x37, 0x94, 0xcb, 0xb2, 0xc2, 0x5d,
+	0xa1, 0x57, 0x58, 0xa3, 0x40, 0x90, 0x59, 0xa3, 0x30, 0x45, 0x4c, 0x98, 0x76, 0x29, 0x7d, 0xe8,
+	0x76, 0x90, 0x51, 0xd7, 0xcb, 0x2c, 0x52, 0x9a, 0x13, 0x56, 0x89, 0xf2, 0x80, 0xd4, 0xaf, 0x83,
+	0x09, 0x61, 0x4c, 0x2f, 0xec, 0x32, 0x8e, 0x89, 0xf2, 0x4b, 0xf6, 0x28, 0x14, 0x7d, 0x92, 0xc4,
+	0x1d, 0xbd, 0x91, 0x2a, 0x12, 0x63, 0x61, 0x2c, 0x1e, 0xeb, 0x13, 0xa9, 0xb1, 0x78, 0x6c, 0xfe,
+	0xad, 0xc1, 0x72, 0x45, 0x5a, 0xaa, 0xb8, 0xc7, 0x30, 0x41, 0x31, 0x09, 0x62, 0x3f, 0x2b, 0xee,
+	0xc3, 0x51, 0x15, 0xf7, 0x91, 0x84, 0x75, 0x32, 0xf8, 0xe2, 0x36, 0xd6, 0x5e, 0xd4, 0x36, 0x9e,
+	0xc2, 0xc2, 0x7e, 0x18, 0x1f, 0xb9, 0xa1, 0x32, 0xe5, 0xc0, 0x44, 0x8f, 0x61, 0x3c, 0xe0, 0xd8,
+	0x19, 0x91, 0x85, 0x0a, 0xb6, 0x4f, 0x61, 0xcd, 0x5f, 0xea, 0xa0, 0x3f, 0x40, 0xee, 0x06, 0x21,
+	0xfa, 0x43, 0xe4, 0x14, 0x66, 0xda, 0x25, 0x59, 0x23, 0x57, 0x71, 0x09, 0xbf, 0xf8, 0x9e, 0xd7,
+	0x5e, 0xd4, 0xb1, 0x1e, 0xc2, 0x74, 0x82, 0x34, 0x66, 0x01, 0x8f, 0x93, 0x00, 0x99, 0x5e, 0x1f,
+	0x45, 0x4e, 0x4e, 0x86, 0xd8, 0x77, 0x4a, 0xe8, 0xc4, 0x85, 0x1b, 0xca, 0xf9, 0x4c, 0x1f, 0x93,
+	0x4c, 0x7b, 0xcf, 0xc7, 0xb4, 0x9b, 0xa2, 0x39, 0x39, 0xac, 0xb9, 0x09, 0x77, 0x0e, 0x02, 0xc6,
+	0x55, 0xa2, 0x07, 0x41, 0x74, 0xc2, 0x9e, 0xf2, 0xfa, 0x6f, 0xff, 0x7c, 0x13, 0x66, 0x54, 0xec,
+	0x21, 0x26, 0xbd, 0xc0, 0x43, 0xf2, 0x8d, 0x06, 0x53, 0xe9, 0xc5, 0x22, 0x0f, 0x72, 0x62, 0x5a,
+	0x59, 0xef, 0x51, 0x79, 0xf5, 0x18, 0xcb, 0x57, 0xc6, 0xe4, 0x87, 0xe7, 0x6b, 0x5f, 0xfc, 0xfe,
+	0xd7, 0xf7, 0xb5, 0x6d, 0x73, 0x53, 0xf6, 0x29, 0xbd, 0xad, 0xac, 0x9b, 0x61, 0xf6, 0x99, 0x1a,
+	0x9d, 0xdb, 0xe2, 0xca, 0x61, 0xf6, 0x99, 0xf8, 0x39, 0xb7, 0xe5, 0x25, 0xf1, 0x86, 0xb6, 0x41,
+	0xbe, 0xd2, 0x60, 0x2a, 0xbd, 0x53, 0x9f, 0x26, 0xa6, 0x74, 0xeb, 0x1a, 0x0b, 0x79, 0x4c, 0xf9,
+	0x08, 0x7f, 0x53, 0xaa, 0x78, 0x75, 0x63, 0xe7, 0x5f, 0xa9, 0xb0, 0xcf, 0x02, 0x97, 0x9f, 0x93,
+	0x6f, 0x35, 0x68, 0xa4, 0x39, 0x93, 0xa1, 0x64, 0xcb, 0xb5, 0x18, 0x99, 0x4b, 0xcd, 0xff, 0x4b,
+	0xc1, 0xb7, 0xcd, 0xd9, 0xcb, 0x82, 0x45, 0x65, 0xbe, 0xd4, 0x60, 0x4c, 0xec, 0x34, 0xb9, 0x7d,
+	0x59, 0x8e, 0xbc, 0x9c, 0x8c, 0x83, 0x51, 0xc9, 0x10, 0x24, 0xa6, 0x2e, 0xa5, 0x10, 0x32, 0x24,
+	0x85, 0x9c, 0x02, 0xd9, 0x47, 0x7e, 0xe9, 0xd8, 0xa8, 0x12, 0xf5, 0x52, 0x3e, 0x5d, 0x75, 0xce,
+	0x98, 0x2d, 0xc9, 0x64, 0x92, 0xe6, 0xf0, 0x2e, 0x09, 0xc7, 0x9e, 0xdb, 0xbe, 0x5a, 0x49, 0xbe,
+	0xd6, 0xa0, 0xbe, 0x8f, 0x95, 0x5c, 0xa3, 0xdb, 0x87, 0x55, 0x29, 0x69, 0x91, 0xdc, 0xa9, 0x90,
+	0x44, 0xce, 0xe0, 0xd6, 0x3e, 0xf2, 0xf2, 0xa9, 0x5d, 0x25, 0x6b, 0x35, 0x9f, 0xbe, 0xfa, 0x94,
+	0x37, 0x2d, 0xc9, 0xd6, 0x22, 0xeb, 0x55, 0x05, 0x48, 0x8f, 0xc9, 0x7c, 0x03, 0x7e, 0xd2, 0xa0,
+	0x91, 0x36, 0x48, 0xc3, 0xce, 0x2c, 0x35, 0x4e, 0x23, 0xac, 0xc8, 0x8e, 0xd4, 0xb8, 0x69, 0xb4,
+	0x2a, 0x5f, 0x25, 0xab, 0x83, 0xdc, 0xf5, 0x5d, 0xee, 0x5a, 0x52, 0xb4, 0x70, 0xec, 0x87, 0xd0,
+	0x48, 0x5f, 0xd4, 0xaa, 0xd2, 0x54, 0xbd, 0xb8, 0xaa, 0xfe, 0x1b, 0x95, 0xf5, 0x7f, 0x0c, 0x20,
+	0x5c, 0xba, 0x27, 0xbf, 0x16, 0xaa, 0xd0, 0x6f, 0x59, 0xea, 0x6b, 0x42, 0x86, 0x49, 0x57, 0xaf,
+	0x4b, 0xe0, 0x26, 0x59, 0xa9, 0x2a, 0x75, 0xba, 0x82, 0x9c, 0xc1, 0xdc, 0x3e, 0xf2, 0x62, 0x13,
+	0xc2, 0x45, 0xb9, 0x17, 0x73, 0xa2, 0xcb, 0xad, 0xa1, 0xb1, 0x74, 0xd5, 0x5f, 0x79, 0x42, 0xaf,
+	0x48, 0xde, 0x35, 0xf2, 0x72, 0x15, 0x2f, 0xeb, 0x47, 0x9e, 0xea, 0x07, 0xc8, 0x8f, 0x1a, 0xcc,
+	0xa9, 0xa6, 0x07, 0x0b, 0x60, 0x64, 0xed, 0x2a, 0x8a, 0xa1, 0xa6, 0xcf, 0x58, 0x7f, 0x56, 0x98,
+	0xd2, 0x74, 0x4f, 0x6a, 0xb2, 0xc8, 0xdd, 0x6b, 0x68, 0xb2, 0x99, 0xd2, 0x43, 0x28, 0x4c, 0x8a,
+	0x4a, 0xca, 0x7b, 0x86, 0x34, 0x73, 0xaa, 0x8a, 0x2b, 0xc8, 0x30, 0x4a, 0xce, 0x52, 0x7f, 0x29,
+	0x01, 0x6b, 0x52, 0xc0, 0x2a, 0x59, 0xae, 0x12, 0x10, 0x8a, 0xf0, 0xfb, 0xf7, 0x7f, 0xbd, 0x58,
+	0xd1, 0x7e, 0xbb, 0x58, 0xd1, 0xfe, 0xbc, 0x58, 0xd1, 0x3e, 0xba, 0x77, 0xbd, 0x2f, 0x5c, 0x2f,
+	0x0c, 0x30, 0xca, 0x3f, 0xa2, 0x8f, 0x1a, 0xf2, 0x83, 0x72, 0xe7, 0x9f, 0x01, 0x00, 0xb4, 0x9a,
+	0xaf, 0xb6, 0x65, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -802,6 +975,9 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*events.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// SimulateSyncWindows returns the periods of a time range during which the sync windows of a project allow or deny
+	// manual and automated syncs
+	SimulateSyncWindows(ctx context.Context, in *SyncWindowsSimulationRequest, opts ...grpc.CallOption) (*SyncWindowsSimulationResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
 }
@@ -913,6 +1089,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) SimulateSyncWindows(ctx context.Context, in *SyncWindowsSimulationRequest, opts ...grpc.CallOption) (*SyncWindowsSimulationResponse, error) {
+	out := new(SyncWindowsSimulationResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/SimulateSyncWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error) {
 	out := new(application.LinksResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListLinks", in, out, opts...)
@@ -946,6 +1131,9 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*events.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// SimulateSyncWindows returns the periods of a time range during which the sync windows of a project allow or deny
+	// manual and automated syncs
+	SimulateSyncWindows(context.Context, *SyncWindowsSimulationRequest) (*SyncWindowsSimulationResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
 }
@@ -987,6 +1175,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) SimulateSyncWindows(ctx context.Context, req *SyncWindowsSimulationRequest) (*SyncWindowsSimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSyncWindows not implemented")
+}
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
@@ -1193,6 +1384,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_SimulateSyncWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncWindowsSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SimulateSyncWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/SimulateSyncWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SimulateSyncWindows(ctx, req.(*SyncWindowsSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectLinksRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1468,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "SimulateSyncWindows",
+			Handler:    _ProjectService_SimulateSyncWindows_Handler,
+		},
 		{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
@@ -1639,6 +1852,137 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowsSimulationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowsSimulationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowsSimulationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AppNamespace) > 0 {
+		i -= len(m.AppNamespace)
+		copy(dAtA[i:], m.AppNamespace)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Application) > 0 {
+		i -= len(m.Application)
+		copy(dAtA[i:], m.Application)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Application)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowsSimulationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowsSimulationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowsSimulationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GlobalProjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1977,17 +2321,39 @@ func (m *SyncWindowsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GlobalProjectsResponse) Size() (n int) {
+func (m *SyncWindowsSimulationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovProject(uint64(l))
-		}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Application)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.AppNamespace)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1995,24 +2361,66 @@ func (m *GlobalProjectsResponse) Size() (n int) {
 	return n
 }
 
-func (m *DetailedProjectsResponse) Size() (n int) {
+func (m *SyncWindowsSimulationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.GlobalProjects) > 0 {
-		for _, e := range m.GlobalProjects {
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
 			l = e.Size()
 			n += 1 + l + sovProject(uint64(l))
 		}
 	}
-	if m.Project != nil {
-		l = m.Project.Size()
-		n += 1 + l + sovProject(uint64(l))
-	}
-	if len(m.Repositories) > 0 {
-		for _, e := range m.Repositories {
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobalProjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetailedProjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalProjects) > 0 {
+		for _, e := range m.GlobalProjects {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.Project != nil {
+		l = m.Project.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Repositories) > 0 {
+		for _, e := range m.Repositories {
 			l = e.Size()
 			n += 1 + l + sovProject(uint64(l))
 		}
@@ -2994,6 +3402,400 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWindowsSimulationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowsSimulationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowsSimulationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowsSimulationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowsSimulationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowsSimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, &v1alpha1.SyncWindowPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, &v1alpha1.SyncWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
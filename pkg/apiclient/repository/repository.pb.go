@@ -793,99 +793,99 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5f, 0x6f, 0x13, 0xc7,
-	0x16, 0xd7, 0x26, 0x24, 0x24, 0x13, 0x02, 0x66, 0x92, 0xc0, 0x62, 0x42, 0x08, 0x0b, 0x37, 0x0a,
-	0x11, 0xac, 0x49, 0xb8, 0x57, 0x17, 0x71, 0x75, 0x2b, 0x39, 0x7f, 0x0a, 0x56, 0xa3, 0x86, 0x1a,
-	0x28, 0x52, 0xd5, 0xaa, 0x9a, 0xac, 0x4f, 0xec, 0x25, 0x9b, 0xdd, 0x61, 0x66, 0x6c, 0x70, 0x11,
-	0x2f, 0x55, 0x55, 0x55, 0x6a, 0x5f, 0xaa, 0xaa, 0x55, 0xdf, 0xda, 0x87, 0x4a, 0x95, 0xda, 0xf7,
-	0x7e, 0x86, 0x3e, 0xb6, 0xea, 0x17, 0xa8, 0x50, 0x3f, 0x44, 0x1f, 0xab, 0x39, 0xb3, 0x5e, 0xaf,
-	0x13, 0x7b, 0x9d, 0x88, 0x90, 0xb7, 0x9d, 0x73, 0x8e, 0xcf, 0xef, 0x77, 0x7e, 0x73, 0xf6, 0xcc,
-	0x78, 0x89, 0x23, 0x41, 0x34, 0x40, 0x14, 0x04, 0xf0, 0x48, 0xfa, 0x2a, 0x12, 0xcd, 0xd4, 0xa3,
-	0xcb, 0x45, 0xa4, 0x22, 0x4a, 0xda, 0x96, 0xfc, 0x74, 0x35, 0x8a, 0xaa, 0x01, 0x14, 0x18, 0xf7,
-	0x0b, 0x2c, 0x0c, 0x23, 0xc5, 0x94, 0x1f, 0x85, 0xd2, 0x44, 0xe6, 0xd7, 0xab, 0xbe, 0xaa, 0xd5,
-	0x37, 0x5d, 0x2f, 0xda, 0x29, 0x30, 0x51, 0x8d, 0xb8, 0x88, 0x1e, 0xe3, 0xc3, 0x75, 0xaf, 0x52,
-	0x68, 0xdc, 0x2c, 0xf0, 0xed, 0xaa, 0xfe, 0xa5, 0x2c, 0x30, 0xce, 0x03, 0xdf, 0xc3, 0xdf, 0x16,
-	0x1a, 0x8b, 0x2c, 0xe0, 0x35, 0xb6, 0x58, 0xa8, 0x42, 0x08, 0x82, 0x29, 0xa8, 0xc4, 0xd9, 0xd6,
-	0xfa, 0x64, 0x43, 0x5a, 0x7d, 0xe9, 0x3b, 0x4d, 0x32, 0x5e, 0x06, 0x1e, 0x15, 0x39, 0x97, 0xef,
-	0xd4, 0x41, 0x34, 0x29, 0x25, 0xc7, 0x74, 0x90, 0x6d, 0xcd, 0x5a, 0xf3, 0xa3, 0x65, 0x7c, 0xa6,
-	0x79, 0x32, 0x22, 0xa0, 0xe1, 0x4b, 0x3f, 0x0a, 0xed, 0x01, 0xb4, 0x27, 0x6b, 0x6a, 0x93, 0xe3,
-	0x8c, 0xf3, 0xb7, 0xd9, 0x0e, 0xd8, 0x83, 0xe8, 0x6a, 0x2d, 0xe9, 0x0c, 0x21, 0x8c, 0xf3, 0x7b,
-	0x22, 0x7a, 0x0c, 0x9e, 0xb2, 0x8f, 0xa1, 0x33, 0x65, 0x71, 0x16, 0xc9, 0xf1, 0x22, 0xe7, 0xa5,
-	0x70, 0x2b, 0xd2, 0xa0, 0xaa, 0xc9, 0xa1, 0x05, 0xaa, 0x9f, 0xb5, 0x8d, 0x33, 0x55, 0x8b, 0x01,
-	0xf1, 0xd9, 0xf9, 0xdb, 0x22, 0x13, 0x31, 0xdd, 0x55, 0x50, 0xcc, 0x0f, 0x62, 0xd2, 0x55, 0x32,
-	0x2c, 0xa3, 0xba, 0xf0, 0x4c, 0x86, 0xb1, 0xa5, 0x0d, 0xb7, 0xad, 0x8e, 0xdb, 0x52, 0x07, 0x1f,
-	0x3e, 0xf4, 0x2a, 0x6e, 0xe3, 0xa6, 0xcb, 0xb7, 0xab, 0xae, 0xd6, 0xda, 0x4d, 0x69, 0xed, 0xb6,
-	0xb4, 0x76, 0x8b, 0x6d, 0xe3, 0x7d, 0x4c, 0x5b, 0x8e, 0xd3, 0xa7, 0xab, 0x1d, 0xc8, 0xaa, 0x76,
-	0x70, 0x77, 0xb5, 0x74, 0x96, 0x8c, 0x99, 0x1c, 0xa5, 0xb0, 0x02, 0xcf, 0x50, 0x8e, 0xa1, 0x72,
-	0xda, 0x44, 0xa7, 0xc9, 0x68, 0x03, 0x84, 0x16, 0xb5, 0x54, 0xb1, 0x87, 0xd0, 0xdf, 0x36, 0x38,
-	0xff, 0x27, 0xb9, 0xd6, 0x46, 0x95, 0x41, 0xf2, 0x28, 0x94, 0x40, 0xaf, 0x92, 0x21, 0x5f, 0xc1,
-	0x8e, 0xb4, 0xad, 0xd9, 0xc1, 0xf9, 0xb1, 0xa5, 0x09, 0x37, 0xb5, 0xbd, 0xb1, 0xb4, 0x65, 0x13,
-	0xe1, 0x78, 0x64, 0x54, 0xff, 0xbc, 0xf7, 0x1e, 0x3b, 0xe4, 0xc4, 0x56, 0xa4, 0x4b, 0x85, 0x2d,
-	0x01, 0xd2, 0xc8, 0x3e, 0x52, 0xee, 0xb0, 0xf5, 0xab, 0xd1, 0xf9, 0x7d, 0x84, 0x9c, 0x42, 0x92,
-	0x9e, 0x07, 0x32, 0xbb, 0x9f, 0xea, 0x12, 0x44, 0xd8, 0x96, 0x31, 0x59, 0x6b, 0x1f, 0x67, 0x52,
-	0x3e, 0x8d, 0x44, 0x25, 0x46, 0x48, 0xd6, 0xf4, 0x0a, 0x19, 0x97, 0xb2, 0x76, 0x4f, 0xf8, 0x0d,
-	0xa6, 0xe0, 0x2d, 0x68, 0xc6, 0x4d, 0xd5, 0x69, 0xd4, 0x19, 0xfc, 0x50, 0x82, 0x57, 0x17, 0x80,
-	0x32, 0x8e, 0x94, 0x93, 0x35, 0xbd, 0x46, 0x4e, 0xab, 0x40, 0xae, 0x04, 0x3e, 0x84, 0x6a, 0x05,
-	0x84, 0x5a, 0x65, 0x8a, 0xd9, 0xc3, 0x98, 0x65, 0xaf, 0x83, 0x2e, 0x90, 0x5c, 0x87, 0x51, 0x43,
-	0x1e, 0xc7, 0xe0, 0x3d, 0xf6, 0xa4, 0x85, 0x47, 0x3b, 0x5b, 0x18, 0x6b, 0x24, 0xc6, 0x86, 0xf5,
-	0x4d, 0x93, 0x51, 0x08, 0xd9, 0x66, 0x00, 0x1b, 0x9e, 0x6f, 0x8f, 0x21, 0xbd, 0xb6, 0x81, 0xde,
-	0x20, 0x13, 0xa6, 0x73, 0x8b, 0x5a, 0xd5, 0xa4, 0xce, 0x13, 0x98, 0xa0, 0x9b, 0x4b, 0xf7, 0x55,
-	0x62, 0x2e, 0xad, 0xda, 0xe3, 0xb3, 0xd6, 0xfc, 0x60, 0x39, 0x6d, 0xa2, 0xb7, 0xc8, 0xd9, 0xf6,
-	0x32, 0x94, 0x8a, 0x05, 0x01, 0xb6, 0x76, 0x69, 0xd5, 0x3e, 0x89, 0xd1, 0xbd, 0xdc, 0xf4, 0x0d,
-	0x92, 0x4f, 0x5c, 0x6b, 0xa1, 0x02, 0xc1, 0x85, 0x2f, 0x61, 0x99, 0x49, 0x78, 0x28, 0x02, 0xfb,
-	0x14, 0x92, 0xca, 0x88, 0xa0, 0x93, 0x64, 0x88, 0x8b, 0xe8, 0x59, 0xd3, 0xce, 0x61, 0xa8, 0x59,
-	0xe8, 0x77, 0x88, 0xc7, 0x2d, 0x74, 0xda, 0xbc, 0x43, 0xf1, 0x92, 0x2e, 0x91, 0xc9, 0xaa, 0xc7,
-	0xef, 0x83, 0x68, 0xf8, 0x1e, 0x14, 0x3d, 0x2f, 0xaa, 0x87, 0xa8, 0x39, 0xc5, 0xb0, 0xae, 0x3e,
-	0xea, 0x12, 0x8a, 0x3d, 0x7a, 0x57, 0x29, 0xbe, 0xcc, 0xa4, 0xef, 0x15, 0xeb, 0xaa, 0x66, 0x4f,
-	0xa0, 0xb0, 0x5d, 0x3c, 0xf4, 0x36, 0xb1, 0xeb, 0x12, 0x8a, 0x1f, 0xd5, 0x05, 0x3c, 0x8a, 0xc4,
-	0x76, 0x10, 0xb1, 0x4a, 0xa9, 0x02, 0xa1, 0xf2, 0x55, 0xd3, 0x9e, 0xc4, 0x5f, 0xf5, 0xf4, 0x6b,
-	0xad, 0x37, 0x81, 0x09, 0x10, 0x0f, 0xa2, 0x6d, 0x08, 0xed, 0x29, 0xa4, 0x95, 0x36, 0xe9, 0x0a,
-	0x5a, 0xbd, 0xb6, 0xe1, 0xf9, 0x6f, 0xb6, 0xe0, 0xed, 0x33, 0x98, 0xb9, 0xab, 0x8f, 0xae, 0x92,
-	0x0b, 0x4c, 0xc3, 0xc5, 0xb5, 0xdd, 0x13, 0x7e, 0xe8, 0xf9, 0x9c, 0x05, 0xa6, 0xbf, 0x4a, 0x15,
-	0xfb, 0x2c, 0xe2, 0x64, 0x07, 0xd1, 0x75, 0x72, 0x29, 0x23, 0xe0, 0x3e, 0x78, 0x02, 0x94, 0x6d,
-	0x63, 0xa6, 0xfe, 0x81, 0x3d, 0x39, 0x3d, 0x80, 0x90, 0x21, 0xa7, 0x73, 0x19, 0x9c, 0x5a, 0x41,
-	0x74, 0x99, 0x4c, 0x63, 0x40, 0xd1, 0x53, 0x7e, 0x03, 0x56, 0x7d, 0x01, 0x9e, 0x9e, 0x4d, 0x6b,
-	0x61, 0x85, 0x47, 0x7e, 0xa8, 0xec, 0x3c, 0x26, 0xc9, 0x8c, 0x71, 0x4e, 0x92, 0x13, 0x7a, 0xa4,
-	0xb4, 0x66, 0x9e, 0xf3, 0xa3, 0x45, 0x4e, 0x6b, 0xc3, 0x8a, 0x00, 0xa6, 0xa0, 0x0c, 0x4f, 0xea,
-	0x20, 0x15, 0x7d, 0x3f, 0x35, 0x65, 0xc6, 0x96, 0xee, 0xbe, 0xda, 0xf8, 0x2f, 0x27, 0x53, 0x34,
-	0x9e, 0x57, 0x67, 0xc8, 0x70, 0x9d, 0x4b, 0x10, 0x2a, 0x9e, 0x8a, 0xf1, 0x4a, 0xbf, 0xcb, 0x9e,
-	0x80, 0x8a, 0xdc, 0x08, 0x83, 0x26, 0x0e, 0xab, 0x91, 0x72, 0xdb, 0xe0, 0x3c, 0x31, 0x44, 0x1f,
-	0xf2, 0xca, 0x51, 0x11, 0x5d, 0xfa, 0xe4, 0xac, 0xc1, 0x34, 0xc6, 0x78, 0x5f, 0xe8, 0x17, 0x16,
-	0x39, 0xb6, 0xee, 0x4b, 0x45, 0xa7, 0xd2, 0x07, 0x44, 0x72, 0x1c, 0xe4, 0xd7, 0x0f, 0x8b, 0x85,
-	0x06, 0x71, 0x2e, 0x7e, 0xfc, 0xc7, 0x5f, 0x5f, 0x0d, 0x9c, 0xa1, 0x93, 0x78, 0x0d, 0x6a, 0x2c,
-	0xb6, 0xef, 0x1c, 0x3e, 0xc8, 0xcf, 0x06, 0x2c, 0xfa, 0xb9, 0x45, 0x06, 0xef, 0x40, 0x4f, 0x36,
-	0x87, 0xa6, 0x89, 0x73, 0x19, 0x99, 0x5c, 0xa0, 0xe7, 0xbb, 0x31, 0x29, 0x3c, 0xd7, 0xab, 0x17,
-	0xf4, 0x1b, 0x8b, 0x8c, 0xdc, 0x01, 0xf5, 0x48, 0xf8, 0x0a, 0x5e, 0x3f, 0xa5, 0xab, 0x48, 0xe9,
-	0x32, 0xbd, 0xd4, 0xa2, 0xf4, 0x54, 0xe3, 0x5e, 0xef, 0x46, 0xec, 0x6b, 0x8b, 0xe4, 0xb4, 0xa0,
-	0xe5, 0x94, 0xef, 0x68, 0x76, 0x70, 0x3a, 0x6b, 0x07, 0xe9, 0xf7, 0x16, 0x99, 0xd2, 0x61, 0xa8,
-	0xd8, 0xd1, 0x93, 0x73, 0x90, 0xdc, 0x34, 0xcd, 0xf7, 0x56, 0x90, 0x7e, 0x40, 0x46, 0x8c, 0x72,
-	0x5b, 0x3d, 0x49, 0xe5, 0x3a, 0xcd, 0x5b, 0xd2, 0x99, 0xc7, 0xc4, 0x0e, 0x9d, 0xcd, 0xe8, 0x96,
-	0x82, 0xd0, 0x29, 0x2b, 0x64, 0x4c, 0xa7, 0xdf, 0x58, 0x29, 0x3d, 0x60, 0xd5, 0x03, 0x20, 0x5c,
-	0x43, 0x84, 0x39, 0x7a, 0x25, 0x0b, 0x21, 0xf2, 0xfc, 0xeb, 0x4a, 0xa7, 0xdd, 0x31, 0x45, 0xe8,
-	0x0b, 0x1f, 0x3d, 0xb7, 0x1b, 0x22, 0xb9, 0xaf, 0xe7, 0xa7, 0xbb, 0xb9, 0x92, 0x69, 0xb9, 0xaf,
-	0xa2, 0x98, 0x86, 0xf8, 0xd2, 0x22, 0xe3, 0x77, 0x40, 0xb5, 0x6f, 0xd6, 0xf4, 0x62, 0x97, 0xcc,
-	0xe9, 0x5b, 0x77, 0xde, 0xe9, 0x1d, 0x90, 0x10, 0xf8, 0x1f, 0x12, 0xf8, 0x8f, 0x73, 0xa3, 0x3b,
-	0x01, 0x73, 0xff, 0xc5, 0x3c, 0x0f, 0xcb, 0xeb, 0x48, 0xa5, 0x62, 0x32, 0xdc, 0xb6, 0x16, 0x68,
-	0x03, 0x29, 0xdd, 0x85, 0x60, 0x67, 0xa5, 0xc6, 0x84, 0xea, 0x29, 0xf5, 0x4c, 0xda, 0xdc, 0x0e,
-	0x4f, 0x48, 0xb8, 0x48, 0x62, 0x9e, 0xce, 0x65, 0xa9, 0x50, 0x83, 0x60, 0xc7, 0x33, 0x30, 0xdf,
-	0x5a, 0x64, 0xd8, 0x9c, 0x2f, 0xf4, 0xc2, 0x6e, 0xc4, 0x8e, 0x73, 0xe7, 0x10, 0x27, 0xc3, 0xbf,
-	0x4c, 0x5f, 0x3b, 0x5d, 0x5f, 0xba, 0xdb, 0x38, 0xde, 0xf5, 0xf0, 0xfc, 0xce, 0x22, 0xb9, 0x16,
-	0x85, 0xd6, 0x6f, 0x8f, 0x8e, 0xa4, 0xd3, 0x9f, 0x24, 0xfd, 0xc9, 0x22, 0x53, 0x06, 0xbf, 0x73,
-	0x42, 0x1c, 0x21, 0xcd, 0xb8, 0xeb, 0x9d, 0x8c, 0x19, 0x11, 0x93, 0xfd, 0xc1, 0x22, 0xc3, 0xe6,
-	0x80, 0xde, 0xcb, 0xae, 0xe3, 0xe0, 0x3e, 0x44, 0x76, 0x8b, 0xa6, 0x1b, 0xf3, 0x19, 0xef, 0x24,
-	0x52, 0x79, 0xd1, 0xde, 0xf5, 0x9f, 0x2d, 0x92, 0x6b, 0xd1, 0xe9, 0x2d, 0xe7, 0xeb, 0x22, 0xec,
-	0x1e, 0x8c, 0x30, 0xfd, 0xc5, 0x22, 0x53, 0x86, 0x4b, 0xdf, 0x0e, 0x78, 0x5d, 0x94, 0xff, 0x8d,
-	0x94, 0xdd, 0xfc, 0x5c, 0xbf, 0x73, 0xb6, 0x83, 0x38, 0x23, 0xc3, 0xab, 0x10, 0x40, 0xef, 0x8b,
-	0x80, 0xbd, 0xdb, 0x9c, 0x8c, 0x98, 0x39, 0x73, 0xd7, 0x58, 0xc8, 0xba, 0x6b, 0xe8, 0x9d, 0xac,
-	0x91, 0x9c, 0x81, 0x48, 0xa9, 0x72, 0x60, 0xb0, 0xcb, 0xfb, 0x00, 0xa3, 0x92, 0x4c, 0x19, 0xa4,
-	0xdd, 0x9b, 0x70, 0x60, 0xb8, 0xf8, 0xd2, 0xb2, 0xb0, 0x8f, 0x4b, 0xcb, 0x73, 0x72, 0xf2, 0x5d,
-	0x16, 0xf8, 0x7a, 0x53, 0xcd, 0x47, 0x00, 0x7a, 0x7e, 0xcf, 0x21, 0xd1, 0xfe, 0x38, 0x90, 0x81,
-	0xb9, 0x84, 0x98, 0xd7, 0x9c, 0xcc, 0xb3, 0xb2, 0x11, 0x43, 0xc5, 0xdb, 0xf7, 0xa9, 0x45, 0x26,
-	0x5a, 0xe8, 0x58, 0xf4, 0xab, 0x51, 0xb8, 0x85, 0x14, 0x96, 0x9c, 0x85, 0xbe, 0x65, 0xef, 0x22,
-	0xb2, 0xbc, 0xf6, 0xeb, 0xcb, 0x19, 0xeb, 0xb7, 0x97, 0x33, 0xd6, 0x9f, 0x2f, 0x67, 0xac, 0xf7,
-	0xfe, 0xbb, 0xbf, 0xef, 0x7e, 0x1e, 0xfe, 0xff, 0x4a, 0x7d, 0xa1, 0xdb, 0x1c, 0xc6, 0x4f, 0x74,
-	0x37, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xff, 0x54, 0xba, 0x9b, 0x87, 0x14, 0x00, 0x00,
+	// 1466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x51, 0x6f, 0x1b, 0xc5,
+	0x13, 0xd7, 0x25, 0x4d, 0x9a, 0x6c, 0x9a, 0xd6, 0xd9, 0x24, 0xed, 0xd5, 0x4d, 0xd3, 0xf4, 0xda,
+	0x7f, 0x94, 0x46, 0xed, 0xb9, 0x49, 0xff, 0x88, 0xaa, 0x08, 0x24, 0x27, 0x0e, 0xad, 0x45, 0x44,
+	0x8a, 0xdb, 0x52, 0x09, 0x81, 0xd0, 0xe6, 0x3c, 0xb1, 0xb7, 0xb9, 0xdc, 0x6d, 0x77, 0xd7, 0x6e,
+	0x4d, 0xd5, 0x17, 0x84, 0x10, 0x12, 0xbc, 0x20, 0x04, 0xe2, 0x0d, 0x1e, 0x90, 0x90, 0xe0, 0x9d,
+	0xcf, 0xc0, 0x23, 0x88, 0x2f, 0x80, 0x2a, 0x3e, 0x04, 0x8f, 0x68, 0xe7, 0xce, 0x3e, 0x3b, 0xb1,
+	0xcf, 0x89, 0x9a, 0xe6, 0xed, 0x76, 0x66, 0x6e, 0x7e, 0xbf, 0xf9, 0xed, 0xdc, 0xec, 0xda, 0xc4,
+	0x51, 0x20, 0xeb, 0x20, 0x73, 0x12, 0x44, 0xa8, 0xb8, 0x0e, 0x65, 0xa3, 0xed, 0xd1, 0x15, 0x32,
+	0xd4, 0x21, 0x25, 0x89, 0x25, 0x3b, 0x53, 0x09, 0xc3, 0x8a, 0x0f, 0x39, 0x26, 0x78, 0x8e, 0x05,
+	0x41, 0xa8, 0x99, 0xe6, 0x61, 0xa0, 0xa2, 0xc8, 0xec, 0x7a, 0x85, 0xeb, 0x6a, 0x6d, 0xd3, 0xf5,
+	0xc2, 0x9d, 0x1c, 0x93, 0x95, 0x50, 0xc8, 0xf0, 0x11, 0x3e, 0x5c, 0xf3, 0xca, 0xb9, 0xfa, 0x8d,
+	0x9c, 0xd8, 0xae, 0x98, 0x37, 0x55, 0x8e, 0x09, 0xe1, 0x73, 0x0f, 0xdf, 0xcd, 0xd5, 0x97, 0x98,
+	0x2f, 0xaa, 0x6c, 0x29, 0x57, 0x81, 0x00, 0x24, 0xd3, 0x50, 0x8e, 0xb3, 0xad, 0xf5, 0xc9, 0x86,
+	0xb4, 0xfa, 0xd2, 0x77, 0x1a, 0x64, 0xbc, 0x04, 0x22, 0xcc, 0x0b, 0xa1, 0xde, 0xab, 0x81, 0x6c,
+	0x50, 0x4a, 0x8e, 0x99, 0x20, 0xdb, 0x9a, 0xb3, 0x16, 0x46, 0x4b, 0xf8, 0x4c, 0xb3, 0x64, 0x44,
+	0x42, 0x9d, 0x2b, 0x1e, 0x06, 0xf6, 0x00, 0xda, 0x5b, 0x6b, 0x6a, 0x93, 0xe3, 0x4c, 0x88, 0x77,
+	0xd9, 0x0e, 0xd8, 0x83, 0xe8, 0x6a, 0x2e, 0xe9, 0x2c, 0x21, 0x4c, 0x88, 0xbb, 0x32, 0x7c, 0x04,
+	0x9e, 0xb6, 0x8f, 0xa1, 0xb3, 0xcd, 0xe2, 0x2c, 0x91, 0xe3, 0x79, 0x21, 0x8a, 0xc1, 0x56, 0x68,
+	0x40, 0x75, 0x43, 0x40, 0x13, 0xd4, 0x3c, 0x1b, 0x9b, 0x60, 0xba, 0x1a, 0x03, 0xe2, 0xb3, 0xf3,
+	0xaf, 0x45, 0x26, 0x63, 0xba, 0x05, 0xd0, 0x8c, 0xfb, 0x31, 0xe9, 0x0a, 0x19, 0x56, 0x61, 0x4d,
+	0x7a, 0x51, 0x86, 0xb1, 0xe5, 0x0d, 0x37, 0x51, 0xc7, 0x6d, 0xaa, 0x83, 0x0f, 0x1f, 0x7b, 0x65,
+	0xb7, 0x7e, 0xc3, 0x15, 0xdb, 0x15, 0xd7, 0x68, 0xed, 0xb6, 0x69, 0xed, 0x36, 0xb5, 0x76, 0xf3,
+	0x89, 0xf1, 0x1e, 0xa6, 0x2d, 0xc5, 0xe9, 0xdb, 0xab, 0x1d, 0x48, 0xab, 0x76, 0x70, 0x77, 0xb5,
+	0x74, 0x8e, 0x8c, 0x45, 0x39, 0x8a, 0x41, 0x19, 0x9e, 0xa2, 0x1c, 0x43, 0xa5, 0x76, 0x13, 0x9d,
+	0x21, 0xa3, 0x75, 0x90, 0x46, 0xd4, 0x62, 0xd9, 0x1e, 0x42, 0x7f, 0x62, 0x70, 0xde, 0x24, 0x99,
+	0xe6, 0x46, 0x95, 0x40, 0x89, 0x30, 0x50, 0x40, 0xaf, 0x90, 0x21, 0xae, 0x61, 0x47, 0xd9, 0xd6,
+	0xdc, 0xe0, 0xc2, 0xd8, 0xf2, 0xa4, 0xdb, 0xb6, 0xbd, 0xb1, 0xb4, 0xa5, 0x28, 0xc2, 0xf1, 0xc8,
+	0xa8, 0x79, 0xbd, 0xf7, 0x1e, 0x3b, 0xe4, 0xc4, 0x56, 0x68, 0x4a, 0x85, 0x2d, 0x09, 0x2a, 0x92,
+	0x7d, 0xa4, 0xd4, 0x61, 0xeb, 0x57, 0xa3, 0xf3, 0xe7, 0x08, 0x39, 0x85, 0x24, 0x3d, 0x0f, 0x54,
+	0x7a, 0x3f, 0xd5, 0x14, 0xc8, 0x20, 0x91, 0xb1, 0xb5, 0x36, 0x3e, 0xc1, 0x94, 0x7a, 0x12, 0xca,
+	0x72, 0x8c, 0xd0, 0x5a, 0xd3, 0xcb, 0x64, 0x5c, 0xa9, 0xea, 0x5d, 0xc9, 0xeb, 0x4c, 0xc3, 0x3b,
+	0xd0, 0x88, 0x9b, 0xaa, 0xd3, 0x68, 0x32, 0xf0, 0x40, 0x81, 0x57, 0x93, 0x80, 0x32, 0x8e, 0x94,
+	0x5a, 0x6b, 0x7a, 0x95, 0x4c, 0x68, 0x5f, 0xad, 0xfa, 0x1c, 0x02, 0xbd, 0x0a, 0x52, 0x17, 0x98,
+	0x66, 0xf6, 0x30, 0x66, 0xd9, 0xeb, 0xa0, 0x8b, 0x24, 0xd3, 0x61, 0x34, 0x90, 0xc7, 0x31, 0x78,
+	0x8f, 0xbd, 0xd5, 0xc2, 0xa3, 0x9d, 0x2d, 0x8c, 0x35, 0x92, 0xc8, 0x86, 0xf5, 0xcd, 0x90, 0x51,
+	0x08, 0xd8, 0xa6, 0x0f, 0x1b, 0x1e, 0xb7, 0xc7, 0x90, 0x5e, 0x62, 0xa0, 0xd7, 0xc9, 0x64, 0xd4,
+	0xb9, 0x79, 0x21, 0x92, 0x92, 0xec, 0x13, 0x98, 0xa0, 0x9b, 0xcb, 0xf4, 0x55, 0xcb, 0x5c, 0x2c,
+	0xd8, 0xe3, 0x73, 0xd6, 0xc2, 0x60, 0xa9, 0xdd, 0x44, 0x6f, 0x92, 0x33, 0xc9, 0x32, 0x50, 0x9a,
+	0xf9, 0x3e, 0xb6, 0x76, 0xb1, 0x60, 0x9f, 0xc4, 0xe8, 0x5e, 0x6e, 0xfa, 0x16, 0xc9, 0xb6, 0x5c,
+	0x6b, 0x81, 0x06, 0x29, 0x24, 0x57, 0xb0, 0xc2, 0x14, 0x3c, 0x90, 0xbe, 0x7d, 0x0a, 0x49, 0xa5,
+	0x44, 0xd0, 0x29, 0x32, 0x24, 0x64, 0xf8, 0xb4, 0x61, 0x67, 0x30, 0x34, 0x5a, 0x98, 0x6f, 0x48,
+	0xc4, 0x2d, 0x34, 0x11, 0x7d, 0x43, 0xf1, 0x92, 0x2e, 0x93, 0xa9, 0x8a, 0x27, 0xee, 0x81, 0xac,
+	0x73, 0x0f, 0xf2, 0x9e, 0x17, 0xd6, 0x02, 0xd4, 0x9c, 0x62, 0x58, 0x57, 0x1f, 0x75, 0x09, 0xc5,
+	0x1e, 0xbd, 0xa3, 0xb5, 0x58, 0x61, 0x8a, 0x7b, 0xf9, 0x9a, 0xae, 0xda, 0x93, 0x28, 0x6c, 0x17,
+	0x0f, 0xbd, 0x45, 0xec, 0x9a, 0x82, 0xfc, 0x27, 0x35, 0x09, 0x0f, 0x43, 0xb9, 0xed, 0x87, 0xac,
+	0x5c, 0x2c, 0x43, 0xa0, 0xb9, 0x6e, 0xd8, 0x53, 0xf8, 0x56, 0x4f, 0xbf, 0xd1, 0x7a, 0x13, 0x98,
+	0x04, 0x79, 0x3f, 0xdc, 0x86, 0xc0, 0x9e, 0x46, 0x5a, 0xed, 0x26, 0x53, 0x41, 0xb3, 0xd7, 0x36,
+	0x3c, 0xfe, 0x76, 0x13, 0xde, 0x3e, 0x8d, 0x99, 0xbb, 0xfa, 0x68, 0x81, 0x9c, 0x67, 0x06, 0x2e,
+	0xae, 0xed, 0xae, 0xe4, 0x81, 0xc7, 0x05, 0xf3, 0xa3, 0xfe, 0x2a, 0x96, 0xed, 0x33, 0x88, 0x93,
+	0x1e, 0x44, 0xd7, 0xc9, 0xc5, 0x94, 0x80, 0x7b, 0xe0, 0x49, 0xd0, 0xb6, 0x8d, 0x99, 0xfa, 0x07,
+	0xf6, 0xe4, 0x74, 0x1f, 0x02, 0x86, 0x9c, 0xce, 0xa6, 0x70, 0x6a, 0x06, 0xd1, 0x15, 0x32, 0x83,
+	0x01, 0x79, 0x4f, 0xf3, 0x3a, 0x14, 0xb8, 0x04, 0xcf, 0xcc, 0xa6, 0xb5, 0xa0, 0x2c, 0x42, 0x1e,
+	0x68, 0x3b, 0x8b, 0x49, 0x52, 0x63, 0x9c, 0x93, 0xe4, 0x84, 0x19, 0x29, 0xcd, 0x99, 0xe7, 0xfc,
+	0x6c, 0x91, 0x09, 0x63, 0x58, 0x95, 0xc0, 0x34, 0x94, 0xe0, 0x71, 0x0d, 0x94, 0xa6, 0x1f, 0xb6,
+	0x4d, 0x99, 0xb1, 0xe5, 0x3b, 0x2f, 0x37, 0xfe, 0x4b, 0xad, 0x29, 0x1a, 0xcf, 0xab, 0xd3, 0x64,
+	0xb8, 0x26, 0x14, 0x48, 0x1d, 0x4f, 0xc5, 0x78, 0x65, 0xbe, 0x65, 0x4f, 0x42, 0x59, 0x6d, 0x04,
+	0x7e, 0x03, 0x87, 0xd5, 0x48, 0x29, 0x31, 0x38, 0x8f, 0x23, 0xa2, 0x0f, 0x44, 0xf9, 0xa8, 0x88,
+	0x2e, 0x7f, 0x76, 0x86, 0x4c, 0x24, 0xc6, 0x78, 0x5f, 0xe8, 0x57, 0x16, 0x39, 0xb6, 0xce, 0x95,
+	0xa6, 0xd3, 0xed, 0x07, 0x44, 0xeb, 0x38, 0xc8, 0xae, 0x1f, 0x16, 0x0b, 0x03, 0xe2, 0x5c, 0xf8,
+	0xf4, 0xaf, 0x7f, 0xbe, 0x19, 0x38, 0x4d, 0xa7, 0xf0, 0x1a, 0x54, 0x5f, 0x4a, 0xee, 0x1c, 0x1c,
+	0xd4, 0x17, 0x03, 0x16, 0xfd, 0xd2, 0x22, 0x83, 0xb7, 0xa1, 0x27, 0x9b, 0x43, 0xd3, 0xc4, 0xb9,
+	0x84, 0x4c, 0xce, 0xd3, 0x73, 0xdd, 0x98, 0xe4, 0x9e, 0x99, 0xd5, 0x73, 0xfa, 0x9d, 0x45, 0x46,
+	0x6e, 0x83, 0x7e, 0x28, 0xb9, 0x86, 0x57, 0x4f, 0xe9, 0x0a, 0x52, 0xba, 0x44, 0x2f, 0x36, 0x29,
+	0x3d, 0x31, 0xb8, 0xd7, 0xba, 0x11, 0xfb, 0xd6, 0x22, 0x19, 0x23, 0x68, 0xa9, 0xcd, 0x77, 0x34,
+	0x3b, 0x38, 0x93, 0xb6, 0x83, 0xf4, 0x47, 0x8b, 0x4c, 0x9b, 0x30, 0x54, 0xec, 0xe8, 0xc9, 0x39,
+	0x48, 0x6e, 0x86, 0x66, 0x7b, 0x2b, 0x48, 0x3f, 0x22, 0x23, 0x91, 0x72, 0x5b, 0x3d, 0x49, 0x65,
+	0x3a, 0xcd, 0x5b, 0xca, 0x59, 0xc0, 0xc4, 0x0e, 0x9d, 0x4b, 0xe9, 0x96, 0x9c, 0x34, 0x29, 0xcb,
+	0x64, 0xcc, 0xa4, 0xdf, 0x58, 0x2d, 0xde, 0x67, 0x95, 0x03, 0x20, 0x5c, 0x45, 0x84, 0x79, 0x7a,
+	0x39, 0x0d, 0x21, 0xf4, 0xf8, 0x35, 0x6d, 0xd2, 0xee, 0x44, 0x45, 0x98, 0x0b, 0x1f, 0x3d, 0xbb,
+	0x1b, 0xa2, 0x75, 0x5f, 0xcf, 0xce, 0x74, 0x73, 0xb5, 0xa6, 0xe5, 0xbe, 0x8a, 0x62, 0x06, 0xe2,
+	0x6b, 0x8b, 0x8c, 0xdf, 0x06, 0x9d, 0xdc, 0xac, 0xe9, 0x85, 0x2e, 0x99, 0xdb, 0x6f, 0xdd, 0x59,
+	0xa7, 0x77, 0x40, 0x8b, 0xc0, 0x1b, 0x48, 0xe0, 0x35, 0xe7, 0x7a, 0x77, 0x02, 0xd1, 0xfd, 0x17,
+	0xf3, 0x3c, 0x28, 0xad, 0x23, 0x95, 0x72, 0x94, 0xe1, 0x96, 0xb5, 0x48, 0xeb, 0x48, 0xe9, 0x0e,
+	0xf8, 0x3b, 0xab, 0x55, 0x26, 0x75, 0x4f, 0xa9, 0x67, 0xdb, 0xcd, 0x49, 0x78, 0x8b, 0x84, 0x8b,
+	0x24, 0x16, 0xe8, 0x7c, 0x9a, 0x0a, 0x55, 0xf0, 0x77, 0xbc, 0x08, 0xe6, 0x7b, 0x8b, 0x0c, 0x47,
+	0xe7, 0x0b, 0x3d, 0xbf, 0x1b, 0xb1, 0xe3, 0xdc, 0x39, 0xc4, 0xc9, 0xf0, 0xbf, 0xa8, 0xaf, 0x9d,
+	0xae, 0x1f, 0xdd, 0x2d, 0x1c, 0xef, 0x66, 0x78, 0xfe, 0x60, 0x91, 0x4c, 0x93, 0x42, 0xf3, 0xdd,
+	0xa3, 0x23, 0xe9, 0xf4, 0x27, 0x49, 0x7f, 0xb1, 0xc8, 0x74, 0x84, 0xdf, 0x39, 0x21, 0x8e, 0x90,
+	0x66, 0xdc, 0xf5, 0x4e, 0xca, 0x8c, 0x88, 0xc9, 0xfe, 0x64, 0x91, 0xe1, 0xe8, 0x80, 0xde, 0xcb,
+	0xae, 0xe3, 0xe0, 0x3e, 0x44, 0x76, 0x4b, 0x51, 0x37, 0x66, 0x53, 0xbe, 0x49, 0xa4, 0xf2, 0x3c,
+	0xd9, 0xf5, 0x5f, 0x2d, 0x92, 0x69, 0xd2, 0xe9, 0x2d, 0xe7, 0xab, 0x22, 0xec, 0x1e, 0x8c, 0x30,
+	0xfd, 0xcd, 0x22, 0xd3, 0x11, 0x97, 0xbe, 0x1d, 0xf0, 0xaa, 0x28, 0xff, 0x1f, 0x29, 0xbb, 0xd9,
+	0xf9, 0x7e, 0xe7, 0x6c, 0x07, 0x71, 0x46, 0x86, 0x0b, 0xe0, 0x43, 0xef, 0x8b, 0x80, 0xbd, 0xdb,
+	0xdc, 0x1a, 0x31, 0xf3, 0xd1, 0x5d, 0x63, 0x31, 0xed, 0xae, 0x61, 0x76, 0xb2, 0x4a, 0x32, 0x11,
+	0x44, 0x9b, 0x2a, 0x07, 0x06, 0xbb, 0xb4, 0x0f, 0x30, 0xaa, 0xc8, 0x74, 0x84, 0xb4, 0x7b, 0x13,
+	0x0e, 0x0c, 0x17, 0x5f, 0x5a, 0x16, 0xf7, 0x71, 0x69, 0x79, 0x46, 0x4e, 0xbe, 0xcf, 0x7c, 0x6e,
+	0x36, 0x35, 0xfa, 0x13, 0x80, 0x9e, 0xdb, 0x73, 0x48, 0x24, 0x7f, 0x0e, 0xa4, 0x60, 0x2e, 0x23,
+	0xe6, 0x55, 0x27, 0xf5, 0xac, 0xac, 0xc7, 0x50, 0xf1, 0xf6, 0x7d, 0x6e, 0x91, 0xc9, 0x26, 0x3a,
+	0x16, 0xfd, 0x72, 0x14, 0x6e, 0x22, 0x85, 0x65, 0x67, 0xb1, 0x6f, 0xd9, 0xbb, 0x88, 0xac, 0xac,
+	0xfd, 0xfe, 0x62, 0xd6, 0xfa, 0xe3, 0xc5, 0xac, 0xf5, 0xf7, 0x8b, 0x59, 0xeb, 0x83, 0xd7, 0xf7,
+	0xf7, 0xbf, 0x9f, 0x87, 0xbf, 0xbf, 0x92, 0x3a, 0x1b, 0x9b, 0xc3, 0xf8, 0x17, 0xdd, 0x8d, 0xff,
+	0x06, 0x00, 0xff, 0x54, 0xba, 0x9b, 0x87, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,4 +24,11 @@ const (
 	ApplicationSetShortName string = "appset"
 	ApplicationSetPlural    string = "applicationsets"
 	ApplicationSetFullName  string = ApplicationSetPlural + "." + Group
+
+	// GeneratorTemplate constants
+	GeneratorTemplateKind      string = "GeneratorTemplate"
+	GeneratorTemplateSingular  string = "generatortemplate"
+	GeneratorTemplateShortName string = "gentemplate"
+	GeneratorTemplatePlural    string = "generatortemplates"
+	GeneratorTemplateFullName  string = GeneratorTemplatePlural + "." + Group
 )
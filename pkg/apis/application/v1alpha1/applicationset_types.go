@@ -18,7 +18,9 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -79,6 +81,23 @@ type ApplicationSetSpec struct {
 	ApplyNestedSelectors         bool                            `json:"applyNestedSelectors,omitempty" protobuf:"bytes,8,name=applyNestedSelectors"`
 	IgnoreApplicationDifferences ApplicationSetIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,9,name=ignoreApplicationDifferences"`
 	TemplatePatch                *string                         `json:"templatePatch,omitempty" protobuf:"bytes,10,name=templatePatch"`
+	// GeneratorDefinitions are named generators which can be referenced by the generators of the ApplicationSet, at any
+	// level of nesting, with a generator reference. They allow to nest combination-type generators beyond the two levels
+	// supported by the schema of the generators. Their schema is not part of the CRD, which would otherwise exceed the
+	// size limits of the Kubernetes API, and they are validated on generation instead.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	GeneratorDefinitions map[string]ApplicationSetNestedGenerator `json:"generatorDefinitions,omitempty" protobuf:"bytes,11,rep,name=generatorDefinitions"`
+	// Imports are the GeneratorTemplates of the namespace of the ApplicationSet whose generator definitions and template
+	// are imported by the ApplicationSet
+	Imports []ApplicationSetImport `json:"imports,omitempty" protobuf:"bytes,12,rep,name=imports"`
+}
+
+// ApplicationSetImport references a GeneratorTemplate imported by an ApplicationSet
+type ApplicationSetImport struct {
+	// Name of the GeneratorTemplate, in the namespace of the ApplicationSet
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
 }
 
 type ApplicationPreservedFields struct {
@@ -101,6 +120,78 @@ type ApplicationSetRolloutStrategy struct {
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// Analysis is a list of gates which are evaluated in order once all Applications of the step are Healthy.
+	// The rollout only moves on to the next step after every gate has passed, and halts if a gate fails.
+	Analysis []ApplicationSetRolloutAnalysis `json:"analysis,omitempty" protobuf:"bytes,3,rep,name=analysis"`
+	// ManualApproval pauses the rollout before the Applications of this step are synced, until the step is promoted
+	// with `argocd appset rollout promote` or aborted with `argocd appset rollout abort`
+	ManualApproval bool `json:"manualApproval,omitempty" protobuf:"varint,4,opt,name=manualApproval"`
+}
+
+// ApplicationSetRolloutAnalysis is a gate of a rollout step. Exactly one of Prometheus, HTTP or Pause must be set.
+type ApplicationSetRolloutAnalysis struct {
+	// Name identifies the gate in the ApplicationSet status
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Prometheus passes when the result of a Prometheus query is non-empty and none of its values is zero
+	Prometheus *ApplicationSetRolloutPrometheusAnalysis `json:"prometheus,omitempty" protobuf:"bytes,2,opt,name=prometheus"`
+	// HTTP passes when a request to an HTTP endpoint returns a 2xx status code
+	HTTP *ApplicationSetRolloutHTTPAnalysis `json:"http,omitempty" protobuf:"bytes,3,opt,name=http"`
+	// Pause passes once the given duration has elapsed
+	Pause *ApplicationSetRolloutPauseAnalysis `json:"pause,omitempty" protobuf:"bytes,4,opt,name=pause"`
+	// Interval is the time between two evaluations of a Prometheus or HTTP gate. Default unit is seconds, but could also
+	// be a duration (e.g. "2m", "1h"). Defaults to 30s.
+	Interval string `json:"interval,omitempty" protobuf:"bytes,5,opt,name=interval"`
+	// Count is the number of consecutive successful evaluations required for a Prometheus or HTTP gate to pass.
+	// Defaults to 1.
+	Count int64 `json:"count,omitempty" protobuf:"varint,6,opt,name=count"`
+	// FailureLimit is the number of consecutive failed evaluations of a Prometheus or HTTP gate which are tolerated
+	// before the gate fails. Defaults to 0, which fails the gate on the first failed evaluation.
+	FailureLimit int64 `json:"failureLimit,omitempty" protobuf:"varint,7,opt,name=failureLimit"`
+}
+
+// GetInterval returns the time between two evaluations of the gate
+func (a *ApplicationSetRolloutAnalysis) GetInterval() (time.Duration, error) {
+	if a.Interval == "" {
+		return DefaultApplicationSetRolloutAnalysisInterval, nil
+	}
+	return parseStringToDuration(a.Interval)
+}
+
+// GetCount returns the number of consecutive successful evaluations required for the gate to pass
+func (a *ApplicationSetRolloutAnalysis) GetCount() int64 {
+	if a.Count < 1 {
+		return 1
+	}
+	return a.Count
+}
+
+// ApplicationSetRolloutPrometheusAnalysis runs an instant query against a Prometheus server
+type ApplicationSetRolloutPrometheusAnalysis struct {
+	// Address is the URL of the Prometheus server, e.g. http://prometheus.monitoring.svc:9090
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Query is the PromQL query to run. Comparison operators filter out failing samples, so a query such as
+	// `sum(rate(http_requests_total{code=~"5.."}[5m])) < 1` only passes while the error rate stays below the threshold.
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+}
+
+// ApplicationSetRolloutHTTPAnalysis sends a request to an HTTP endpoint
+type ApplicationSetRolloutHTTPAnalysis struct {
+	// URL is the endpoint to send the request to
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method is the HTTP method of the request, either GET or HEAD. Defaults to GET.
+	// +kubebuilder:validation:Enum=GET;HEAD
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+}
+
+// ApplicationSetRolloutPauseAnalysis pauses the rollout for a given amount of time
+type ApplicationSetRolloutPauseAnalysis struct {
+	// Duration is the amount of time to pause for. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Duration string `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+}
+
+// GetDuration returns the pause duration
+func (p *ApplicationSetRolloutPauseAnalysis) GetDuration() (time.Duration, error) {
+	return parseStringToDuration(p.Duration)
 }
 
 type ApplicationMatchExpression struct {
@@ -142,6 +233,60 @@ type ApplicationSetSyncPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=create-only;create-update;create-delete;sync
 	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
+	// Expiration deletes the generated Applications which are idle for longer than a TTL, or which are outside of a schedule.
+	// The Applications are only deleted if the policy allows the deletion of Applications.
+	Expiration *ApplicationSetExpiration `json:"expiration,omitempty" protobuf:"bytes,3,opt,name=expiration"`
+}
+
+const (
+	// ApplicationSetIdleSinceCommit considers an Application idle since the date of the last commit of its synced revision
+	ApplicationSetIdleSinceCommit = "commit"
+	// ApplicationSetIdleSinceSync considers an Application idle since its last sync
+	ApplicationSetIdleSinceSync = "sync"
+)
+
+// ApplicationSetExpiration configures the deletion of the generated Applications which are not used anymore, e.g. the
+// preview environments of stale pull requests. An expired Application is not generated again until its generated spec
+// changes, e.g. a new commit is pushed to the pull request, or until a schedule window begins.
+type ApplicationSetExpiration struct {
+	// IdleTTL is the duration, e.g. 72h, after which an idle Application is deleted
+	IdleTTL string `json:"idleTTL,omitempty" protobuf:"bytes,1,opt,name=idleTTL"`
+	// IdleSince is the activity from which an Application is considered idle: the last commit of its synced revision, or its last sync. Defaults to commit.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=commit;sync
+	IdleSince string `json:"idleSince,omitempty" protobuf:"bytes,2,opt,name=idleSince"`
+	// Schedules are the windows during which the Applications are kept, the Applications are deleted outside of them
+	Schedules []ApplicationSetExpirationSchedule `json:"schedules,omitempty" protobuf:"bytes,3,rep,name=schedules"`
+}
+
+// ApplicationSetExpirationSchedule is a window during which the generated Applications are kept
+type ApplicationSetExpirationSchedule struct {
+	// Schedule is the time the window will begin, specified in cron format
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// Duration is the amount of time the window will be open
+	Duration string `json:"duration" protobuf:"bytes,2,opt,name=duration"`
+	// TimeZone of the schedule
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,3,opt,name=timeZone"`
+}
+
+// Active returns true if the window is open at the given time
+func (s ApplicationSetExpirationSchedule) Active(currentTime time.Time) (bool, error) {
+	return SyncWindow{Schedule: s.Schedule, Duration: s.Duration, TimeZone: s.TimeZone}.active(currentTime)
+}
+
+// GetIdleTTL returns the parsed idle TTL, or zero if it is not set
+func (e *ApplicationSetExpiration) GetIdleTTL() (time.Duration, error) {
+	if e == nil || e.IdleTTL == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(e.IdleTTL)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing idle TTL %q: %w", e.IdleTTL, err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("idle TTL %q must be positive", e.IdleTTL)
+	}
+	return ttl, nil
 }
 
 // ApplicationSetIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
@@ -208,6 +353,22 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,11,name=oci"`
+
+	HelmChart *HelmChartGenerator `json:"helmChart,omitempty" protobuf:"bytes,12,name=helmChart"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,13,name=kubernetesResource"`
+
+	// GeneratorRef references a generator definition of the ApplicationSet
+	GeneratorRef *GeneratorReference `json:"generatorRef,omitempty" protobuf:"bytes,14,name=generatorRef"`
+}
+
+// GeneratorReference references a generator definition of the ApplicationSet by name. The parameters of the referenced
+// generator are filtered by the selector of the referencing generator, if any.
+type GeneratorReference struct {
+	// Name of the generator definition
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -230,6 +391,15 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,11,name=oci"`
+
+	HelmChart *HelmChartGenerator `json:"helmChart,omitempty" protobuf:"bytes,12,name=helmChart"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,13,name=kubernetesResource"`
+
+	// GeneratorRef references a generator definition of the ApplicationSet
+	GeneratorRef *GeneratorReference `json:"generatorRef,omitempty" protobuf:"bytes,14,name=generatorRef"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -238,6 +408,7 @@ type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
 // a merge within a matrix). A generator at this level may not be a combination-type generator (MatrixGenerator or
 // MergeGenerator). ApplicationSet enforces this nesting depth limit because CRDs do not support recursive types.
 // https://github.com/kubernetes-sigs/controller-tools/issues/477
+// Deeper compositions are expressed with references to the generator definitions of the ApplicationSet.
 type ApplicationSetTerminalGenerator struct {
 	List                    *ListGenerator        `json:"list,omitempty" protobuf:"bytes,1,name=list"`
 	Clusters                *ClusterGenerator     `json:"clusters,omitempty" protobuf:"bytes,2,name=clusters"`
@@ -249,6 +420,15 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,9,name=oci"`
+
+	HelmChart *HelmChartGenerator `json:"helmChart,omitempty" protobuf:"bytes,10,name=helmChart"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,11,name=kubernetesResource"`
+
+	// GeneratorRef references a generator definition of the ApplicationSet
+	GeneratorRef *GeneratorReference `json:"generatorRef,omitempty" protobuf:"bytes,12,name=generatorRef"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -267,6 +447,10 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
+			OCI:                     terminalGenerator.OCI,
+			HelmChart:               terminalGenerator.HelmChart,
+			KubernetesResource:      terminalGenerator.KubernetesResource,
+			GeneratorRef:            terminalGenerator.GeneratorRef,
 			Selector:                terminalGenerator.Selector,
 		}
 	}
@@ -420,6 +604,25 @@ type GitGenerator struct {
 
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,8,name=values"`
+	// Tags, if set, generates parameters for the tags of the repository which match the filters, rather than for the
+	// directories or the files of a revision.
+	Tags *GitTagsGeneratorItem `json:"tags,omitempty" protobuf:"bytes,9,opt,name=tags"`
+}
+
+// GitTagsGeneratorItem defines which tags of the repository the Git generator generates parameters for.
+type GitTagsGeneratorItem struct {
+	// Match is a regex which the names of the tags must match.
+	Match string `json:"match,omitempty" protobuf:"bytes,1,opt,name=match"`
+	// VersionConstraint is a semantic version range the tags must satisfy, e.g. ">=1.2.0 <2.0.0". If set, tags which
+	// are not semantic versions are ignored.
+	VersionConstraint string `json:"versionConstraint,omitempty" protobuf:"bytes,2,opt,name=versionConstraint"`
+	// Latest, if greater than zero, only considers the given number of highest semantic versions which match the
+	// filters.
+	Latest int64 `json:"latest,omitempty" protobuf:"varint,3,opt,name=latest"`
+	// ReleaseLine, if set, only considers the highest semantic version of each major or minor release line, e.g. to
+	// generate parameters for the last three minor versions with Latest set to 3.
+	// +kubebuilder:validation:Enum=major;minor
+	ReleaseLine string `json:"releaseLine,omitempty" protobuf:"bytes,4,opt,name=releaseLine"`
 }
 
 type GitDirectoryGeneratorItem struct {
@@ -630,6 +833,9 @@ type PullRequestGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
 	// ContinueOnRepoNotFoundError is a flag to continue the ApplicationSet Pull Request generator parameters generation even if the repository is not found.
 	ContinueOnRepoNotFoundError bool `json:"continueOnRepoNotFoundError,omitempty" protobuf:"varint,11,opt,name=continueOnRepoNotFoundError"`
+	// Additional providers to use and config for them.
+	AWSCodeCommit *PullRequestGeneratorAWSCodeCommit `json:"awsCodeCommit,omitempty" protobuf:"bytes,12,opt,name=awsCodeCommit"`
+	Gerrit        *PullRequestGeneratorGerrit        `json:"gerrit,omitempty" protobuf:"bytes,13,opt,name=gerrit"`
 	// If you add a new SCM provider, update CustomApiUrl below.
 }
 
@@ -652,6 +858,9 @@ func (p *PullRequestGenerator) CustomApiUrl() string { //nolint:revive //FIXME(v
 	if p.AzureDevOps != nil {
 		return p.AzureDevOps.API
 	}
+	if p.Gerrit != nil {
+		return p.Gerrit.API
+	}
 	return ""
 }
 
@@ -687,6 +896,32 @@ type PullRequestGeneratorAzureDevOps struct {
 	Labels []string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
 }
 
+// PullRequestGeneratorAWSCodeCommit defines connection info specific to AWS CodeCommit.
+type PullRequestGeneratorAWSCodeCommit struct {
+	// CodeCommit repository name to scan. Required.
+	Repository string `json:"repository" protobuf:"bytes,1,opt,name=repository"`
+	// Role provides the AWS IAM role to assume, for cross-account access to the repository
+	// if not provided, AppSet controller will use its pod/node identity.
+	Role string `json:"role,omitempty" protobuf:"bytes,2,opt,name=role"`
+	// Region provides the AWS region of the repository.
+	// if not provided, AppSet controller will infer the current region from environment.
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+}
+
+// PullRequestGeneratorGerrit defines connection info specific to Gerrit.
+type PullRequestGeneratorGerrit struct {
+	// The Gerrit URL to talk to. For example https://gerrit.mydomain.com/. Required.
+	API string `json:"api" protobuf:"bytes,1,opt,name=api"`
+	// Gerrit project to scan. Required.
+	Project string `json:"project" protobuf:"bytes,2,opt,name=project"`
+	// Credentials for Basic auth, with the HTTP password of the Gerrit account. If not set, changes are listed anonymously.
+	BasicAuth *BasicAuthBitbucketServer `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
+	// Labels is used to filter the changes that you want to target, by hashtag
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,4,rep,name=labels"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+}
+
 // PullRequestGeneratorGithub defines connection info specific to GitHub.
 type PullRequestGeneratorGithub struct {
 	// GitHub org or user to scan. Required.
@@ -781,6 +1016,17 @@ type PullRequestGeneratorFilter struct {
 	BranchMatch       *string `json:"branchMatch,omitempty" protobuf:"bytes,1,opt,name=branchMatch"`
 	TargetBranchMatch *string `json:"targetBranchMatch,omitempty" protobuf:"bytes,2,opt,name=targetBranchMatch"`
 	TitleMatch        *string `json:"titleMatch,omitempty" protobuf:"bytes,3,op,name=titleMatch"`
+	// Draft selects the draft pull requests if true, or the ready for review ones if false.
+	Draft *bool `json:"draft,omitempty" protobuf:"varint,4,opt,name=draft"`
+	// Approved selects the pull requests approved by reviewers if true, or the ones which are not if false.
+	Approved *bool `json:"approved,omitempty" protobuf:"varint,5,opt,name=approved"`
+	// ChecksStatus selects the pull requests whose status checks have the given combined status.
+	// +kubebuilder:validation:Enum=success;pending;failure
+	ChecksStatus *string `json:"checksStatus,omitempty" protobuf:"bytes,6,opt,name=checksStatus"`
+	// An array of glob patterns, e.g. services/foo/**, at least one of which must match a file changed by the pull request.
+	PathsChanged []string `json:"pathsChanged,omitempty" protobuf:"bytes,7,rep,name=pathsChanged"`
+	// UpdatedWithin selects the pull requests updated within the given duration, e.g. 168h.
+	UpdatedWithin *string `json:"updatedWithin,omitempty" protobuf:"bytes,8,opt,name=updatedWithin"`
 }
 
 type PluginConfigMapRef struct {
@@ -809,6 +1055,87 @@ type PluginGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
 }
 
+// OCIGenerator defines a generator that lists the repositories and the tags of an OCI registry namespace.
+type OCIGenerator struct {
+	// RepoURL is the URL of the registry namespace, e.g. oci://registry.example.com/charts. Credentials are looked up
+	// in the repositories and repository credentials of Argo CD.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
+	// Repositories are the names of the repositories, relative to the namespace, to list the tags of. If empty, the
+	// repositories are listed with the catalog API of the registry.
+	Repositories []string `json:"repositories,omitempty" protobuf:"bytes,2,rep,name=repositories"`
+	// Filters for which repositories and tags should be considered. A tag is considered if it matches any filter.
+	Filters []OCIGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,3,rep,name=filters"`
+	// Latest, if true, only considers the highest semantic version tag of each repository which matches the filters.
+	Latest bool `json:"latest,omitempty" protobuf:"varint,4,opt,name=latest"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,name=values"`
+}
+
+// OCIGeneratorFilter is a single repository and tag filter. If multiple filter types are set on a single struct, they
+// will be AND'd together. All filters must pass for a tag to be included.
+type OCIGeneratorFilter struct {
+	// A regex for repository names, relative to the namespace.
+	RepositoryMatch *string `json:"repositoryMatch,omitempty" protobuf:"bytes,1,opt,name=repositoryMatch"`
+	// A regex for tags.
+	TagMatch *string `json:"tagMatch,omitempty" protobuf:"bytes,2,opt,name=tagMatch"`
+	// A semantic version constraint tags must satisfy, e.g. ">=1.2.0 <2.0.0". Tags which are not semantic versions
+	// do not satisfy any constraint.
+	VersionConstraint *string `json:"versionConstraint,omitempty" protobuf:"bytes,3,opt,name=versionConstraint"`
+}
+
+// HelmChartGenerator defines a generator that lists the versions of the charts of a Helm repository.
+type HelmChartGenerator struct {
+	// RepoURL is the URL of the Helm repository, either a repository with an index.yaml or an OCI repository.
+	// Credentials are looked up in the repositories and repository credentials of Argo CD.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
+	// Charts are the names of the charts to list the versions of. Required for OCI repositories. If empty, all the
+	// charts of the index of the repository are listed.
+	Charts []string `json:"charts,omitempty" protobuf:"bytes,2,rep,name=charts"`
+	// VersionConstraint is a semantic version range the versions must satisfy, e.g. ">=1.2.0 <2.0.0". If set, versions
+	// which are not semantic versions are ignored.
+	VersionConstraint string `json:"versionConstraint,omitempty" protobuf:"bytes,3,opt,name=versionConstraint"`
+	// LatestVersions, if greater than zero, only considers the given number of highest semantic versions of each chart
+	// which satisfy the version constraint.
+	LatestVersions int64 `json:"latestVersions,omitempty" protobuf:"varint,4,opt,name=latestVersions"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,name=values"`
+}
+
+// KubernetesResourceGenerator defines a generator that lists arbitrary Kubernetes objects, on the cluster of the
+// ApplicationSet controller or on the clusters registered with Argo CD.
+type KubernetesResourceGenerator struct {
+	// APIVersion is the API version of the objects, e.g. "v1" or "example.com/v1alpha1".
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,name=apiVersion"`
+	// Kind is the kind of the objects, e.g. "Namespace".
+	Kind string `json:"kind" protobuf:"bytes,2,name=kind"`
+	// Namespace is the namespace to list namespaced objects from. If empty, the objects of all namespaces are listed.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// LabelSelector selects the objects by their labels.
+	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,opt,name=labelSelector"`
+	// FieldSelector selects the objects by their fields, e.g. "metadata.name=team-a".
+	FieldSelector string `json:"fieldSelector,omitempty" protobuf:"bytes,5,opt,name=fieldSelector"`
+	// ClusterSelector, if set, lists the objects on each cluster registered with Argo CD which matches the selector,
+	// rather than on the cluster of the ApplicationSet controller. An empty selector matches all the clusters.
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty" protobuf:"bytes,6,opt,name=clusterSelector"`
+	// Parameters are JSONPath expressions evaluated against each object, e.g. "{.metadata.labels.team}", whose results
+	// are passed as parameters to the template under the given names.
+	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,7,rep,name=parameters"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,8,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,9,opt,name=template"`
+
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
+}
+
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -822,6 +1149,28 @@ type ApplicationSetStatus struct {
 	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,4,opt,name=resourcesCount"`
 	// Health contains information about the applicationset's current health status based on the applicationset conditions
 	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
+	// ExpiredApplications are the Applications deleted by the expiration policy, which are not generated again until
+	// their generated spec changes or until a schedule window begins
+	ExpiredApplications []ApplicationSetExpiredApplication `json:"expiredApplications,omitempty" protobuf:"bytes,6,rep,name=expiredApplications"`
+}
+
+const (
+	// ApplicationSetExpirationReasonIdle is the reason of the Applications which were idle for longer than the TTL
+	ApplicationSetExpirationReasonIdle = "Idle"
+	// ApplicationSetExpirationReasonSchedule is the reason of the Applications which were outside of the schedule windows
+	ApplicationSetExpirationReasonSchedule = "Schedule"
+)
+
+// ApplicationSetExpiredApplication is an Application deleted by the expiration policy of the ApplicationSet
+type ApplicationSetExpiredApplication struct {
+	// Name of the Application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Reason is the reason why the Application expired, Idle or Schedule
+	Reason string `json:"reason" protobuf:"bytes,2,opt,name=reason"`
+	// SpecHash is the hash of the generated spec of the Application when it expired
+	SpecHash string `json:"specHash,omitempty" protobuf:"bytes,3,opt,name=specHash"`
+	// ExpiredAt is the time the Application expired
+	ExpiredAt metav1.Time `json:"expiredAt" protobuf:"bytes,4,opt,name=expiredAt"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -884,6 +1233,11 @@ const (
 	ApplicationSetReasonApplicationSetRolloutError       = "ApplicationSetRolloutError"
 	ApplicationSetReasonInvalidRolloutConfig             = "ApplicationSetInvalidRolloutConfig"
 	ApplicationSetReasonValidRolloutConfig               = "ApplicationSetValidRolloutConfig"
+	ApplicationSetReasonRolloutAnalysisFailed            = "ApplicationSetRolloutAnalysisFailed"
+	ApplicationSetReasonRolloutAwaitingApproval          = "ApplicationSetRolloutAwaitingApproval"
+	ApplicationSetReasonRolloutAborted                   = "ApplicationSetRolloutAborted"
+	ApplicationSetReasonExpirationError                  = "ExpirationError"
+	ApplicationSetReasonImportError                      = "ImportError"
 )
 
 // Represents resource health status
@@ -914,6 +1268,65 @@ type ApplicationSetApplicationStatus struct {
 	Step string `json:"step" protobuf:"bytes,5,opt,name=step"`
 	// TargetRevision tracks the desired revisions the Application should be synced to.
 	TargetRevisions []string `json:"targetRevisions" protobuf:"bytes,6,opt,name=targetrevisions"`
+	// Analysis contains the results of the analysis gates of the step this Application belongs to
+	Analysis []ApplicationSetRolloutAnalysisStatus `json:"analysis,omitempty" protobuf:"bytes,7,rep,name=analysis"`
+	// Approval contains the decision on the manual approval of the step this Application belongs to
+	Approval *ApplicationSetRolloutApprovalStatus `json:"approval,omitempty" protobuf:"bytes,8,opt,name=approval"`
+}
+
+// ApplicationSetRolloutApprovalPhase is the decision taken on a rollout step which requires manual approval
+type ApplicationSetRolloutApprovalPhase string
+
+const (
+	// ApplicationSetRolloutApproved indicates that the step was promoted and its Applications may be synced
+	ApplicationSetRolloutApproved ApplicationSetRolloutApprovalPhase = "Approved"
+	// ApplicationSetRolloutAborted indicates that the step was aborted and the rollout is halted
+	ApplicationSetRolloutAborted ApplicationSetRolloutApprovalPhase = "Aborted"
+)
+
+// ApplicationSetRolloutApprovalStatus contains the decision on a rollout step which requires manual approval
+type ApplicationSetRolloutApprovalStatus struct {
+	// Phase is the decision taken on the step
+	Phase ApplicationSetRolloutApprovalPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// User is the name of the user who took the decision
+	User string `json:"user,omitempty" protobuf:"bytes,2,opt,name=user"`
+	// Time is the time the decision was taken
+	Time metav1.Time `json:"time" protobuf:"bytes,3,opt,name=time"`
+}
+
+// ApplicationSetRolloutAnalysisPhase is the phase of a rollout step analysis gate
+type ApplicationSetRolloutAnalysisPhase string
+
+const (
+	// ApplicationSetRolloutAnalysisRunning indicates that the gate is being evaluated
+	ApplicationSetRolloutAnalysisRunning ApplicationSetRolloutAnalysisPhase = "Running"
+	// ApplicationSetRolloutAnalysisSuccessful indicates that the gate has passed
+	ApplicationSetRolloutAnalysisSuccessful ApplicationSetRolloutAnalysisPhase = "Successful"
+	// ApplicationSetRolloutAnalysisFailed indicates that the gate has failed and the rollout is halted
+	ApplicationSetRolloutAnalysisFailed ApplicationSetRolloutAnalysisPhase = "Failed"
+)
+
+// DefaultApplicationSetRolloutAnalysisInterval is the default time between two evaluations of a rollout step analysis gate
+const DefaultApplicationSetRolloutAnalysisInterval = 30 * time.Second
+
+// ApplicationSetRolloutAnalysisStatus contains the result of a rollout step analysis gate
+type ApplicationSetRolloutAnalysisStatus struct {
+	// Name is the name of the gate
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Phase is the current phase of the gate
+	Phase ApplicationSetRolloutAnalysisPhase `json:"phase" protobuf:"bytes,2,opt,name=phase"`
+	// Message contains human-readable details about the result of the gate
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// StartedAt is the time the gate started being evaluated
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
+	// FinishedAt is the time the gate passed or failed
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,5,opt,name=finishedAt"`
+	// Successes is the number of consecutive successful evaluations of the gate
+	Successes int64 `json:"successes,omitempty" protobuf:"varint,6,opt,name=successes"`
+	// Failures is the number of consecutive failed evaluations of the gate
+	Failures int64 `json:"failures,omitempty" protobuf:"varint,7,opt,name=failures"`
+	// LastEvaluatedAt is the time the gate was last evaluated
+	LastEvaluatedAt *metav1.Time `json:"lastEvaluatedAt,omitempty" protobuf:"bytes,8,opt,name=lastEvaluatedAt"`
 }
 
 // ApplicationSetList contains a list of ApplicationSet
@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GeneratorTemplate is a library of generator definitions and of an Application template, which the ApplicationSets
+// of its namespace can import.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=generatortemplates,shortName=gentemplate;gentemplates
+type GeneratorTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              GeneratorTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// GeneratorTemplateSpec holds the generator definitions and the template imported by ApplicationSets
+type GeneratorTemplateSpec struct {
+	// GeneratorDefinitions are named generators which the generators of the importing ApplicationSets can reference.
+	// The generator definitions of an ApplicationSet take precedence over the imported ones.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	GeneratorDefinitions map[string]ApplicationSetNestedGenerator `json:"generatorDefinitions,omitempty" protobuf:"bytes,1,rep,name=generatorDefinitions"`
+	// Template is merged into the template of the importing ApplicationSets: it sets the fields which are not set by
+	// their template. The project of the Applications is never imported.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template *ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
+}
+
+// GeneratorTemplateList contains a list of GeneratorTemplate
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+type GeneratorTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []GeneratorTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -21,6 +21,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectStatus":                        schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application":                             schema_pkg_apis_application_v1alpha1_Application(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition":                    schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency":                   schema_pkg_apis_application_v1alpha1_ApplicationDependency(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependencyStatus":             schema_pkg_apis_application_v1alpha1_ApplicationDependencyStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination":                  schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount":    schema_pkg_apis_application_v1alpha1_ApplicationDestinationServiceAccount(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationList":                         schema_pkg_apis_application_v1alpha1_ApplicationList(ref),
@@ -29,10 +31,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSet":                          schema_pkg_apis_application_v1alpha1_ApplicationSet(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus":         schema_pkg_apis_application_v1alpha1_ApplicationSetApplicationStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition":                 schema_pkg_apis_application_v1alpha1_ApplicationSetCondition(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpiration":                schema_pkg_apis_application_v1alpha1_ApplicationSetExpiration(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpirationSchedule":        schema_pkg_apis_application_v1alpha1_ApplicationSetExpirationSchedule(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpiredApplication":        schema_pkg_apis_application_v1alpha1_ApplicationSetExpiredApplication(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator":                 schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetImport":                    schema_pkg_apis_application_v1alpha1_ApplicationSetImport(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetList":                      schema_pkg_apis_application_v1alpha1_ApplicationSetList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator":           schema_pkg_apis_application_v1alpha1_ApplicationSetNestedGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences": schema_pkg_apis_application_v1alpha1_ApplicationSetResourceIgnoreDifferences(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysis":           schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysis(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysisStatus":     schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysisStatus(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutApprovalStatus":     schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutApprovalStatus(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutHTTPAnalysis":       schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutHTTPAnalysis(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPauseAnalysis":      schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutPauseAnalysis(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPrometheusAnalysis": schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutPrometheusAnalysis(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStep":               schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStep(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStrategy":           schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStrategy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSpec":                      schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref),
@@ -55,6 +67,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary":                      schema_pkg_apis_application_v1alpha1_ApplicationSummary(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationTree":                         schema_pkg_apis_application_v1alpha1_ApplicationTree(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationWatchEvent":                   schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AutomatedRollbackStatus":                 schema_pkg_apis_application_v1alpha1_AutomatedRollbackStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Backoff":                                 schema_pkg_apis_application_v1alpha1_Backoff(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer":                schema_pkg_apis_application_v1alpha1_BasicAuthBitbucketServer(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BearerTokenBitbucket":                    schema_pkg_apis_application_v1alpha1_BearerTokenBitbucket(ref),
@@ -76,12 +89,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator":                       schema_pkg_apis_application_v1alpha1_DuckTypeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.EnvEntry":                                schema_pkg_apis_application_v1alpha1_EnvEntry(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ExecProviderConfig":                      schema_pkg_apis_application_v1alpha1_ExecProviderConfig(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference":                      schema_pkg_apis_application_v1alpha1_GeneratorReference(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplate":                       schema_pkg_apis_application_v1alpha1_GeneratorTemplate(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplateList":                   schema_pkg_apis_application_v1alpha1_GeneratorTemplateList(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplateSpec":                   schema_pkg_apis_application_v1alpha1_GeneratorTemplateSpec(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitDirectoryGeneratorItem":               schema_pkg_apis_application_v1alpha1_GitDirectoryGeneratorItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitFileGeneratorItem":                    schema_pkg_apis_application_v1alpha1_GitFileGeneratorItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator":                            schema_pkg_apis_application_v1alpha1_GitGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitTagsGeneratorItem":                    schema_pkg_apis_application_v1alpha1_GitTagsGeneratorItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKey":                          schema_pkg_apis_application_v1alpha1_GnuPGPublicKey(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKeyList":                      schema_pkg_apis_application_v1alpha1_GnuPGPublicKeyList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus":                            schema_pkg_apis_application_v1alpha1_HealthStatus(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator":                      schema_pkg_apis_application_v1alpha1_HelmChartGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmFileParameter":                       schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmOptions":                             schema_pkg_apis_application_v1alpha1_HelmOptions(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmParameter":                           schema_pkg_apis_application_v1alpha1_HelmParameter(ref),
@@ -95,6 +114,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTTokens":                               schema_pkg_apis_application_v1alpha1_JWTTokens(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JsonnetVar":                              schema_pkg_apis_application_v1alpha1_JsonnetVar(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KnownTypeField":                          schema_pkg_apis_application_v1alpha1_KnownTypeField(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator":             schema_pkg_apis_application_v1alpha1_KubernetesResourceGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizeGvk":                            schema_pkg_apis_application_v1alpha1_KustomizeGvk(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizeOptions":                        schema_pkg_apis_application_v1alpha1_KustomizeOptions(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizePatch":                          schema_pkg_apis_application_v1alpha1_KustomizePatch(ref),
@@ -107,6 +127,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator":                          schema_pkg_apis_application_v1alpha1_MergeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMatrixGenerator":                   schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMergeGenerator":                    schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator":                            schema_pkg_apis_application_v1alpha1_OCIGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGeneratorFilter":                      schema_pkg_apis_application_v1alpha1_OCIGeneratorFilter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation":                               schema_pkg_apis_application_v1alpha1_Operation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator":                      schema_pkg_apis_application_v1alpha1_OperationInitiator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState":                          schema_pkg_apis_application_v1alpha1_OperationState(ref),
@@ -120,10 +142,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginInput":                             schema_pkg_apis_application_v1alpha1_PluginInput(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole":                             schema_pkg_apis_application_v1alpha1_ProjectRole(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator":                    schema_pkg_apis_application_v1alpha1_PullRequestGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAWSCodeCommit":       schema_pkg_apis_application_v1alpha1_PullRequestGeneratorAWSCodeCommit(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAzureDevOps":         schema_pkg_apis_application_v1alpha1_PullRequestGeneratorAzureDevOps(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorBitbucket":           schema_pkg_apis_application_v1alpha1_PullRequestGeneratorBitbucket(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorBitbucketServer":     schema_pkg_apis_application_v1alpha1_PullRequestGeneratorBitbucketServer(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorFilter":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorFilter(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGerrit":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGerrit(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitLab":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitLab(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitea":               schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitea(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGithub":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGithub(ref),
@@ -139,6 +163,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActionParam":                     schema_pkg_apis_application_v1alpha1_ResourceActionParam(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActions":                         schema_pkg_apis_application_v1alpha1_ResourceActions(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDiff":                            schema_pkg_apis_application_v1alpha1_ResourceDiff(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDrift":                           schema_pkg_apis_application_v1alpha1_ResourceDrift(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDriftManager":                    schema_pkg_apis_application_v1alpha1_ResourceDriftManager(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences":               schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNetworkingInfo":                  schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNode":                            schema_pkg_apis_application_v1alpha1_ResourceNode(ref),
@@ -149,6 +175,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy":                           schema_pkg_apis_application_v1alpha1_RetryStrategy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory":                         schema_pkg_apis_application_v1alpha1_RevisionHistory(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionMetadata":                        schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RollbackOnDegraded":                      schema_pkg_apis_application_v1alpha1_RollbackOnDegraded(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator":                    schema_pkg_apis_application_v1alpha1_SCMProviderGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorAWSCodeCommit":       schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorAWSCodeCommit(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorAzureDevOps":         schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorAzureDevOps(ref),
@@ -166,6 +193,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperation":                           schema_pkg_apis_application_v1alpha1_SyncOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource":                   schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult":                     schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlan":                                schema_pkg_apis_application_v1alpha1_SyncPlan(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanResource":                        schema_pkg_apis_application_v1alpha1_SyncPlanResource(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanStep":                            schema_pkg_apis_application_v1alpha1_SyncPlanStep(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy":                              schema_pkg_apis_application_v1alpha1_SyncPolicy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated":                     schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSource":                              schema_pkg_apis_application_v1alpha1_SyncSource(ref),
@@ -174,6 +204,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyApply":                       schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook":                        schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow":                              schema_pkg_apis_application_v1alpha1_SyncWindow(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowCalendar":                      schema_pkg_apis_application_v1alpha1_SyncWindowCalendar(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowCalendarEvent":                 schema_pkg_apis_application_v1alpha1_SyncWindowCalendarEvent(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowPeriod":                        schema_pkg_apis_application_v1alpha1_SyncWindowPeriod(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TLSClientConfig":                         schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TagFilter":                               schema_pkg_apis_application_v1alpha1_TagFilter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.objectMeta":                              schema_pkg_apis_application_v1alpha1_objectMeta(ref),
@@ -623,6 +656,85 @@ func schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationDependency is a reference to an Application which another Application depends on",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Application",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the Application. Defaults to the namespace of the dependent Application.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationDependencyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationDependencyStatus holds the state of a dependency which blocks the sync of an Application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Application",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the Application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStatus is the sync status of the Application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"healthStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthStatus is the health status of the Application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the dependency is not satisfied",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -926,12 +1038,32 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetApplicationStatus(ref co
 							},
 						},
 					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis contains the results of the analysis gates of the step this Application belongs to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysisStatus"),
+									},
+								},
+							},
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval contains the decision on the manual approval of the step this Application belongs to",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutApprovalStatus"),
+						},
+					},
 				},
 				Required: []string{"application", "message", "status", "step", "targetRevisions"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysisStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutApprovalStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -989,6 +1121,131 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetCondition(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationSetExpiration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetExpiration configures the deletion of the generated Applications which are not used anymore, e.g. the preview environments of stale pull requests. An expired Application is not generated again until its generated spec changes, e.g. a new commit is pushed to the pull request, or until a schedule window begins.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"idleTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleTTL is the duration, e.g. 72h, after which an idle Application is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idleSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleSince is the activity from which an Application is considered idle: the last commit of its synced revision, or its last sync. Defaults to commit.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules are the windows during which the Applications are kept, the Applications are deleted outside of them",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpirationSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpirationSchedule"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetExpirationSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetExpirationSchedule is a window during which the generated Applications are kept",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the time the window will begin, specified in cron format",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the amount of time the window will be open",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone of the schedule",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetExpiredApplication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetExpiredApplication is an Application deleted by the expiration policy of the ApplicationSet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Application",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason why the Application expired, Idle or Schedule",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"specHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SpecHash is the hash of the generated spec of the Application when it expired",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiredAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiredAt is the time the Application expired",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "reason", "expiredAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1047,11 +1304,54 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref common.Ref
 							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator"),
 						},
 					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator"),
+						},
+					},
+					"helmChart": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator"),
+						},
+					},
+					"kubernetesResource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator"),
+						},
+					},
+					"generatorRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratorRef references a generator definition of the ApplicationSet",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MatrixGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MatrixGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetImport references a GeneratorTemplate imported by an ApplicationSet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the GeneratorTemplate, in the namespace of the ApplicationSet",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
 	}
 }
 
@@ -1164,11 +1464,32 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetNestedGenerator(ref comm
 							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator"),
 						},
 					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator"),
+						},
+					},
+					"helmChart": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator"),
+						},
+					},
+					"kubernetesResource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator"),
+						},
+					},
+					"generatorRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratorRef references a generator definition of the ApplicationSet",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -1222,44 +1543,314 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetResourceIgnoreDifference
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ApplicationSetRolloutAnalysis is a gate of a rollout step. Exactly one of Prometheus, HTTP or Pause must be set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"matchExpressions": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression"),
-									},
-								},
-							},
+							Description: "Name identifies the gate in the ApplicationSet status",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxUpdate": {
+					"prometheus": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Prometheus passes when the result of a Prometheus query is non-empty and none of its values is zero",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPrometheusAnalysis"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP passes when a request to an HTTP endpoint returns a 2xx status code",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutHTTPAnalysis"),
+						},
+					},
+					"pause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pause passes once the given duration has elapsed",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPauseAnalysis"),
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between two evaluations of a Prometheus or HTTP gate. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\"). Defaults to 30s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of consecutive successful evaluations required for a Prometheus or HTTP gate to pass. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"failureLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureLimit is the number of consecutive failed evaluations of a Prometheus or HTTP gate which are tolerated before the gate fails. Defaults to 0, which fails the gate on the first failed evaluation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutHTTPAnalysis", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPauseAnalysis", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPrometheusAnalysis"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysisStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetRolloutAnalysisStatus contains the result of a rollout step analysis gate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the gate",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the gate",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains human-readable details about the result of the gate",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time the gate started being evaluated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedAt is the time the gate passed or failed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"successes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Successes is the number of consecutive successful evaluations of the gate",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"failures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failures is the number of consecutive failed evaluations of the gate",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastEvaluatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastEvaluatedAt is the time the gate was last evaluated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutApprovalStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetRolloutApprovalStatus contains the decision on a rollout step which requires manual approval",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the decision taken on the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the name of the user who took the decision",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time the decision was taken",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutHTTPAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetRolloutHTTPAnalysis sends a request to an HTTP endpoint",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the endpoint to send the request to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the HTTP method of the request, either GET or HEAD. Defaults to GET.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutPauseAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetRolloutPauseAnalysis pauses the rollout for a given amount of time",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the amount of time to pause for. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\")",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"duration"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutPrometheusAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetRolloutPrometheusAnalysis runs an instant query against a Prometheus server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address is the URL of the Prometheus server, e.g. http://prometheus.monitoring.svc:9090",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the PromQL query to run. Comparison operators filter out failing samples, so a query such as `sum(rate(http_requests_total{code=~\"5..\"}[5m])) < 1` only passes while the error rate stays below the threshold.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"address", "query"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"matchExpressions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression"),
+									},
+								},
+							},
+						},
+					},
+					"maxUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis is a list of gates which are evaluated in order once all Applications of the step are Healthy. The rollout only moves on to the next step after every gate has passed, and halts if a gate fails.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysis"),
+									},
+								},
+							},
+						},
+					},
+					"manualApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManualApproval pauses the rollout before the Applications of this step are synced, until the step is promoted with `argocd appset rollout promote` or aborted with `argocd appset rollout abort`",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysis", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
 					"steps": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -1344,7 +1935,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref common.Referenc
 					},
 					"applyNestedSelectors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ApplyNestedSelectors enables selectors defined within the generators of two level-nested matrix or merge generators",
+							Description: "ApplyNestedSelectors enables selectors defined within the generators of two level-nested matrix or merge generators.\n\nDeprecated: This field is ignored, and the behavior is always enabled. The field will be removed in a future version of the ApplicationSet CRD.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -1368,12 +1959,41 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref common.Referenc
 							Format: "",
 						},
 					},
+					"generatorDefinitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratorDefinitions are named generators which can be referenced by the generators of the ApplicationSet, at any level of nesting, with a generator reference. They allow to nest combination-type generators beyond the two levels supported by the schema of the generators. Their schema is not part of the CRD, which would otherwise exceed the size limits of the Kubernetes API, and they are validated on generation instead.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator"),
+									},
+								},
+							},
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports are the GeneratorTemplates of the namespace of the ApplicationSet whose generator definitions and template are imported by the ApplicationSet",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetImport"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"generators", "template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetImport", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
 	}
 }
 
@@ -1425,11 +2045,39 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetStatus(ref common.Refere
 							},
 						},
 					},
+					"resourcesCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourcesCount is the total number of resources managed by this application set. The count may be higher than actual number of items in the Resources field when the number of managed resources exceeds the limit imposed by the controller (to avoid making the status field too large).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"health": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Health contains information about the applicationset's current health status based on the applicationset conditions",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus"),
+						},
+					},
+					"expiredApplications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiredApplications are the Applications deleted by the expiration policy, which are not generated again until their generated spec changes or until a schedule window begins",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpiredApplication"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpiredApplication", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"},
 	}
 }
 
@@ -1480,9 +2128,17 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetSyncPolicy(ref common.Re
 							Format:      "",
 						},
 					},
+					"expiration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expiration deletes the generated Applications which are idle for longer than a TTL, or which are outside of a schedule. The Applications are only deleted if the policy allows the deletion of Applications.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpiration"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetExpiration"},
 	}
 }
 
@@ -1587,7 +2243,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetTerminalGenerator(ref co
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationSetTerminalGenerator represents a generator nested within a nested generator (for example, a list within a merge within a matrix). A generator at this level may not be a combination-type generator (MatrixGenerator or MergeGenerator). ApplicationSet enforces this nesting depth limit because CRDs do not support recursive types. https://github.com/kubernetes-sigs/controller-tools/issues/477",
+				Description: "ApplicationSetTerminalGenerator represents a generator nested within a nested generator (for example, a list within a merge within a matrix). A generator at this level may not be a combination-type generator (MatrixGenerator or MergeGenerator). ApplicationSet enforces this nesting depth limit because CRDs do not support recursive types. https://github.com/kubernetes-sigs/controller-tools/issues/477 Deeper compositions are expressed with references to the generator definitions of the ApplicationSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"list": {
@@ -1631,11 +2287,32 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetTerminalGenerator(ref co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator"),
+						},
+					},
+					"helmChart": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator"),
+						},
+					},
+					"kubernetesResource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator"),
+						},
+					},
+					"generatorRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratorRef references a generator definition of the ApplicationSet",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorReference", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmChartGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KubernetesResourceGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -2329,12 +3006,26 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn is a list of Applications which must be Synced and Healthy before this application is automatically synced",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"destination", "project"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
 	}
 }
 
@@ -2469,11 +3160,17 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus"),
 						},
 					},
+					"automatedRollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomatedRollback holds information about the last rollback performed because the application became Degraded",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AutomatedRollbackStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AutomatedRollbackStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2555,55 +3252,121 @@ func schema_pkg_apis_application_v1alpha1_ApplicationTree(ref common.ReferenceCa
 							},
 						},
 					},
-					"hosts": {
+					"hosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hosts holds list of Kubernetes nodes that run application related pods",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo"),
+									},
+								},
+							},
+						},
+					},
+					"blockingDependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockingDependencies contains the Applications which the application depends on and which are not yet Synced and Healthy, preventing the application from being automatically synced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependencyStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependencyStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNode"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationWatchEvent contains information about application change.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"application": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Application is:\n * If Type is Added or Modified: the new state of the object.\n * If Type is Deleted: the state of the object immediately before deletion.\n * If Type is Error: *api.Status is recommended; other types may make sense\n   depending on context.",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application"),
+						},
+					},
+				},
+				Required: []string{"type", "application"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_AutomatedRollbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomatedRollbackStatus contains information about an automated rollback of a Degraded application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision which was rolled back. Automated sync is paused while it is still the desired revision.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revisions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hosts holds list of Kubernetes nodes that run application related pods",
+							Description: "Revisions are the revisions of each source which were rolled back in case of a multi-source application",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNode"},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationWatchEvent contains information about application change.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"historyID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "HistoryID is the ID of the revision history entry the application was rolled back to",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"application": {
+					"rolledBackAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Application is:\n * If Type is Added or Modified: the new state of the object.\n * If Type is Deleted: the state of the object immediately before deletion.\n * If Type is Error: *api.Status is recommended; other types may make sense\n   depending on context.",
-							Default:     map[string]any{},
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application"),
+							Description: "RolledBackAt is the time the rollback was initiated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"type", "application"},
+				Required: []string{"historyID", "rolledBackAt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -3562,6 +4325,155 @@ func schema_pkg_apis_application_v1alpha1_ExecProviderConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_GeneratorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorReference references a generator definition of the ApplicationSet by name. The parameters of the referenced generator are filtered by the selector of the referencing generator, if any.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the generator definition",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_GeneratorTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorTemplate is a library of generator definitions and of an Application template, which the ApplicationSets of its namespace can import.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplateSpec"),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_GeneratorTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorTemplateList contains a list of GeneratorTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GeneratorTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_GeneratorTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorTemplateSpec holds the generator definitions and the template imported by ApplicationSets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generatorDefinitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratorDefinitions are named generators which the generators of the importing ApplicationSets can reference. The generator definitions of an ApplicationSet take precedence over the imported ones.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator"),
+									},
+								},
+							},
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is merged into the template of the importing ApplicationSets: it sets the fields which are not set by their template. The project of the Applications is never imported.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_GitDirectoryGeneratorItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3688,12 +4600,59 @@ func schema_pkg_apis_application_v1alpha1_GitGenerator(ref common.ReferenceCallb
 							},
 						},
 					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags, if set, generates parameters for the tags of the repository which match the filters, rather than for the directories or the files of a revision.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitTagsGeneratorItem"),
+						},
+					},
 				},
 				Required: []string{"repoURL", "revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitDirectoryGeneratorItem", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitFileGeneratorItem"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitDirectoryGeneratorItem", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitFileGeneratorItem", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitTagsGeneratorItem"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_GitTagsGeneratorItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GitTagsGeneratorItem defines which tags of the repository the Git generator generates parameters for.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"match": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Match is a regex which the names of the tags must match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"versionConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionConstraint is a semantic version range the tags must satisfy, e.g. \">=1.2.0 <2.0.0\". If set, tags which are not semantic versions are ignored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"latest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latest, if greater than zero, only considers the given number of highest semantic versions which match the filters.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"releaseLine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReleaseLine, if set, only considers the highest semantic version of each major or minor release line, e.g. to generate parameters for the last three minor versions with Latest set to 3.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3798,29 +4757,111 @@ func schema_pkg_apis_application_v1alpha1_HealthStatus(ref common.ReferenceCallb
 				Properties: map[string]spec.Schema{
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status holds the status code of the application or resource",
+							Description: "Status holds the status code of the application or resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable informational message describing the health status",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time the HealthStatus was set",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmChartGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmChartGenerator defines a generator that lists the versions of the charts of a Helm repository.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the Helm repository, either a repository with an index.yaml or an OCI repository. Credentials are looked up in the repositories and repository credentials of Argo CD.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"charts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Charts are the names of the charts to list the versions of. Required for OCI repositories. If empty, all the charts of the index of the repository are listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"versionConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionConstraint is a semantic version range the versions must satisfy, e.g. \">=1.2.0 <2.0.0\". If set, versions which are not semantic versions are ignored.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"latestVersions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is a human-readable informational message describing the health status",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LatestVersions, if greater than zero, only considers the given number of highest semantic versions of each chart which satisfy the version constraint.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"lastTransitionTime": {
+					"requeueAfterSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastTransitionTime is the time the HealthStatus was set",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Standard parameters.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values contains key/value pairs which are passed directly as parameters to the template",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"repoURL"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
 	}
 }
 
@@ -4250,6 +5291,110 @@ func schema_pkg_apis_application_v1alpha1_KnownTypeField(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_KubernetesResourceGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubernetesResourceGenerator defines a generator that lists arbitrary Kubernetes objects, on the cluster of the ApplicationSet controller or on the clusters registered with Argo CD.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the API version of the objects, e.g. \"v1\" or \"example.com/v1alpha1\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the objects, e.g. \"Namespace\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace to list namespaced objects from. If empty, the objects of all namespaces are listed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the objects by their labels.",
+							Default:     map[string]any{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"fieldSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldSelector selects the objects by their fields, e.g. \"metadata.name=team-a\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterSelector, if set, lists the objects on each cluster registered with Argo CD which matches the selector, rather than on the cluster of the ApplicationSet controller. An empty selector matches all the clusters.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters are JSONPath expressions evaluated against each object, e.g. \"{.metadata.labels.team}\", whose results are passed as parameters to the template under the given names.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"requeueAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard parameters.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values contains key/value pairs which are passed directly as parameters to the template",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_KustomizeGvk(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4685,37 +5830,160 @@ func schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref common.Refere
 				Properties: map[string]spec.Schema{
 					"generators": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTerminalGenerator"),
-									},
-								},
-							},
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTerminalGenerator"),
+									},
+								},
+							},
+						},
+					},
+					"mergeKeys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"generators", "mergeKeys"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTerminalGenerator"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OCIGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCIGenerator defines a generator that lists the repositories and the tags of an OCI registry namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the registry namespace, e.g. oci://registry.example.com/charts. Credentials are looked up in the repositories and repository credentials of Argo CD.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repositories": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repositories are the names of the repositories, relative to the namespace, to list the tags of. If empty, the repositories are listed with the catalog API of the registry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filters for which repositories and tags should be considered. A tag is considered if it matches any filter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGeneratorFilter"),
+									},
+								},
+							},
+						},
+					},
+					"latest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latest, if true, only considers the highest semantic version tag of each repository which matches the filters.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"requeueAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard parameters.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values contains key/value pairs which are passed directly as parameters to the template",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"repoURL"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCIGeneratorFilter"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OCIGeneratorFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCIGeneratorFilter is a single repository and tag filter. If multiple filter types are set on a single struct, they will be AND'd together. All filters must pass for a tag to be included.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryMatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A regex for repository names, relative to the namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tagMatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A regex for tags.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"mergeKeys": {
+					"versionConstraint": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "A semantic version constraint tags must satisfy, e.g. \">=1.2.0 <2.0.0\". Tags which are not semantic versions do not satisfy any constraint.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"generators", "mergeKeys"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTerminalGenerator"},
 	}
 }
 
@@ -5285,11 +6553,81 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGenerator(ref common.Refere
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAzureDevOps"),
 						},
 					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values contains key/value pairs which are passed directly as parameters to the template",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"continueOnRepoNotFoundError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinueOnRepoNotFoundError is a flag to continue the ApplicationSet Pull Request generator parameters generation even if the repository is not found.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"awsCodeCommit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional providers to use and config for them.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAWSCodeCommit"),
+						},
+					},
+					"gerrit": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGerrit"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAzureDevOps", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorBitbucket", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorBitbucketServer", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorFilter", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitLab", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitea", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGithub"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAWSCodeCommit", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAzureDevOps", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorBitbucket", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorBitbucketServer", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorFilter", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGerrit", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitLab", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitea", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGithub"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorAWSCodeCommit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PullRequestGeneratorAWSCodeCommit defines connection info specific to AWS CodeCommit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CodeCommit repository name to scan. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role provides the AWS IAM role to assume, for cross-account access to the repository if not provided, AppSet controller will use its pod/node identity.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region provides the AWS region of the repository. if not provided, AppSet controller will infer the current region from environment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"repository"},
+			},
+		},
 	}
 }
 
@@ -5496,9 +6834,118 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorFilter(ref common.
 							Format: "",
 						},
 					},
+					"titleMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"draft": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Draft selects the draft pull requests if true, or the ready for review ones if false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"approved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approved selects the pull requests approved by reviewers if true, or the ones which are not if false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"checksStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChecksStatus selects the pull requests whose status checks have the given combined status.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pathsChanged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "An array of glob patterns, e.g. services/foo/**, at least one of which must match a file changed by the pull request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"updatedWithin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedWithin selects the pull requests updated within the given duration, e.g. 168h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGerrit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PullRequestGeneratorGerrit defines connection info specific to Gerrit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"api": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Gerrit URL to talk to. For example https://gerrit.mydomain.com/. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gerrit project to scan. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials for Basic auth, with the HTTP password of the Gerrit account. If not set, changes are listed anonymously.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is used to filter the changes that you want to target, by hashtag",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow self-signed TLS / Certificates; default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"api", "project"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer"},
 	}
 }
 
@@ -6421,35 +7868,162 @@ func schema_pkg_apis_application_v1alpha1_ResourceDiff(ref common.ReferenceCallb
 							Format: "",
 						},
 					},
-					"normalizedLiveState": {
+					"normalizedLiveState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NormalizedLiveState contains JSON serialized live resource state with applied normalizations",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"predictedLiveState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PredictedLiveState contains JSON serialized resource state that is calculated based on normalized and target resource state",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"modified": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceDrift contains the changes made to a live resource outside of Argo CD",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group specifies the API group of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind specifies the API kind of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace specifies the namespace of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name specifies the name of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields contains the paths of the fields of the live resource which differ from the target state, e.g. spec.replicas",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"managers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Managers contains the field managers of the changed fields, as recorded in the managed fields of the live resource",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDriftManager"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDriftManager"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceDriftManager(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceDriftManager is a field manager which changed fields of a live resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manager": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NormalizedLiveState contains JSON serialized live resource state with applied normalizations",
+							Description: "Manager is the name of the field manager, e.g. kubectl-edit",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"predictedLiveState": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PredictedLiveState contains JSON serialized resource state that is calculated based on normalized and target resource state",
+							Description: "Operation is the type of operation which changed the fields: Apply or Update",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceVersion": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Time is the time the fields were last changed by the manager",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"modified": {
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Fields contains the paths of the changed fields owned by the manager",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"manager"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6926,6 +8500,13 @@ func schema_pkg_apis_application_v1alpha1_ResourceResult(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is the number of times applying the resource was retried after a transient error",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"group", "version", "kind", "namespace", "name"},
 			},
@@ -7119,6 +8700,13 @@ func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator"),
 						},
 					},
+					"healthStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthStatus is the application health observed after the sync to this revision. It is only recorded for applications with automated rollback on degraded health enabled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"deployedAt", "id"},
 			},
@@ -7186,6 +8774,33 @@ func schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_RollbackOnDegraded(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackOnDegraded controls the automated rollback of applications which become Degraded after a sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the amount of time after a sync during which a Degraded health status triggers a rollback. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\"). Defaults to 5m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fallbackToUnverifiedRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FallbackToUnverifiedRevision allows rolling back to the most recent earlier revision whose health was never recorded, e.g. because it was deployed before rollback was enabled, if no earlier revision was observed Healthy. Revisions which were observed Degraded are never rolled back to.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SCMProviderGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7977,157 +9592,360 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 					},
 					"sources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sources overrides the source definition set in the application. This is typically set in a Rollback operation and is nil during a Sync operation",
+							Description: "Sources overrides the source definition set in the application. This is typically set in a Rollback operation and is nil during a Sync operation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+									},
+								},
+							},
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions is the list of revision (Git) or chart version (Helm) which to sync each source in sources field for the application to If omitted, will use the revision specified in app spec.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan will perform a `kubectl apply --dry-run=server` of the resources and record the changes the sync would make in the plan of the sync result. It implies DryRun.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"planID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanID is the ID of the sync plan this sync applies. The sync fails if the target revision or the live state of the resources changed since the plan was previewed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"drift": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drift contains the changes made to the live resources outside of Argo CD, which are corrected by a self-heal sync",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDrift"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDrift", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncOperationResource contains resources to sync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncOperationResult represent result of sync operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains a list of sync result items for each individual resource in a sync operation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"),
+									},
+								},
+							},
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision holds the revision this sync operation was performed to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source records the application source information of the sync, used for comparing auto-sync",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source records the application source information of the sync, used for comparing auto-sync",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+									},
+								},
+							},
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions holds the revision this sync operation was performed for respective indexed source in sources field",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"managedNamespaceMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedNamespaceMetadata contains the current sync state of managed namespace metadata",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
+						},
+					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan contains the changes the sync would make, if the sync operation was a plan",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlan"),
+						},
+					},
+				},
+				Required: []string{"revision"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlan"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPlan contains the changes a sync would make to the resources of an application, as predicted by a dry-run of the sync validated by the API server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps contains the phases and waves of the sync, in the order they would run",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanStep"),
 									},
 								},
 							},
 						},
 					},
-					"revisions": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Revisions is the list of revision (Git) or chart version (Helm) which to sync each source in sources field for the application to If omitted, will use the revision specified in app spec.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ID identifies the target revision, the target manifests and the live state of the resources the plan was previewed for. It is used to apply exactly the previewed plan.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanStep"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_SyncPlanResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncOperationResource contains resources to sync.",
+				Description: "SyncPlanResource contains the change a sync would make to a resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"group": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Group specifies the API group of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version specifies the API version of the resource",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind specifies the API kind of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace specifies the namespace of the resource",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name specifies the name of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"namespace": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Action is the change the sync would make to the resource: Create, Patch, Replace, Prune or Hook",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hookType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HookType specifies the type of the hook. Empty for non-hook resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"modified": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Modified is true if the live state of the resource differs from its target state",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the result of the dry-run of the change",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains the message of the dry-run of the change",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kind", "name"},
+				Required: []string{"kind", "name", "action"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_SyncPlanStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncOperationResult represent result of sync operation",
+				Description: "SyncPlanStep contains the changes a sync would make in a wave of a sync phase",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources contains a list of sync result items for each individual resource in a sync operation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"),
-									},
-								},
-							},
-						},
-					},
-					"revision": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Revision holds the revision this sync operation was performed to",
+							Description: "Phase is the sync phase the changes would be made in",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"source": {
+					"wave": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source records the application source information of the sync, used for comparing auto-sync",
-							Default:     map[string]any{},
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+							Description: "Wave is the sync wave the changes would be made in",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"sources": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source records the application source information of the sync, used for comparing auto-sync",
+							Description: "Resources contains the changes to the resources of the wave",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
-									},
-								},
-							},
-						},
-					},
-					"revisions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Revisions holds the revision this sync operation was performed for respective indexed source in sources field",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanResource"),
 									},
 								},
 							},
 						},
 					},
-					"managedNamespaceMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ManagedNamespaceMetadata contains the current sync state of managed namespace metadata",
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
-						},
-					},
 				},
-				Required: []string{"revision"},
+				Required: []string{"phase", "wave"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanResource"},
 	}
 }
 
@@ -8207,9 +10025,17 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref common.Referen
 							Format:      "",
 						},
 					},
+					"rollbackOnDegraded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollbackOnDegraded rolls the application back to the last healthy revision when it becomes Degraded shortly after a sync",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RollbackOnDegraded"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RollbackOnDegraded"},
 	}
 }
 
@@ -8457,16 +10283,165 @@ func schema_pkg_apis_application_v1alpha1_SyncWindow(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description of the sync that will be applied to the schedule, can be used to add any information such as a ticket number for example",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncOverrun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncOverrun allows ongoing syncs to continue in two scenarios: For deny windows: allows syncs that started before the deny window became active to continue running For allow windows: allows syncs that started during the allow window to continue after the window ends",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"calendar": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Calendar references an iCal calendar whose events define when the window is active. It is used instead of the schedule and duration of the window.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowCalendar"),
+						},
+					},
+					"activeEvent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveEvent is the calendar event which makes the window active. It is only set in the active windows returned by the API and is ignored in the project spec.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowCalendarEvent"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowCalendar", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowCalendarEvent"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncWindowCalendar(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncWindowCalendar references an iCal (RFC 5545) calendar. The sync window is active during each event of the calendar.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTP(S) URL of the iCal feed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapKeyRef references the key of a ConfigMap in the Argo CD namespace which contains the iCal feed",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncWindowCalendarEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncWindowCalendarEvent is an occurrence of an event of a sync window calendar",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is the unique identifier of the event in the calendar",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is the summary of the event",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time the occurrence of the event starts",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the time the occurrence of the event ends",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncWindowPeriod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncWindowPeriod is a period of time during which the sync windows of an application consistently allow or deny syncs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time the period starts",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the time the period ends",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"autoSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoSync is true if automated syncs are allowed during the period",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"manualSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManualSync is true if manual syncs are allowed during the period",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"syncOverrun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SyncOverrun allows syncs that started before this deny window to continue running",
+							Description: "SyncOverrun is true if syncs are denied during the period, but a sync which started while syncs were allowed may continue",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes the sync windows which deny automated syncs during the period",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"start", "end", "autoSync", "manualSync"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -100,6 +100,39 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of Applications which must be Synced and Healthy before this application is automatically synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency is a reference to an Application which another Application depends on
+type ApplicationDependency struct {
+	// Name is the name of the Application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Application. Defaults to the namespace of the dependent Application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+}
+
+// ApplicationDependencyStatus holds the state of a dependency which blocks the sync of an Application
+type ApplicationDependencyStatus struct {
+	// Name is the name of the Application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Application
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// SyncStatus is the sync status of the Application
+	SyncStatus SyncStatusCode `json:"syncStatus,omitempty" protobuf:"bytes,3,opt,name=syncStatus,casttype=SyncStatusCode"`
+	// HealthStatus is the health status of the Application
+	HealthStatus health.HealthStatusCode `json:"healthStatus,omitempty" protobuf:"bytes,4,opt,name=healthStatus"`
+	// Message explains why the dependency is not satisfied
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// GetNamespace returns the namespace of the dependency, defaulting to the given namespace of the dependent Application
+func (d ApplicationDependency) GetNamespace(defaultNamespace string) string {
+	if d.Namespace == "" {
+		return defaultNamespace
+	}
+	return d.Namespace
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -1247,6 +1280,28 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// AutomatedRollback holds information about the last rollback performed because the application became Degraded
+	AutomatedRollback *AutomatedRollbackStatus `json:"automatedRollback,omitempty" protobuf:"bytes,15,opt,name=automatedRollback"`
+}
+
+// AutomatedRollbackStatus contains information about an automated rollback of a Degraded application
+type AutomatedRollbackStatus struct {
+	// Revision is the revision which was rolled back. Automated sync is paused while it is still the desired revision.
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// Revisions are the revisions of each source which were rolled back in case of a multi-source application
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,opt,name=revisions"`
+	// HistoryID is the ID of the revision history entry the application was rolled back to
+	HistoryID int64 `json:"historyID" protobuf:"bytes,3,opt,name=historyID"`
+	// RolledBackAt is the time the rollback was initiated
+	RolledBackAt metav1.Time `json:"rolledBackAt" protobuf:"bytes,4,opt,name=rolledBackAt"`
+}
+
+// GetRevisions returns the rolled back revisions, for both single and multiple source applications
+func (r *AutomatedRollbackStatus) GetRevisions() []string {
+	if len(r.Revisions) > 0 {
+		return r.Revisions
+	}
+	return []string{r.Revision}
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1365,7 +1420,7 @@ type Operation struct {
 // DryRun returns true if an operation was requested to be performed in dry run mode
 func (o *Operation) DryRun() bool {
 	if o.Sync != nil {
-		return o.Sync.DryRun
+		return o.Sync.DryRun || o.Sync.Plan
 	}
 	return false
 }
@@ -1446,6 +1501,42 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// Plan will perform a `kubectl apply --dry-run=server` of the resources and record the changes the sync would make
+	// in the plan of the sync result. It implies DryRun.
+	Plan bool `json:"plan,omitempty" protobuf:"bytes,13,opt,name=plan"`
+	// PlanID is the ID of the sync plan this sync applies. The sync fails if the target revision or the live state of
+	// the resources changed since the plan was previewed.
+	PlanID string `json:"planID,omitempty" protobuf:"bytes,14,opt,name=planID"`
+	// Drift contains the changes made to the live resources outside of Argo CD, which are corrected by a self-heal sync
+	Drift []ResourceDrift `json:"drift,omitempty" protobuf:"bytes,15,rep,name=drift"`
+}
+
+// ResourceDrift contains the changes made to a live resource outside of Argo CD
+type ResourceDrift struct {
+	// Group specifies the API group of the resource
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind specifies the API kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace specifies the namespace of the resource
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Name specifies the name of the resource
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Fields contains the paths of the fields of the live resource which differ from the target state, e.g. spec.replicas
+	Fields []string `json:"fields,omitempty" protobuf:"bytes,5,rep,name=fields"`
+	// Managers contains the field managers of the changed fields, as recorded in the managed fields of the live resource
+	Managers []ResourceDriftManager `json:"managers,omitempty" protobuf:"bytes,6,rep,name=managers"`
+}
+
+// ResourceDriftManager is a field manager which changed fields of a live resource
+type ResourceDriftManager struct {
+	// Manager is the name of the field manager, e.g. kubectl-edit
+	Manager string `json:"manager" protobuf:"bytes,1,opt,name=manager"`
+	// Operation is the type of operation which changed the fields: Apply or Update
+	Operation string `json:"operation,omitempty" protobuf:"bytes,2,opt,name=operation"`
+	// Time is the time the fields were last changed by the manager
+	Time *metav1.Time `json:"time,omitempty" protobuf:"bytes,3,opt,name=time"`
+	// Fields contains the paths of the changed fields owned by the manager
+	Fields []string `json:"fields,omitempty" protobuf:"bytes,4,rep,name=fields"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1620,6 +1711,32 @@ type SyncPolicyAutomated struct {
 	AllowEmpty *bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
 	// Enable allows apps to explicitly control automated sync
 	Enabled *bool `json:"enabled,omitempty" protobuf:"bytes,4,opt,name=enabled"`
+	// RollbackOnDegraded rolls the application back to the last healthy revision when it becomes Degraded shortly after a sync
+	RollbackOnDegraded *RollbackOnDegraded `json:"rollbackOnDegraded,omitempty" protobuf:"bytes,5,opt,name=rollbackOnDegraded"`
+}
+
+// RollbackOnDegraded controls the automated rollback of applications which become Degraded after a sync
+type RollbackOnDegraded struct {
+	// Window is the amount of time after a sync during which a Degraded health status triggers a rollback.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to 5m.
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+	// FallbackToUnverifiedRevision allows rolling back to the most recent earlier revision whose health was never
+	// recorded, e.g. because it was deployed before rollback was enabled, if no earlier revision was observed Healthy.
+	// Revisions which were observed Degraded are never rolled back to.
+	FallbackToUnverifiedRevision bool `json:"fallbackToUnverifiedRevision,omitempty" protobuf:"varint,2,opt,name=fallbackToUnverifiedRevision"`
+}
+
+// GetWindow returns the configured rollback window, defaulting to DefaultRollbackOnDegradedWindow if not set.
+func (r *RollbackOnDegraded) GetWindow() (time.Duration, error) {
+	if r == nil || r.Window == "" {
+		return DefaultRollbackOnDegradedWindow, nil
+	}
+	return parseStringToDuration(r.Window)
+}
+
+// IsRollbackOnDegradedEnabled returns true if automated rollback of Degraded applications is configured
+func (a *SyncPolicyAutomated) IsRollbackOnDegradedEnabled() bool {
+	return a != nil && a.RollbackOnDegraded != nil
 }
 
 // GetPrune returns the value of Prune, defaulting to false if nil.
@@ -1773,6 +1890,52 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Plan contains the changes the sync would make, if the sync operation was a plan
+	Plan *SyncPlan `json:"plan,omitempty" protobuf:"bytes,7,opt,name=plan"`
+}
+
+// SyncPlan contains the changes a sync would make to the resources of an application, as predicted by a dry-run of
+// the sync validated by the API server
+type SyncPlan struct {
+	// Steps contains the phases and waves of the sync, in the order they would run
+	Steps []SyncPlanStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// ID identifies the target revision, the target manifests and the live state of the resources the plan was
+	// previewed for. It is used to apply exactly the previewed plan.
+	ID string `json:"id,omitempty" protobuf:"bytes,2,opt,name=id"`
+}
+
+// SyncPlanStep contains the changes a sync would make in a wave of a sync phase
+type SyncPlanStep struct {
+	// Phase is the sync phase the changes would be made in
+	Phase synccommon.SyncPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Wave is the sync wave the changes would be made in
+	Wave int64 `json:"wave" protobuf:"bytes,2,opt,name=wave"`
+	// Resources contains the changes to the resources of the wave
+	Resources []SyncPlanResource `json:"resources,omitempty" protobuf:"bytes,3,rep,name=resources"`
+}
+
+// SyncPlanResource contains the change a sync would make to a resource
+type SyncPlanResource struct {
+	// Group specifies the API group of the resource
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Version specifies the API version of the resource
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// Kind specifies the API kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	// Namespace specifies the namespace of the resource
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	// Name specifies the name of the resource
+	Name string `json:"name" protobuf:"bytes,5,opt,name=name"`
+	// Action is the change the sync would make to the resource: Create, Patch, Replace, Prune or Hook
+	Action synccommon.SyncAction `json:"action" protobuf:"bytes,6,opt,name=action"`
+	// HookType specifies the type of the hook. Empty for non-hook resources
+	HookType synccommon.HookType `json:"hookType,omitempty" protobuf:"bytes,7,opt,name=hookType"`
+	// Modified is true if the live state of the resource differs from its target state
+	Modified bool `json:"modified,omitempty" protobuf:"bytes,8,opt,name=modified"`
+	// Status is the result of the dry-run of the change
+	Status synccommon.ResultCode `json:"status,omitempty" protobuf:"bytes,9,opt,name=status"`
+	// Message contains the message of the dry-run of the change
+	Message string `json:"message,omitempty" protobuf:"bytes,10,opt,name=message"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1800,6 +1963,8 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// Retries is the number of times applying the resource was retried after a transient error
+	Retries int64 `json:"retries,omitempty" protobuf:"varint,12,opt,name=retries"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1853,6 +2018,9 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// HealthStatus is the application health observed after the sync to this revision. It is only recorded for
+	// applications with automated rollback on degraded health enabled.
+	HealthStatus health.HealthStatusCode `json:"healthStatus,omitempty" protobuf:"bytes,11,opt,name=healthStatus"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1920,6 +2088,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionAutomatedRollbackWarning indicates that the application was rolled back because it became Degraded after a sync
+	ApplicationConditionAutomatedRollbackWarning = "AutomatedRollbackWarning"
+	// ApplicationConditionWaitingForDependencies indicates that the application is not automatically synced because its dependencies are not Synced and Healthy
+	ApplicationConditionWaitingForDependencies = "WaitingForDependencies"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2039,12 +2211,16 @@ type ApplicationTree struct {
 	// ShardsCount represents the total number of shards the application tree is split into.
 	// This is used to distribute resource processing across multiple shards.
 	ShardsCount int64 `json:"shardsCount,omitempty" protobuf:"bytes,4,opt,name=shardsCount"`
+	// BlockingDependencies contains the Applications which the application depends on and which are not yet
+	// Synced and Healthy, preventing the application from being automatically synced.
+	BlockingDependencies []ApplicationDependencyStatus `json:"blockingDependencies,omitempty" protobuf:"bytes,5,rep,name=blockingDependencies"`
 }
 
 func (t *ApplicationTree) Merge(other *ApplicationTree) {
 	t.Nodes = append(t.Nodes, other.Nodes...)
 	t.OrphanedNodes = append(t.OrphanedNodes, other.OrphanedNodes...)
 	t.Hosts = append(t.Hosts, other.Hosts...)
+	t.BlockingDependencies = append(t.BlockingDependencies, other.BlockingDependencies...)
 	t.Normalize()
 }
 
@@ -2090,6 +2266,7 @@ func (t *ApplicationTree) GetShards(size int64) []*ApplicationTree {
 	} else {
 		shards = []*ApplicationTree{{ShardsCount: 0}}
 	}
+	shards[0].BlockingDependencies = t.BlockingDependencies
 	return shards
 }
 
@@ -2952,6 +3129,93 @@ type SyncWindow struct {
 	// For deny windows: allows syncs that started before the deny window became active to continue running
 	// For allow windows: allows syncs that started during the allow window to continue after the window ends
 	SyncOverrun bool `json:"syncOverrun,omitempty" protobuf:"bytes,11,opt,name=syncOverrun"`
+	// Calendar references an iCal calendar whose events define when the window is active. It is used instead of the
+	// schedule and duration of the window.
+	Calendar *SyncWindowCalendar `json:"calendar,omitempty" protobuf:"bytes,12,opt,name=calendar"`
+	// ActiveEvent is the calendar event which makes the window active. It is only set in the active windows returned
+	// by the API and is ignored in the project spec.
+	ActiveEvent *SyncWindowCalendarEvent `json:"activeEvent,omitempty" protobuf:"bytes,13,opt,name=activeEvent"`
+}
+
+// SyncWindowCalendar references an iCal (RFC 5545) calendar. The sync window is active during each event of the calendar.
+type SyncWindowCalendar struct {
+	// URL is the HTTP(S) URL of the iCal feed
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// ConfigMapKeyRef references the key of a ConfigMap in the Argo CD namespace which contains the iCal feed
+	ConfigMapKeyRef *ConfigMapKeyRef `json:"configMapKeyRef,omitempty" protobuf:"bytes,2,opt,name=configMapKeyRef"`
+}
+
+// SyncWindowCalendarEvent is an occurrence of an event of a sync window calendar
+type SyncWindowCalendarEvent struct {
+	// UID is the unique identifier of the event in the calendar
+	UID string `json:"uid,omitempty" protobuf:"bytes,1,opt,name=uid"`
+	// Summary is the summary of the event
+	Summary string `json:"summary,omitempty" protobuf:"bytes,2,opt,name=summary"`
+	// Start is the time the occurrence of the event starts
+	Start metav1.Time `json:"start" protobuf:"bytes,3,opt,name=start"`
+	// End is the time the occurrence of the event ends
+	End metav1.Time `json:"end" protobuf:"bytes,4,opt,name=end"`
+}
+
+// SyncWindowPeriod is a period of time during which the sync windows of an application consistently allow or deny syncs
+type SyncWindowPeriod struct {
+	// Start is the time the period starts
+	Start metav1.Time `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the time the period ends
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// AutoSync is true if automated syncs are allowed during the period
+	AutoSync bool `json:"autoSync" protobuf:"varint,3,opt,name=autoSync"`
+	// ManualSync is true if manual syncs are allowed during the period
+	ManualSync bool `json:"manualSync" protobuf:"varint,4,opt,name=manualSync"`
+	// SyncOverrun is true if syncs are denied during the period, but a sync which started while syncs were allowed
+	// may continue
+	SyncOverrun bool `json:"syncOverrun,omitempty" protobuf:"varint,5,opt,name=syncOverrun"`
+	// Reason describes the sync windows which deny automated syncs during the period
+	Reason string `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
+}
+
+// SyncWindowCalendarLoader loads the events of sync window calendars
+type SyncWindowCalendarLoader interface {
+	// GetEvents returns the occurrences of the calendar events which are in progress at any time between from and
+	// to. Times of the calendar which have no time zone are interpreted in the given location.
+	GetEvents(calendar *SyncWindowCalendar, loc *time.Location, from time.Time, to time.Time) ([]SyncWindowCalendarEvent, error)
+}
+
+var syncWindowCalendarLoader SyncWindowCalendarLoader
+
+// SetSyncWindowCalendarLoader sets the loader used to evaluate sync windows backed by a calendar. Sync windows backed
+// by a calendar cannot be evaluated until a loader is set.
+func SetSyncWindowCalendarLoader(loader SyncWindowCalendarLoader) {
+	syncWindowCalendarLoader = loader
+}
+
+// Source returns the URL or the ConfigMap key the calendar is loaded from
+func (c *SyncWindowCalendar) Source() string {
+	if c.ConfigMapKeyRef != nil {
+		return fmt.Sprintf("configmap:%s/%s", c.ConfigMapKeyRef.ConfigMapName, c.ConfigMapKeyRef.Key)
+	}
+	return c.URL
+}
+
+// Validate checks whether the calendar references exactly one valid source
+func (c *SyncWindowCalendar) Validate() error {
+	if (c.URL == "") == (c.ConfigMapKeyRef == nil) {
+		return errors.New("calendar must specify exactly one of url and configMapKeyRef")
+	}
+	if c.ConfigMapKeyRef != nil {
+		if c.ConfigMapKeyRef.ConfigMapName == "" || c.ConfigMapKeyRef.Key == "" {
+			return errors.New("calendar configMapKeyRef requires configMapName and key")
+		}
+		return nil
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("cannot parse calendar url '%s': %w", c.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("calendar url '%s' must use http or https", c.URL)
+	}
+	return nil
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -2971,21 +3235,16 @@ func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
 
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, event, err := w.activeAt(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
+				if event != nil {
+					w = w.DeepCopy()
+					w.ActiveEvent = event
+				}
 				active = append(active, w)
 			}
 		}
@@ -3010,24 +3269,15 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
 			if w.Kind != "allow" {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, _, err := w.activeAt(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -3038,16 +3288,83 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 	return nil, nil
 }
 
-func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
+// activeAt returns true if the sync window is active at the given time. For windows backed by a calendar, the
+// calendar event which makes the window active is returned as well.
+func (w *SyncWindow) activeAt(currentTime time.Time) (bool, *SyncWindowCalendarEvent, error) {
+	if w.Calendar != nil {
+		if syncWindowCalendarLoader == nil {
+			return false, nil, fmt.Errorf("cannot load calendar '%s': sync window calendars are not supported", w.Calendar.Source())
+		}
+		events, err := syncWindowCalendarLoader.GetEvents(w.Calendar, w.timeZoneLocation(), currentTime, currentTime)
+		if err != nil {
+			return false, nil, fmt.Errorf("cannot load calendar '%s': %w", w.Calendar.Source(), err)
+		}
+		for i := range events {
+			if !currentTime.Before(events[i].Start.Time) && currentTime.Before(events[i].End.Time) {
+				return true, &events[i], nil
+			}
+		}
+		return false, nil, nil
+	}
+
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, sErr := specParser.Parse(w.Schedule)
+	if sErr != nil {
+		return false, nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+	}
+	duration, dErr := time.ParseDuration(w.Duration)
+	if dErr != nil {
+		return false, nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	}
+
+	// Offset the nextWindow time to consider the timeZone of the sync window
+	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil, nil
+}
+
+// timeZoneLocation returns the location of the time zone of the sync window, or UTC if the time zone is invalid
+func (w *SyncWindow) timeZoneLocation() *time.Location {
 	loc, err := time.LoadLocation(w.TimeZone)
 	if err != nil {
 		log.Warnf("Invalid time zone %s specified. Using UTC as default time zone", w.TimeZone)
-		loc = time.Now().UTC().Location()
+		loc = time.UTC
 	}
-	_, tzOffset := time.Now().In(loc).Zone()
+	return loc
+}
+
+func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
+	_, tzOffset := time.Now().In(w.timeZoneLocation()).Zone()
 	return time.Duration(tzOffset) * time.Second
 }
 
+// describe returns a human-readable description of the sync window, which names the active calendar event for
+// windows backed by a calendar
+func (w *SyncWindow) describe() string {
+	switch {
+	case w.ActiveEvent != nil:
+		return fmt.Sprintf("%s window calendar event '%s' (%s - %s)", w.Kind, w.ActiveEvent.Summary,
+			w.ActiveEvent.Start.UTC().Format(time.RFC3339), w.ActiveEvent.End.UTC().Format(time.RFC3339))
+	case w.Calendar != nil:
+		return fmt.Sprintf("%s window calendar '%s'", w.Kind, w.Calendar.Source())
+	default:
+		return fmt.Sprintf("%s window '%s' (%s)", w.Kind, w.Schedule, w.Duration)
+	}
+}
+
+// describe returns a human-readable description of the sync windows of the given kind
+func (w *SyncWindows) describe(kind string) string {
+	var descriptions []string
+	if w.HasWindows() {
+		for _, window := range *w {
+			if window.Kind == kind {
+				descriptions = append(descriptions, window.describe())
+			}
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
+
 // AddWindow adds a sync window with the given parameters to the AppProject
 func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string, syncOverrun bool) error {
 	if knd == "" || sch == "" || dur == "" {
@@ -3184,63 +3501,74 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 //  2. When an allow window ends: If the operation started during an allow window with syncOverrun enabled, the sync can continue
 //     even after the allow window has ended (and no other allow windows are active).
 func (w *SyncWindows) CanSync(isManual bool, operationStartTime *time.Time) (bool, error) {
+	canSync, _, err := w.CanSyncWithReason(isManual, operationStartTime)
+	return canSync, err
+}
+
+// CanSyncWithReason returns true if a sync window currently allows a sync, like CanSync. If the sync is blocked, it
+// also returns the reason, which names the sync windows and calendar events blocking the sync.
+func (w *SyncWindows) CanSyncWithReason(isManual bool, operationStartTime *time.Time) (bool, string, error) {
+	return w.canSyncWithReasonAt(isManual, operationStartTime, time.Now())
+}
+
+func (w *SyncWindows) canSyncWithReasonAt(isManual bool, operationStartTime *time.Time, currentTime time.Time) (bool, string, error) {
 	if !w.HasWindows() {
-		return true, nil
+		return true, "", nil
 	}
 
-	active, err := w.Active()
+	active, err := w.active(currentTime)
 	if err != nil {
-		return false, fmt.Errorf("invalid sync windows: %w", err)
+		return false, "", fmt.Errorf("invalid sync windows: %w", err)
 	}
 	hasActiveDeny, manualEnabled := active.hasDeny()
 
 	if hasActiveDeny {
 		if isManual && manualEnabled {
-			return true, nil
+			return true, "", nil
 		}
 
 		// Check if operation started before deny window and overrun is allowed
 		if operationStartTime != nil && !operationStartTime.IsZero() && active.denyAllowsOverrun() {
 			wasAllowed, err := w.canSyncAtTime(isManual, *operationStartTime)
 			if err != nil {
-				return false, err
+				return false, "", err
 			}
 			if wasAllowed {
-				return true, nil // Allow sync to continue (overrun into deny window)
+				return true, "", nil // Allow sync to continue (overrun into deny window)
 			}
 		}
 
-		return false, nil
+		return false, "active " + active.describe("deny"), nil
 	}
 
 	if active.hasAllow() {
-		return true, nil
+		return true, "", nil
 	}
 
-	inactiveAllows, err := w.InactiveAllows()
+	inactiveAllows, err := w.inactiveAllows(currentTime)
 	if err != nil {
-		return false, fmt.Errorf("invalid sync windows: %w", err)
+		return false, "", fmt.Errorf("invalid sync windows: %w", err)
 	}
 	if inactiveAllows.HasWindows() {
 		if isManual && inactiveAllows.manualEnabled() {
-			return true, nil
+			return true, "", nil
 		}
 
 		// Check if operation started during an allow window and overrun is allowed
 		if operationStartTime != nil && !operationStartTime.IsZero() && inactiveAllows.inactiveAllowsAllowOverrun() {
 			wasAllowed, err := w.canSyncAtTime(isManual, *operationStartTime)
 			if err != nil {
-				return false, err
+				return false, "", err
 			}
 			if wasAllowed {
-				return true, nil // Allow sync to continue (overrun out of allow window)
+				return true, "", nil // Allow sync to continue (overrun out of allow window)
 			}
 		}
 
-		return false, nil
+		return false, "outside of " + inactiveAllows.describe("allow"), nil
 	}
 
-	return true, nil
+	return true, "", nil
 }
 
 // hasDeny will iterate over the SyncWindows and return if a deny window is found and if
@@ -3370,6 +3698,128 @@ func (w *SyncWindows) canSyncAtTime(isManual bool, checkTime time.Time) (bool, e
 	return true, nil
 }
 
+// maxSyncWindowTransitions is the maximum number of times sync windows may become active or inactive during a
+// simulated time range
+const maxSyncWindowTransitions = 10000
+
+// Simulate returns the consecutive periods between from and to during which the sync windows consistently allow or
+// deny manual and automated syncs. The sync windows must already be matched to an application, see Matches.
+func (w *SyncWindows) Simulate(from time.Time, to time.Time) ([]SyncWindowPeriod, error) {
+	if !to.After(from) {
+		return nil, errors.New("end of the time range must be after its start")
+	}
+	boundaries := []time.Time{from, to}
+	if w.HasWindows() {
+		for _, window := range *w {
+			transitions, err := window.transitions(from, to)
+			if err != nil {
+				return nil, fmt.Errorf("invalid sync windows: %w", err)
+			}
+			boundaries = append(boundaries, transitions...)
+			if len(boundaries) > maxSyncWindowTransitions {
+				return nil, fmt.Errorf("sync windows change more than %d times in the time range, use a shorter time range", maxSyncWindowTransitions)
+			}
+		}
+	}
+	slices.SortFunc(boundaries, func(a, b time.Time) int { return a.Compare(b) })
+	boundaries = slices.CompactFunc(boundaries, func(a, b time.Time) bool { return a.Equal(b) })
+
+	var periods []SyncWindowPeriod
+	for i := 0; i < len(boundaries)-1; i++ {
+		// windows may or may not be active at the exact time of a transition, so the state of a period is evaluated
+		// at its midpoint
+		start, end := boundaries[i], boundaries[i+1]
+		period, err := w.periodAt(start.Add(end.Sub(start) / 2))
+		if err != nil {
+			return nil, err
+		}
+		if last := len(periods) - 1; last >= 0 && periods[last].AutoSync == period.AutoSync && periods[last].ManualSync == period.ManualSync &&
+			periods[last].SyncOverrun == period.SyncOverrun && periods[last].Reason == period.Reason {
+			periods[last].End = metav1.NewTime(end)
+			continue
+		}
+		period.Start = metav1.NewTime(start)
+		period.End = metav1.NewTime(end)
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+// periodAt returns whether the sync windows allow syncs at the given time
+func (w *SyncWindows) periodAt(currentTime time.Time) (SyncWindowPeriod, error) {
+	var period SyncWindowPeriod
+	var err error
+	period.AutoSync, period.Reason, err = w.canSyncWithReasonAt(false, nil, currentTime)
+	if err != nil {
+		return period, err
+	}
+	period.ManualSync, _, err = w.canSyncWithReasonAt(true, nil, currentTime)
+	if err != nil {
+		return period, err
+	}
+	if period.AutoSync {
+		return period, nil
+	}
+	active, err := w.active(currentTime)
+	if err != nil {
+		return period, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	if hasActiveDeny, _ := active.hasDeny(); hasActiveDeny {
+		period.SyncOverrun = active.denyAllowsOverrun()
+		return period, nil
+	}
+	inactiveAllows, err := w.inactiveAllows(currentTime)
+	if err != nil {
+		return period, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	period.SyncOverrun = inactiveAllows.inactiveAllowsAllowOverrun()
+	return period, nil
+}
+
+// transitions returns the times between from and to at which the sync window may become active or inactive
+func (w *SyncWindow) transitions(from time.Time, to time.Time) ([]time.Time, error) {
+	var transitions []time.Time
+	if w.Calendar != nil {
+		if syncWindowCalendarLoader == nil {
+			return nil, fmt.Errorf("cannot load calendar '%s': sync window calendars are not supported", w.Calendar.Source())
+		}
+		events, err := syncWindowCalendarLoader.GetEvents(w.Calendar, w.timeZoneLocation(), from, to)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load calendar '%s': %w", w.Calendar.Source(), err)
+		}
+		for _, event := range events {
+			transitions = append(transitions, event.Start.Time, event.End.Time)
+		}
+	} else {
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		schedule, err := specParser.Parse(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+		}
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+		}
+		// the schedule is evaluated in UTC shifted by the offset of the time zone of the window, like in activeAt
+		offset := w.scheduleOffsetByTimeZone()
+		for next := schedule.Next(from.UTC().Add(offset - duration)); next.Add(-offset).Before(to); next = schedule.Next(next) {
+			if next.IsZero() || len(transitions) > maxSyncWindowTransitions {
+				break
+			}
+			start := next.Add(-offset)
+			transitions = append(transitions, start, start.Add(duration))
+		}
+	}
+
+	var inRange []time.Time
+	for _, t := range transitions {
+		if t.After(from) && t.Before(to) {
+			inRange = append(inRange, t)
+		}
+	}
+	return inRange, nil
+}
+
 // Active returns true if the sync window is currently active
 func (w SyncWindow) Active() (bool, error) {
 	return w.active(time.Now())
@@ -3378,23 +3828,8 @@ func (w SyncWindow) Active() (bool, error) {
 func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	// If SyncWindow.Active() is called outside of a UTC locale, it should be
 	// first converted to UTC before search
-	currentTime = currentTime.UTC()
-
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	schedule, sErr := specParser.Parse(w.Schedule)
-	if sErr != nil {
-		return false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-	}
-	duration, dErr := time.ParseDuration(w.Duration)
-	if dErr != nil {
-		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
-	}
-
-	// Offset the nextWindow time to consider the timeZone of the sync window
-	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+	isActive, _, err := w.activeAt(currentTime.UTC())
+	return isActive, err
 }
 
 // Update updates a sync window's settings with the given parameter
@@ -3448,14 +3883,23 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	_, err := specParser.Parse(w.Schedule)
-	if err != nil {
-		return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
-	}
-	_, err = time.ParseDuration(w.Duration)
-	if err != nil {
-		return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	if w.Calendar != nil {
+		if w.Schedule != "" || w.Duration != "" {
+			return errors.New("schedule and duration cannot be combined with calendar")
+		}
+		if err := w.Calendar.Validate(); err != nil {
+			return err
+		}
+	} else {
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		_, err := specParser.Parse(w.Schedule)
+		if err != nil {
+			return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+		}
+		_, err = time.ParseDuration(w.Duration)
+		if err != nil {
+			return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+		}
 	}
 
 	if len(w.Description) > 255 {
@@ -3477,6 +3921,7 @@ func (w *SyncWindow) HashIdentity() (uint64, error) {
 		Clusters:       w.Clusters,
 		TimeZone:       w.TimeZone,
 		UseAndOperator: w.UseAndOperator,
+		Calendar:       w.Calendar,
 		// ManualSync and Description are excluded as they don't affect window identity
 	}
 
@@ -3342,6 +3342,231 @@ func TestSyncWindow_Validate(t *testing.T) {
 	})
 }
 
+type fakeSyncWindowCalendarLoader struct {
+	events []SyncWindowCalendarEvent
+	err    error
+}
+
+func (l *fakeSyncWindowCalendarLoader) GetEvents(_ *SyncWindowCalendar, _ *time.Location, from time.Time, to time.Time) ([]SyncWindowCalendarEvent, error) {
+	var events []SyncWindowCalendarEvent
+	for _, e := range l.events {
+		if !e.Start.After(to) && e.End.After(from) {
+			events = append(events, e)
+		}
+	}
+	return events, l.err
+}
+
+func setFakeSyncWindowCalendarLoader(t *testing.T, loader SyncWindowCalendarLoader) {
+	t.Helper()
+	previous := syncWindowCalendarLoader
+	SetSyncWindowCalendarLoader(loader)
+	t.Cleanup(func() {
+		SetSyncWindowCalendarLoader(previous)
+	})
+}
+
+func TestSyncWindow_ValidateCalendar(t *testing.T) {
+	testCases := []struct {
+		name     string
+		window   SyncWindow
+		expected string
+	}{{
+		name:   "url",
+		window: SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{URL: "https://example.com/holidays.ics"}},
+	}, {
+		name:   "configmap",
+		window: SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{ConfigMapKeyRef: &ConfigMapKeyRef{ConfigMapName: "holidays", Key: "calendar.ics"}}},
+	}, {
+		name:     "no source",
+		window:   SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{}},
+		expected: "exactly one of url and configMapKeyRef",
+	}, {
+		name:     "both sources",
+		window:   SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{URL: "https://example.com/holidays.ics", ConfigMapKeyRef: &ConfigMapKeyRef{ConfigMapName: "holidays", Key: "calendar.ics"}}},
+		expected: "exactly one of url and configMapKeyRef",
+	}, {
+		name:     "configmap without key",
+		window:   SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{ConfigMapKeyRef: &ConfigMapKeyRef{ConfigMapName: "holidays"}}},
+		expected: "requires configMapName and key",
+	}, {
+		name:     "unsupported scheme",
+		window:   SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{URL: "file:///etc/holidays.ics"}},
+		expected: "must use http or https",
+	}, {
+		name:     "schedule",
+		window:   SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Calendar: &SyncWindowCalendar{URL: "https://example.com/holidays.ics"}},
+		expected: "cannot be combined with calendar",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.window.Validate()
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSyncWindows_Calendar(t *testing.T) {
+	now := time.Now()
+	activeEvent := SyncWindowCalendarEvent{
+		UID:     "christmas",
+		Summary: "Christmas freeze",
+		Start:   metav1.NewTime(now.Add(-time.Hour)),
+		End:     metav1.NewTime(now.Add(time.Hour)),
+	}
+	inactiveEvent := SyncWindowCalendarEvent{
+		UID:     "new-year",
+		Summary: "New year freeze",
+		Start:   metav1.NewTime(now.Add(24 * time.Hour)),
+		End:     metav1.NewTime(now.Add(48 * time.Hour)),
+	}
+	calendar := &SyncWindowCalendar{URL: "https://example.com/holidays.ics"}
+
+	t.Run("deny window is active during an event", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, &fakeSyncWindowCalendarLoader{events: []SyncWindowCalendarEvent{activeEvent, inactiveEvent}})
+		windows := SyncWindows{{Kind: "deny", Calendar: calendar}}
+
+		active, err := windows.Active()
+		require.NoError(t, err)
+		require.Len(t, *active, 1)
+		assert.Equal(t, &activeEvent, (*active)[0].ActiveEvent)
+		assert.Nil(t, windows[0].ActiveEvent)
+
+		canSync, reason, err := windows.CanSyncWithReason(false, nil)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+		assert.Contains(t, reason, "calendar event 'Christmas freeze'")
+	})
+	t.Run("deny window is inactive between events", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, &fakeSyncWindowCalendarLoader{events: []SyncWindowCalendarEvent{inactiveEvent}})
+		windows := SyncWindows{{Kind: "deny", Calendar: calendar}}
+
+		canSync, reason, err := windows.CanSyncWithReason(false, nil)
+		require.NoError(t, err)
+		assert.True(t, canSync)
+		assert.Empty(t, reason)
+	})
+	t.Run("allow window blocks sync outside of events", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, &fakeSyncWindowCalendarLoader{events: []SyncWindowCalendarEvent{inactiveEvent}})
+		windows := SyncWindows{{Kind: "allow", Calendar: calendar}}
+
+		canSync, reason, err := windows.CanSyncWithReason(false, nil)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+		assert.Equal(t, "outside of allow window calendar 'https://example.com/holidays.ics'", reason)
+	})
+	t.Run("manual sync is allowed during deny event", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, &fakeSyncWindowCalendarLoader{events: []SyncWindowCalendarEvent{activeEvent}})
+		windows := SyncWindows{{Kind: "deny", Calendar: calendar, ManualSync: true}}
+
+		canSync, err := windows.CanSync(true, nil)
+		require.NoError(t, err)
+		assert.True(t, canSync)
+	})
+	t.Run("calendar cannot be loaded", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, &fakeSyncWindowCalendarLoader{err: errors.New("connection refused")})
+		windows := SyncWindows{{Kind: "deny", Calendar: calendar}}
+
+		canSync, err := windows.CanSync(false, nil)
+		require.ErrorContains(t, err, "connection refused")
+		assert.False(t, canSync)
+	})
+	t.Run("no loader", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, nil)
+		windows := SyncWindows{{Kind: "deny", Calendar: calendar}}
+
+		_, err := windows.Active()
+		require.ErrorContains(t, err, "not supported")
+	})
+}
+
+func TestSyncWindows_CanSyncWithReason(t *testing.T) {
+	proj := newProjectBuilder().withActiveDenyWindow(false).build()
+
+	canSync, reason, err := proj.Spec.SyncWindows.CanSyncWithReason(false, nil)
+
+	require.NoError(t, err)
+	assert.False(t, canSync)
+	assert.Equal(t, "active deny window '* * * * *' (1h)", reason)
+}
+
+func TestSyncWindows_Simulate(t *testing.T) {
+	// Friday, October 16th 2026
+	from := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+	at := func(day int, hour int, minute int) metav1.Time {
+		return metav1.NewTime(time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC))
+	}
+
+	t.Run("no windows", func(t *testing.T) {
+		periods, err := (&SyncWindows{}).Simulate(from, to)
+		require.NoError(t, err)
+		assert.Equal(t, []SyncWindowPeriod{{Start: metav1.NewTime(from), End: metav1.NewTime(to), AutoSync: true, ManualSync: true}}, periods)
+	})
+	t.Run("deny window on friday night", func(t *testing.T) {
+		windows := SyncWindows{{Kind: "deny", Schedule: "0 22 * * 5", Duration: "10h", ManualSync: true}}
+
+		periods, err := windows.Simulate(from, to)
+		require.NoError(t, err)
+		assert.Equal(t, []SyncWindowPeriod{
+			{Start: metav1.NewTime(from), End: at(16, 22, 0), AutoSync: true, ManualSync: true},
+			{Start: at(16, 22, 0), End: at(17, 8, 0), ManualSync: true, Reason: "active deny window '0 22 * * 5' (10h)"},
+			{Start: at(17, 8, 0), End: metav1.NewTime(to), AutoSync: true, ManualSync: true},
+		}, periods)
+	})
+	t.Run("window in a time zone", func(t *testing.T) {
+		windows := SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "Asia/Kolkata"}}
+
+		periods, err := windows.Simulate(from, from.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, periods, 3)
+		assert.Equal(t, at(16, 16, 30), periods[1].Start)
+		assert.Equal(t, at(16, 17, 30), periods[1].End)
+		assert.False(t, periods[1].ManualSync)
+	})
+	t.Run("overlapping allow windows with overrun", func(t *testing.T) {
+		windows := SyncWindows{
+			{Kind: "allow", Schedule: "0 9 * * *", Duration: "2h", SyncOverrun: true},
+			{Kind: "allow", Schedule: "0 10 * * *", Duration: "2h", SyncOverrun: true},
+		}
+
+		periods, err := windows.Simulate(from, from.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, periods, 3)
+		assert.Equal(t, SyncWindowPeriod{Start: at(16, 9, 0), End: at(16, 12, 0), AutoSync: true, ManualSync: true}, periods[1])
+		assert.True(t, periods[0].SyncOverrun)
+		assert.False(t, periods[0].AutoSync)
+		assert.Equal(t, "outside of allow window '0 9 * * *' (2h), allow window '0 10 * * *' (2h)", periods[0].Reason)
+	})
+	t.Run("calendar window", func(t *testing.T) {
+		setFakeSyncWindowCalendarLoader(t, &fakeSyncWindowCalendarLoader{events: []SyncWindowCalendarEvent{{
+			Summary: "Release freeze",
+			Start:   at(16, 12, 0),
+			End:     at(16, 18, 0),
+		}}})
+		windows := SyncWindows{{Kind: "deny", Calendar: &SyncWindowCalendar{URL: "https://example.com/freezes.ics"}}}
+
+		periods, err := windows.Simulate(from, to)
+		require.NoError(t, err)
+		require.Len(t, periods, 3)
+		assert.Equal(t, at(16, 12, 0), periods[1].Start)
+		assert.Equal(t, at(16, 18, 0), periods[1].End)
+		assert.Contains(t, periods[1].Reason, "calendar event 'Release freeze'")
+	})
+	t.Run("invalid time range", func(t *testing.T) {
+		_, err := (&SyncWindows{}).Simulate(to, from)
+		require.ErrorContains(t, err, "must be after its start")
+	})
+	t.Run("invalid window", func(t *testing.T) {
+		_, err := (&SyncWindows{{Kind: "deny", Schedule: "invalid", Duration: "1h"}}).Simulate(from, to)
+		require.ErrorContains(t, err, "cannot parse schedule")
+	})
+}
+
 func TestApplicationStatus_GetConditions(t *testing.T) {
 	status := ApplicationStatus{
 		Conditions: []ApplicationCondition{
@@ -3810,6 +4035,24 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 }
 
+func TestRollbackOnDegraded_GetWindow(t *testing.T) {
+	var rollback *RollbackOnDegraded
+	window, err := rollback.GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRollbackOnDegradedWindow, window)
+
+	window, err = (&RollbackOnDegraded{Window: "2m"}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, window)
+
+	window, err = (&RollbackOnDegraded{Window: "30"}).GetWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, window)
+
+	_, err = (&RollbackOnDegraded{Window: "invalid"}).GetWindow()
+	require.Error(t, err)
+}
+
 func TestSourceAllowsConcurrentProcessing_KustomizeParams(t *testing.T) {
 	t.Run("no params", func(t *testing.T) {
 		src := ApplicationSource{Path: ".", Kustomize: &ApplicationSourceKustomize{
@@ -4720,6 +4963,28 @@ func TestApplicationTree_GetShards(t *testing.T) {
 	}, shards[4])
 }
 
+func TestApplicationTree_GetShardsBlockingDependencies(t *testing.T) {
+	blocking := []ApplicationDependencyStatus{{Name: "crds", Namespace: "argocd", Message: "application does not exist"}}
+	tree := &ApplicationTree{
+		Nodes:                []ResourceNode{{ResourceRef: ResourceRef{Name: "node 1"}}, {ResourceRef: ResourceRef{Name: "node 2"}}},
+		BlockingDependencies: blocking,
+	}
+
+	shards := tree.GetShards(1)
+	require.Len(t, shards, 2)
+	assert.Equal(t, blocking, shards[0].BlockingDependencies)
+	assert.Empty(t, shards[1].BlockingDependencies)
+
+	merged := shards[0]
+	merged.Merge(shards[1])
+	assert.Equal(t, blocking, merged.BlockingDependencies)
+	assert.Len(t, merged.Nodes, 2)
+
+	empty := (&ApplicationTree{BlockingDependencies: blocking}).GetShards(1)
+	require.Len(t, empty, 1)
+	assert.Equal(t, blocking, empty[0].BlockingDependencies)
+}
+
 func TestApplicationTree_Merge(t *testing.T) {
 	tree := &ApplicationTree{}
 	tree.Merge(&ApplicationTree{
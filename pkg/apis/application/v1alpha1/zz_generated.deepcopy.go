@@ -288,6 +288,38 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependencyStatus) DeepCopyInto(out *ApplicationDependencyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependencyStatus.
+func (in *ApplicationDependencyStatus) DeepCopy() *ApplicationDependencyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependencyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -440,6 +472,18 @@ func (in *ApplicationSetApplicationStatus) DeepCopyInto(out *ApplicationSetAppli
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = make([]ApplicationSetRolloutAnalysisStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApplicationSetRolloutApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -473,6 +517,60 @@ func (in *ApplicationSetCondition) DeepCopy() *ApplicationSetCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetExpiration) DeepCopyInto(out *ApplicationSetExpiration) {
+	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]ApplicationSetExpirationSchedule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetExpiration.
+func (in *ApplicationSetExpiration) DeepCopy() *ApplicationSetExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetExpirationSchedule) DeepCopyInto(out *ApplicationSetExpirationSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetExpirationSchedule.
+func (in *ApplicationSetExpirationSchedule) DeepCopy() *ApplicationSetExpirationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetExpirationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetExpiredApplication) DeepCopyInto(out *ApplicationSetExpiredApplication) {
+	*out = *in
+	in.ExpiredAt.DeepCopyInto(&out.ExpiredAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetExpiredApplication.
+func (in *ApplicationSetExpiredApplication) DeepCopy() *ApplicationSetExpiredApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetExpiredApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 	*out = *in
@@ -526,6 +624,26 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratorRef != nil {
+		in, out := &in.GeneratorRef, &out.GeneratorRef
+		*out = new(GeneratorReference)
+		**out = **in
+	}
 	return
 }
 
@@ -561,6 +679,22 @@ func (in ApplicationSetIgnoreDifferences) DeepCopy() ApplicationSetIgnoreDiffere
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetImport) DeepCopyInto(out *ApplicationSetImport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetImport.
+func (in *ApplicationSetImport) DeepCopy() *ApplicationSetImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetList) DeepCopyInto(out *ApplicationSetList) {
 	*out = *in
@@ -647,6 +781,26 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratorRef != nil {
+		in, out := &in.GeneratorRef, &out.GeneratorRef
+		*out = new(GeneratorReference)
+		**out = **in
+	}
 	return
 }
 
@@ -708,6 +862,130 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutAnalysis) DeepCopyInto(out *ApplicationSetRolloutAnalysis) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(ApplicationSetRolloutPrometheusAnalysis)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ApplicationSetRolloutHTTPAnalysis)
+		**out = **in
+	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(ApplicationSetRolloutPauseAnalysis)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutAnalysis.
+func (in *ApplicationSetRolloutAnalysis) DeepCopy() *ApplicationSetRolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutAnalysisStatus) DeepCopyInto(out *ApplicationSetRolloutAnalysisStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastEvaluatedAt != nil {
+		in, out := &in.LastEvaluatedAt, &out.LastEvaluatedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutAnalysisStatus.
+func (in *ApplicationSetRolloutAnalysisStatus) DeepCopy() *ApplicationSetRolloutAnalysisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutAnalysisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutApprovalStatus) DeepCopyInto(out *ApplicationSetRolloutApprovalStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutApprovalStatus.
+func (in *ApplicationSetRolloutApprovalStatus) DeepCopy() *ApplicationSetRolloutApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutHTTPAnalysis) DeepCopyInto(out *ApplicationSetRolloutHTTPAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutHTTPAnalysis.
+func (in *ApplicationSetRolloutHTTPAnalysis) DeepCopy() *ApplicationSetRolloutHTTPAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutHTTPAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutPauseAnalysis) DeepCopyInto(out *ApplicationSetRolloutPauseAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutPauseAnalysis.
+func (in *ApplicationSetRolloutPauseAnalysis) DeepCopy() *ApplicationSetRolloutPauseAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutPauseAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutPrometheusAnalysis) DeepCopyInto(out *ApplicationSetRolloutPrometheusAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutPrometheusAnalysis.
+func (in *ApplicationSetRolloutPrometheusAnalysis) DeepCopy() *ApplicationSetRolloutPrometheusAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutPrometheusAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -723,6 +1001,13 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = make([]ApplicationSetRolloutAnalysis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -802,6 +1087,18 @@ func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GeneratorDefinitions != nil {
+		in, out := &in.GeneratorDefinitions, &out.GeneratorDefinitions
+		*out = make(map[string]ApplicationSetNestedGenerator, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]ApplicationSetImport, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -840,6 +1137,13 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 		}
 	}
 	in.Health.DeepCopyInto(&out.Health)
+	if in.ExpiredApplications != nil {
+		in, out := &in.ExpiredApplications, &out.ExpiredApplications
+		*out = make([]ApplicationSetExpiredApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -882,6 +1186,11 @@ func (in *ApplicationSetSyncPolicy) DeepCopyInto(out *ApplicationSetSyncPolicy)
 		*out = new(ApplicationsSyncPolicy)
 		**out = **in
 	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(ApplicationSetExpiration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -991,6 +1300,26 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratorRef != nil {
+		in, out := &in.GeneratorRef, &out.GeneratorRef
+		*out = new(GeneratorReference)
+		**out = **in
+	}
 	return
 }
 
@@ -1400,6 +1729,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1459,6 +1793,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(AutomatedRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1522,6 +1861,11 @@ func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BlockingDependencies != nil {
+		in, out := &in.BlockingDependencies, &out.BlockingDependencies
+		*out = make([]ApplicationDependencyStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1552,6 +1896,28 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollbackStatus) DeepCopyInto(out *AutomatedRollbackStatus) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.RolledBackAt.DeepCopyInto(&out.RolledBackAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollbackStatus.
+func (in *AutomatedRollbackStatus) DeepCopy() *AutomatedRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -2129,6 +2495,110 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorReference) DeepCopyInto(out *GeneratorReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorReference.
+func (in *GeneratorReference) DeepCopy() *GeneratorReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorTemplate) DeepCopyInto(out *GeneratorTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorTemplate.
+func (in *GeneratorTemplate) DeepCopy() *GeneratorTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GeneratorTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorTemplateList) DeepCopyInto(out *GeneratorTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GeneratorTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorTemplateList.
+func (in *GeneratorTemplateList) DeepCopy() *GeneratorTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GeneratorTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorTemplateSpec) DeepCopyInto(out *GeneratorTemplateSpec) {
+	*out = *in
+	if in.GeneratorDefinitions != nil {
+		in, out := &in.GeneratorDefinitions, &out.GeneratorDefinitions
+		*out = make(map[string]ApplicationSetNestedGenerator, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ApplicationSetTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorTemplateSpec.
+func (in *GeneratorTemplateSpec) DeepCopy() *GeneratorTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -2187,6 +2657,11 @@ func (in *GitGenerator) DeepCopyInto(out *GitGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(GitTagsGeneratorItem)
+		**out = **in
+	}
 	return
 }
 
@@ -2200,6 +2675,22 @@ func (in *GitGenerator) DeepCopy() *GitGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitTagsGeneratorItem) DeepCopyInto(out *GitTagsGeneratorItem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitTagsGeneratorItem.
+func (in *GitTagsGeneratorItem) DeepCopy() *GitTagsGeneratorItem {
+	if in == nil {
+		return nil
+	}
+	out := new(GitTagsGeneratorItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GnuPGPublicKey) DeepCopyInto(out *GnuPGPublicKey) {
 	*out = *in
@@ -2258,6 +2749,40 @@ func (in *HealthStatus) DeepCopy() *HealthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartGenerator) DeepCopyInto(out *HelmChartGenerator) {
+	*out = *in
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartGenerator.
+func (in *HelmChartGenerator) DeepCopy() *HelmChartGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmFileParameter) DeepCopyInto(out *HelmFileParameter) {
 	*out = *in
@@ -2517,6 +3042,48 @@ func (in *KnownTypeField) DeepCopy() *KnownTypeField {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesResourceGenerator) DeepCopyInto(out *KubernetesResourceGenerator) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesResourceGenerator.
+func (in *KubernetesResourceGenerator) DeepCopy() *KubernetesResourceGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesResourceGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeGvk) DeepCopyInto(out *KustomizeGvk) {
 	*out = *in
@@ -2804,67 +3371,139 @@ func (in *MergeGenerator) DeepCopyInto(out *MergeGenerator) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.Template.DeepCopyInto(&out.Template)
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeGenerator.
+func (in *MergeGenerator) DeepCopy() *MergeGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NestedMatrixGenerator) DeepCopyInto(out *NestedMatrixGenerator) {
+	*out = *in
+	if in.Generators != nil {
+		in, out := &in.Generators, &out.Generators
+		*out = make(ApplicationSetTerminalGenerators, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedMatrixGenerator.
+func (in *NestedMatrixGenerator) DeepCopy() *NestedMatrixGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(NestedMatrixGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NestedMergeGenerator) DeepCopyInto(out *NestedMergeGenerator) {
+	*out = *in
+	if in.Generators != nil {
+		in, out := &in.Generators, &out.Generators
+		*out = make(ApplicationSetTerminalGenerators, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MergeKeys != nil {
+		in, out := &in.MergeKeys, &out.MergeKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeGenerator.
-func (in *MergeGenerator) DeepCopy() *MergeGenerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedMergeGenerator.
+func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	if in == nil {
 		return nil
 	}
-	out := new(MergeGenerator)
+	out := new(NestedMergeGenerator)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NestedMatrixGenerator) DeepCopyInto(out *NestedMatrixGenerator) {
+func (in *OCIGenerator) DeepCopyInto(out *OCIGenerator) {
 	*out = *in
-	if in.Generators != nil {
-		in, out := &in.Generators, &out.Generators
-		*out = make(ApplicationSetTerminalGenerators, len(*in))
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]OCIGeneratorFilter, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedMatrixGenerator.
-func (in *NestedMatrixGenerator) DeepCopy() *NestedMatrixGenerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGenerator.
+func (in *OCIGenerator) DeepCopy() *OCIGenerator {
 	if in == nil {
 		return nil
 	}
-	out := new(NestedMatrixGenerator)
+	out := new(OCIGenerator)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NestedMergeGenerator) DeepCopyInto(out *NestedMergeGenerator) {
+func (in *OCIGeneratorFilter) DeepCopyInto(out *OCIGeneratorFilter) {
 	*out = *in
-	if in.Generators != nil {
-		in, out := &in.Generators, &out.Generators
-		*out = make(ApplicationSetTerminalGenerators, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RepositoryMatch != nil {
+		in, out := &in.RepositoryMatch, &out.RepositoryMatch
+		*out = new(string)
+		**out = **in
 	}
-	if in.MergeKeys != nil {
-		in, out := &in.MergeKeys, &out.MergeKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.TagMatch != nil {
+		in, out := &in.TagMatch, &out.TagMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionConstraint != nil {
+		in, out := &in.VersionConstraint, &out.VersionConstraint
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedMergeGenerator.
-func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGeneratorFilter.
+func (in *OCIGeneratorFilter) DeepCopy() *OCIGeneratorFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(NestedMergeGenerator)
+	out := new(OCIGeneratorFilter)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3255,6 +3894,16 @@ func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.AWSCodeCommit != nil {
+		in, out := &in.AWSCodeCommit, &out.AWSCodeCommit
+		*out = new(PullRequestGeneratorAWSCodeCommit)
+		**out = **in
+	}
+	if in.Gerrit != nil {
+		in, out := &in.Gerrit, &out.Gerrit
+		*out = new(PullRequestGeneratorGerrit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3268,6 +3917,22 @@ func (in *PullRequestGenerator) DeepCopy() *PullRequestGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestGeneratorAWSCodeCommit) DeepCopyInto(out *PullRequestGeneratorAWSCodeCommit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestGeneratorAWSCodeCommit.
+func (in *PullRequestGeneratorAWSCodeCommit) DeepCopy() *PullRequestGeneratorAWSCodeCommit {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestGeneratorAWSCodeCommit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGeneratorAzureDevOps) DeepCopyInto(out *PullRequestGeneratorAzureDevOps) {
 	*out = *in
@@ -3369,6 +4034,31 @@ func (in *PullRequestGeneratorFilter) DeepCopyInto(out *PullRequestGeneratorFilt
 		*out = new(string)
 		**out = **in
 	}
+	if in.Draft != nil {
+		in, out := &in.Draft, &out.Draft
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Approved != nil {
+		in, out := &in.Approved, &out.Approved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChecksStatus != nil {
+		in, out := &in.ChecksStatus, &out.ChecksStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathsChanged != nil {
+		in, out := &in.PathsChanged, &out.PathsChanged
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatedWithin != nil {
+		in, out := &in.UpdatedWithin, &out.UpdatedWithin
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3382,6 +4072,32 @@ func (in *PullRequestGeneratorFilter) DeepCopy() *PullRequestGeneratorFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestGeneratorGerrit) DeepCopyInto(out *PullRequestGeneratorGerrit) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuthBitbucketServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestGeneratorGerrit.
+func (in *PullRequestGeneratorGerrit) DeepCopy() *PullRequestGeneratorGerrit {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestGeneratorGerrit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGeneratorGitLab) DeepCopyInto(out *PullRequestGeneratorGitLab) {
 	*out = *in
@@ -3756,6 +4472,59 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]ResourceDriftManager, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDriftManager) DeepCopyInto(out *ResourceDriftManager) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDriftManager.
+func (in *ResourceDriftManager) DeepCopy() *ResourceDriftManager {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDriftManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -4123,6 +4892,22 @@ func (in *RevisionReference) DeepCopy() *RevisionReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackOnDegraded) DeepCopyInto(out *RollbackOnDegraded) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackOnDegraded.
+func (in *RollbackOnDegraded) DeepCopy() *RollbackOnDegraded {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackOnDegraded)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SCMProviderGenerator) DeepCopyInto(out *SCMProviderGenerator) {
 	*out = *in
@@ -4701,6 +5486,13 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]ResourceDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4762,6 +5554,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(SyncPlan)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4795,6 +5592,66 @@ func (in SyncOptions) DeepCopy() SyncOptions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPlan) DeepCopyInto(out *SyncPlan) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]SyncPlanStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPlan.
+func (in *SyncPlan) DeepCopy() *SyncPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPlanResource) DeepCopyInto(out *SyncPlanResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPlanResource.
+func (in *SyncPlanResource) DeepCopy() *SyncPlanResource {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPlanResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPlanStep) DeepCopyInto(out *SyncPlanStep) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]SyncPlanResource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPlanStep.
+func (in *SyncPlanStep) DeepCopy() *SyncPlanStep {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPlanStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	*out = *in
@@ -4854,6 +5711,11 @@ func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RollbackOnDegraded != nil {
+		in, out := &in.RollbackOnDegraded, &out.RollbackOnDegraded
+		*out = new(RollbackOnDegraded)
+		**out = **in
+	}
 	return
 }
 
@@ -4982,6 +5844,16 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(SyncWindowCalendar)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveEvent != nil {
+		in, out := &in.ActiveEvent, &out.ActiveEvent
+		*out = new(SyncWindowCalendarEvent)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4995,6 +5867,63 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendar) DeepCopyInto(out *SyncWindowCalendar) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendar.
+func (in *SyncWindowCalendar) DeepCopy() *SyncWindowCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendarEvent) DeepCopyInto(out *SyncWindowCalendarEvent) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendarEvent.
+func (in *SyncWindowCalendarEvent) DeepCopy() *SyncWindowCalendarEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendarEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowPeriod) DeepCopyInto(out *SyncWindowPeriod) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowPeriod.
+func (in *SyncWindowPeriod) DeepCopy() *SyncWindowPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
@@ -15,6 +15,7 @@ type ArgoprojV1alpha1Interface interface {
 	AppProjectsGetter
 	ApplicationsGetter
 	ApplicationSetsGetter
+	GeneratorTemplatesGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -34,6 +35,10 @@ func (c *ArgoprojV1alpha1Client) ApplicationSets(namespace string) ApplicationSe
 	return newApplicationSets(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) GeneratorTemplates(namespace string) GeneratorTemplateInterface {
+	return newGeneratorTemplates(c, namespace)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
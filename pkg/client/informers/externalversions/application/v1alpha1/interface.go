@@ -14,6 +14,8 @@ type Interface interface {
 	Applications() ApplicationInformer
 	// ApplicationSets returns a ApplicationSetInformer.
 	ApplicationSets() ApplicationSetInformer
+	// GeneratorTemplates returns a GeneratorTemplateInformer.
+	GeneratorTemplates() GeneratorTemplateInformer
 }
 
 type version struct {
@@ -41,3 +43,8 @@ func (v *version) Applications() ApplicationInformer {
 func (v *version) ApplicationSets() ApplicationSetInformer {
 	return &applicationSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// GeneratorTemplates returns a GeneratorTemplateInformer.
+func (v *version) GeneratorTemplates() GeneratorTemplateInformer {
+	return &generatorTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
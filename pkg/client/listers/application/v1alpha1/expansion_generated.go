@@ -25,3 +25,11 @@ type ApplicationSetListerExpansion interface{}
 // ApplicationSetNamespaceListerExpansion allows custom methods to be added to
 // ApplicationSetNamespaceLister.
 type ApplicationSetNamespaceListerExpansion interface{}
+
+// GeneratorTemplateListerExpansion allows custom methods to be added to
+// GeneratorTemplateLister.
+type GeneratorTemplateListerExpansion interface{}
+
+// GeneratorTemplateNamespaceListerExpansion allows custom methods to be added to
+// GeneratorTemplateNamespaceLister.
+type GeneratorTemplateNamespaceListerExpansion interface{}
@@ -470,6 +470,180 @@ func (_c *RepoServerServiceClient_GetGitFiles_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetGitTags provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) GetGitTags(ctx context.Context, in *apiclient.GitTagsRequest, opts ...grpc.CallOption) (*apiclient.GitTagsResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGitTags")
+	}
+
+	var r0 *apiclient.GitTagsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.GitTagsRequest, ...grpc.CallOption) (*apiclient.GitTagsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.GitTagsRequest, ...grpc.CallOption) *apiclient.GitTagsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.GitTagsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.GitTagsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_GetGitTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGitTags'
+type RepoServerServiceClient_GetGitTags_Call struct {
+	*mock.Call
+}
+
+// GetGitTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.GitTagsRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) GetGitTags(ctx any, in any, opts ...any) *RepoServerServiceClient_GetGitTags_Call {
+	return &RepoServerServiceClient_GetGitTags_Call{Call: _e.mock.On("GetGitTags",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_GetGitTags_Call) Run(run func(ctx context.Context, in *apiclient.GitTagsRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_GetGitTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.GitTagsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.GitTagsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetGitTags_Call) Return(gitTagsResponse *apiclient.GitTagsResponse, err error) *RepoServerServiceClient_GetGitTags_Call {
+	_c.Call.Return(gitTagsResponse, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetGitTags_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.GitTagsRequest, opts ...grpc.CallOption) (*apiclient.GitTagsResponse, error)) *RepoServerServiceClient_GetGitTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHelmChartVersions provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) GetHelmChartVersions(ctx context.Context, in *apiclient.HelmChartVersionsRequest, opts ...grpc.CallOption) (*apiclient.HelmChartVersionsResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHelmChartVersions")
+	}
+
+	var r0 *apiclient.HelmChartVersionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.HelmChartVersionsRequest, ...grpc.CallOption) (*apiclient.HelmChartVersionsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.HelmChartVersionsRequest, ...grpc.CallOption) *apiclient.HelmChartVersionsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.HelmChartVersionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.HelmChartVersionsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_GetHelmChartVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHelmChartVersions'
+type RepoServerServiceClient_GetHelmChartVersions_Call struct {
+	*mock.Call
+}
+
+// GetHelmChartVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.HelmChartVersionsRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) GetHelmChartVersions(ctx any, in any, opts ...any) *RepoServerServiceClient_GetHelmChartVersions_Call {
+	return &RepoServerServiceClient_GetHelmChartVersions_Call{Call: _e.mock.On("GetHelmChartVersions",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_GetHelmChartVersions_Call) Run(run func(ctx context.Context, in *apiclient.HelmChartVersionsRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_GetHelmChartVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.HelmChartVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.HelmChartVersionsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetHelmChartVersions_Call) Return(helmChartVersionsResponse *apiclient.HelmChartVersionsResponse, err error) *RepoServerServiceClient_GetHelmChartVersions_Call {
+	_c.Call.Return(helmChartVersionsResponse, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetHelmChartVersions_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.HelmChartVersionsRequest, opts ...grpc.CallOption) (*apiclient.HelmChartVersionsResponse, error)) *RepoServerServiceClient_GetHelmChartVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetHelmCharts provides a mock function for the type RepoServerServiceClient
 func (_mock *RepoServerServiceClient) GetHelmCharts(ctx context.Context, in *apiclient.HelmChartsRequest, opts ...grpc.CallOption) (*apiclient.HelmChartsResponse, error) {
 	// grpc.CallOption
@@ -557,6 +731,93 @@ func (_c *RepoServerServiceClient_GetHelmCharts_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetOCIArtifacts provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) GetOCIArtifacts(ctx context.Context, in *apiclient.OCIArtifactsRequest, opts ...grpc.CallOption) (*apiclient.OCIArtifactsResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOCIArtifacts")
+	}
+
+	var r0 *apiclient.OCIArtifactsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.OCIArtifactsRequest, ...grpc.CallOption) (*apiclient.OCIArtifactsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.OCIArtifactsRequest, ...grpc.CallOption) *apiclient.OCIArtifactsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.OCIArtifactsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.OCIArtifactsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_GetOCIArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOCIArtifacts'
+type RepoServerServiceClient_GetOCIArtifacts_Call struct {
+	*mock.Call
+}
+
+// GetOCIArtifacts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.OCIArtifactsRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) GetOCIArtifacts(ctx any, in any, opts ...any) *RepoServerServiceClient_GetOCIArtifacts_Call {
+	return &RepoServerServiceClient_GetOCIArtifacts_Call{Call: _e.mock.On("GetOCIArtifacts",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_GetOCIArtifacts_Call) Run(run func(ctx context.Context, in *apiclient.OCIArtifactsRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_GetOCIArtifacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.OCIArtifactsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.OCIArtifactsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetOCIArtifacts_Call) Return(oCIArtifactsResponse *apiclient.OCIArtifactsResponse, err error) *RepoServerServiceClient_GetOCIArtifacts_Call {
+	_c.Call.Return(oCIArtifactsResponse, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetOCIArtifacts_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.OCIArtifactsRequest, opts ...grpc.CallOption) (*apiclient.OCIArtifactsResponse, error)) *RepoServerServiceClient_GetOCIArtifacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOCIMetadata provides a mock function for the type RepoServerServiceClient
 func (_mock *RepoServerServiceClient) GetOCIMetadata(ctx context.Context, in *apiclient.RepoServerRevisionChartDetailsRequest, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error) {
 	// grpc.CallOption
@@ -905,6 +1166,93 @@ func (_c *RepoServerServiceClient_ListApps_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// ListOCIRepositories provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) ListOCIRepositories(ctx context.Context, in *apiclient.ListRefsRequest, opts ...grpc.CallOption) (*apiclient.OCIRepositories, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOCIRepositories")
+	}
+
+	var r0 *apiclient.OCIRepositories
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.ListRefsRequest, ...grpc.CallOption) (*apiclient.OCIRepositories, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.ListRefsRequest, ...grpc.CallOption) *apiclient.OCIRepositories); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.OCIRepositories)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.ListRefsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_ListOCIRepositories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOCIRepositories'
+type RepoServerServiceClient_ListOCIRepositories_Call struct {
+	*mock.Call
+}
+
+// ListOCIRepositories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.ListRefsRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) ListOCIRepositories(ctx any, in any, opts ...any) *RepoServerServiceClient_ListOCIRepositories_Call {
+	return &RepoServerServiceClient_ListOCIRepositories_Call{Call: _e.mock.On("ListOCIRepositories",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_ListOCIRepositories_Call) Run(run func(ctx context.Context, in *apiclient.ListRefsRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_ListOCIRepositories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.ListRefsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.ListRefsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_ListOCIRepositories_Call) Return(oCIRepositories *apiclient.OCIRepositories, err error) *RepoServerServiceClient_ListOCIRepositories_Call {
+	_c.Call.Return(oCIRepositories, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_ListOCIRepositories_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.ListRefsRequest, opts ...grpc.CallOption) (*apiclient.OCIRepositories, error)) *RepoServerServiceClient_ListOCIRepositories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListOCITags provides a mock function for the type RepoServerServiceClient
 func (_mock *RepoServerServiceClient) ListOCITags(ctx context.Context, in *apiclient.ListRefsRequest, opts ...grpc.CallOption) (*apiclient.Refs, error) {
 	// grpc.CallOption
@@ -927,6 +927,221 @@ func (m *Refs) GetTags() []string {
 	return nil
 }
 
+// OCIRepositories contains the names of the repositories of an OCI registry namespace
+type OCIRepositories struct {
+	Repositories         []string `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OCIRepositories) Reset()         { *m = OCIRepositories{} }
+func (m *OCIRepositories) String() string { return proto.CompactTextString(m) }
+func (*OCIRepositories) ProtoMessage()    {}
+func (*OCIRepositories) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{11}
+}
+func (m *OCIRepositories) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIRepositories) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCIRepositories.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OCIRepositories) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIRepositories.Merge(m, src)
+}
+func (m *OCIRepositories) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIRepositories) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIRepositories.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIRepositories proto.InternalMessageInfo
+
+func (m *OCIRepositories) GetRepositories() []string {
+	if m != nil {
+		return m.Repositories
+	}
+	return nil
+}
+
+// OCIArtifactsRequest requests the digest and the annotations of tags of an OCI repository
+type OCIArtifactsRequest struct {
+	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Tags                 []string             `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OCIArtifactsRequest) Reset()         { *m = OCIArtifactsRequest{} }
+func (m *OCIArtifactsRequest) String() string { return proto.CompactTextString(m) }
+func (*OCIArtifactsRequest) ProtoMessage()    {}
+func (*OCIArtifactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{12}
+}
+func (m *OCIArtifactsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIArtifactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCIArtifactsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OCIArtifactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifactsRequest.Merge(m, src)
+}
+func (m *OCIArtifactsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIArtifactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifactsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIArtifactsRequest proto.InternalMessageInfo
+
+func (m *OCIArtifactsRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *OCIArtifactsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// OCIArtifact contains the digest and the manifest annotations of a tag of an OCI repository
+type OCIArtifact struct {
+	Tag                  string            `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Digest               string            `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Annotations          map[string]string `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OCIArtifact) Reset()         { *m = OCIArtifact{} }
+func (m *OCIArtifact) String() string { return proto.CompactTextString(m) }
+func (*OCIArtifact) ProtoMessage()    {}
+func (*OCIArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{13}
+}
+func (m *OCIArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCIArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OCIArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifact.Merge(m, src)
+}
+func (m *OCIArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIArtifact proto.InternalMessageInfo
+
+func (m *OCIArtifact) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *OCIArtifact) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *OCIArtifact) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type OCIArtifactsResponse struct {
+	Artifacts            []*OCIArtifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *OCIArtifactsResponse) Reset()         { *m = OCIArtifactsResponse{} }
+func (m *OCIArtifactsResponse) String() string { return proto.CompactTextString(m) }
+func (*OCIArtifactsResponse) ProtoMessage()    {}
+func (*OCIArtifactsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{14}
+}
+func (m *OCIArtifactsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIArtifactsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCIArtifactsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OCIArtifactsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifactsResponse.Merge(m, src)
+}
+func (m *OCIArtifactsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIArtifactsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifactsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIArtifactsResponse proto.InternalMessageInfo
+
+func (m *OCIArtifactsResponse) GetArtifacts() []*OCIArtifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
 // ListAppsRequest requests a repository directory structure
 type ListAppsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -941,7 +1156,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1218,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1265,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,7 +1313,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,7 +1371,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1493,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1575,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1648,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServe
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1717,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1794,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1841,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1901,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1790,7 +2005,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1837,7 +2052,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1885,7 +2100,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1939,7 +2154,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1975,34 +2190,29 @@ func (m *HelmChartsResponse) GetItems() []*HelmChart {
 	return nil
 }
 
-type GitFilesRequest struct {
-	Repo                      *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	SubmoduleEnabled          bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
-	Revision                  string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	Path                      string               `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
-	NewGitFileGlobbingEnabled bool                 `protobuf:"varint,5,opt,name=NewGitFileGlobbingEnabled,proto3" json:"NewGitFileGlobbingEnabled,omitempty"`
-	NoRevisionCache           bool                 `protobuf:"varint,6,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	// Deprecated: Use sourceIntegrity for more detailed information. verifyCommit will be removed with the next major version.
-	VerifyCommit bool `protobuf:"varint,7,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
-	// Source integrity constrains to verify the sources before use
-	SourceIntegrity      *v1alpha1.SourceIntegrity `protobuf:"bytes,8,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+// HelmChartVersionsRequest requests the versions of charts of a Helm repository
+type HelmChartVersionsRequest struct {
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// the charts to list the versions of; required for OCI repositories, all the charts of the index otherwise
+	Charts               []string `protobuf:"bytes,2,rep,name=charts,proto3" json:"charts,omitempty"`
+	NoCache              bool     `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
-func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
-func (*GitFilesRequest) ProtoMessage()    {}
-func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+func (m *HelmChartVersionsRequest) Reset()         { *m = HelmChartVersionsRequest{} }
+func (m *HelmChartVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*HelmChartVersionsRequest) ProtoMessage()    {}
+func (*HelmChartVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
-func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
+func (m *HelmChartVersionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GitFilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HelmChartVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GitFilesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HelmChartVersionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2012,94 +2222,178 @@ func (m *GitFilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GitFilesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GitFilesRequest.Merge(m, src)
+func (m *HelmChartVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmChartVersionsRequest.Merge(m, src)
 }
-func (m *GitFilesRequest) XXX_Size() int {
+func (m *HelmChartVersionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GitFilesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GitFilesRequest.DiscardUnknown(m)
+func (m *HelmChartVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmChartVersionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GitFilesRequest proto.InternalMessageInfo
+var xxx_messageInfo_HelmChartVersionsRequest proto.InternalMessageInfo
 
-func (m *GitFilesRequest) GetRepo() *v1alpha1.Repository {
+func (m *HelmChartVersionsRequest) GetRepo() *v1alpha1.Repository {
 	if m != nil {
 		return m.Repo
 	}
 	return nil
 }
 
-func (m *GitFilesRequest) GetSubmoduleEnabled() bool {
+func (m *HelmChartVersionsRequest) GetCharts() []string {
 	if m != nil {
-		return m.SubmoduleEnabled
+		return m.Charts
+	}
+	return nil
+}
+
+func (m *HelmChartVersionsRequest) GetNoCache() bool {
+	if m != nil {
+		return m.NoCache
 	}
 	return false
 }
 
-func (m *GitFilesRequest) GetRevision() string {
+// HelmChartVersion contains a version of a chart and its metadata from the index of the Helm repository
+type HelmChartVersion struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	AppVersion           string   `protobuf:"bytes,3,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Created              string   `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	Digest               string   `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	Home                 string   `protobuf:"bytes,7,opt,name=home,proto3" json:"home,omitempty"`
+	Icon                 string   `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
+	Keywords             []string `protobuf:"bytes,9,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Deprecated           bool     `protobuf:"varint,10,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelmChartVersion) Reset()         { *m = HelmChartVersion{} }
+func (m *HelmChartVersion) String() string { return proto.CompactTextString(m) }
+func (*HelmChartVersion) ProtoMessage()    {}
+func (*HelmChartVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{32}
+}
+func (m *HelmChartVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmChartVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelmChartVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HelmChartVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmChartVersion.Merge(m, src)
+}
+func (m *HelmChartVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmChartVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmChartVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmChartVersion proto.InternalMessageInfo
+
+func (m *HelmChartVersion) GetName() string {
 	if m != nil {
-		return m.Revision
+		return m.Name
 	}
 	return ""
 }
 
-func (m *GitFilesRequest) GetPath() string {
+func (m *HelmChartVersion) GetVersion() string {
 	if m != nil {
-		return m.Path
+		return m.Version
 	}
 	return ""
 }
 
-func (m *GitFilesRequest) GetNewGitFileGlobbingEnabled() bool {
+func (m *HelmChartVersion) GetAppVersion() string {
 	if m != nil {
-		return m.NewGitFileGlobbingEnabled
+		return m.AppVersion
 	}
-	return false
+	return ""
 }
 
-func (m *GitFilesRequest) GetNoRevisionCache() bool {
+func (m *HelmChartVersion) GetDescription() string {
 	if m != nil {
-		return m.NoRevisionCache
+		return m.Description
 	}
-	return false
+	return ""
 }
 
-func (m *GitFilesRequest) GetVerifyCommit() bool {
+func (m *HelmChartVersion) GetCreated() string {
 	if m != nil {
-		return m.VerifyCommit
+		return m.Created
 	}
-	return false
+	return ""
 }
 
-func (m *GitFilesRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
+func (m *HelmChartVersion) GetDigest() string {
 	if m != nil {
-		return m.SourceIntegrity
+		return m.Digest
 	}
-	return nil
+	return ""
 }
 
-type GitFilesResponse struct {
-	// Map consisting of path of the path to its contents in bytes
-	Map                  map[string][]byte `protobuf:"bytes,1,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *HelmChartVersion) GetHome() string {
+	if m != nil {
+		return m.Home
+	}
+	return ""
 }
 
-func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
-func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
-func (*GitFilesResponse) ProtoMessage()    {}
-func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+func (m *HelmChartVersion) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
 }
-func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *HelmChartVersion) GetKeywords() []string {
+	if m != nil {
+		return m.Keywords
+	}
+	return nil
+}
+
+func (m *HelmChartVersion) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+type HelmChartVersionsResponse struct {
+	Items                []*HelmChartVersion `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *HelmChartVersionsResponse) Reset()         { *m = HelmChartVersionsResponse{} }
+func (m *HelmChartVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*HelmChartVersionsResponse) ProtoMessage()    {}
+func (*HelmChartVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{33}
+}
+func (m *HelmChartVersionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GitFilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HelmChartVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GitFilesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HelmChartVersionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2109,51 +2403,45 @@ func (m *GitFilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GitFilesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GitFilesResponse.Merge(m, src)
+func (m *HelmChartVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmChartVersionsResponse.Merge(m, src)
 }
-func (m *GitFilesResponse) XXX_Size() int {
+func (m *HelmChartVersionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GitFilesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GitFilesResponse.DiscardUnknown(m)
+func (m *HelmChartVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmChartVersionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GitFilesResponse proto.InternalMessageInfo
+var xxx_messageInfo_HelmChartVersionsResponse proto.InternalMessageInfo
 
-func (m *GitFilesResponse) GetMap() map[string][]byte {
+func (m *HelmChartVersionsResponse) GetItems() []*HelmChartVersion {
 	if m != nil {
-		return m.Map
+		return m.Items
 	}
 	return nil
 }
 
-type GitDirectoriesRequest struct {
-	Repo             *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	SubmoduleEnabled bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
-	Revision         string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	NoRevisionCache  bool                 `protobuf:"varint,4,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	// Deprecated: Use sourceIntegrity for more detailed information. verifyCommit will be removed with the next major version.
-	VerifyCommit bool `protobuf:"varint,5,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
-	// Source integrity constrains to verify the sources before use
-	SourceIntegrity      *v1alpha1.SourceIntegrity `protobuf:"bytes,6,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+type GitTagsRequest struct {
+	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Tags                 []string             `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
-func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
-func (*GitDirectoriesRequest) ProtoMessage()    {}
-func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+func (m *GitTagsRequest) Reset()         { *m = GitTagsRequest{} }
+func (m *GitTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*GitTagsRequest) ProtoMessage()    {}
+func (*GitTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{34}
 }
-func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
+func (m *GitTagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GitDirectoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GitTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GitDirectoriesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GitTagsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2163,80 +2451,130 @@ func (m *GitDirectoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GitDirectoriesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GitDirectoriesRequest.Merge(m, src)
+func (m *GitTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitTagsRequest.Merge(m, src)
 }
-func (m *GitDirectoriesRequest) XXX_Size() int {
+func (m *GitTagsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GitDirectoriesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GitDirectoriesRequest.DiscardUnknown(m)
+func (m *GitTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitTagsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GitDirectoriesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GitTagsRequest proto.InternalMessageInfo
 
-func (m *GitDirectoriesRequest) GetRepo() *v1alpha1.Repository {
+func (m *GitTagsRequest) GetRepo() *v1alpha1.Repository {
 	if m != nil {
 		return m.Repo
 	}
 	return nil
 }
 
-func (m *GitDirectoriesRequest) GetSubmoduleEnabled() bool {
+func (m *GitTagsRequest) GetTags() []string {
 	if m != nil {
-		return m.SubmoduleEnabled
+		return m.Tags
 	}
-	return false
+	return nil
 }
 
-func (m *GitDirectoriesRequest) GetRevision() string {
+type GitTag struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sha                  string   `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	Author               string   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Date                 string   `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GitTag) Reset()         { *m = GitTag{} }
+func (m *GitTag) String() string { return proto.CompactTextString(m) }
+func (*GitTag) ProtoMessage()    {}
+func (*GitTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{35}
+}
+func (m *GitTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GitTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GitTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GitTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitTag.Merge(m, src)
+}
+func (m *GitTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *GitTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GitTag proto.InternalMessageInfo
+
+func (m *GitTag) GetName() string {
 	if m != nil {
-		return m.Revision
+		return m.Name
 	}
 	return ""
 }
 
-func (m *GitDirectoriesRequest) GetNoRevisionCache() bool {
+func (m *GitTag) GetSha() string {
 	if m != nil {
-		return m.NoRevisionCache
+		return m.Sha
 	}
-	return false
+	return ""
 }
 
-func (m *GitDirectoriesRequest) GetVerifyCommit() bool {
+func (m *GitTag) GetAuthor() string {
 	if m != nil {
-		return m.VerifyCommit
+		return m.Author
 	}
-	return false
+	return ""
 }
 
-func (m *GitDirectoriesRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
+func (m *GitTag) GetDate() string {
 	if m != nil {
-		return m.SourceIntegrity
+		return m.Date
 	}
-	return nil
+	return ""
 }
 
-type GitDirectoriesResponse struct {
-	// A set of directory paths
-	Paths                []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GitTag) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{} }
-func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
-func (*GitDirectoriesResponse) ProtoMessage()    {}
-func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+type GitTagsResponse struct {
+	Tags                 []*GitTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GitTagsResponse) Reset()         { *m = GitTagsResponse{} }
+func (m *GitTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*GitTagsResponse) ProtoMessage()    {}
+func (*GitTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{36}
+}
+func (m *GitTagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GitDirectoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GitTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GitDirectoriesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GitTagsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2246,60 +2584,53 @@ func (m *GitDirectoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GitDirectoriesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GitDirectoriesResponse.Merge(m, src)
+func (m *GitTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitTagsResponse.Merge(m, src)
 }
-func (m *GitDirectoriesResponse) XXX_Size() int {
+func (m *GitTagsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GitDirectoriesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GitDirectoriesResponse.DiscardUnknown(m)
+func (m *GitTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitTagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GitDirectoriesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GitTagsResponse proto.InternalMessageInfo
 
-func (m *GitDirectoriesResponse) GetPaths() []string {
+func (m *GitTagsResponse) GetTags() []*GitTag {
 	if m != nil {
-		return m.Paths
+		return m.Tags
 	}
 	return nil
 }
 
-type UpdateRevisionForPathsRequest struct {
-	Repo                 *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	AppLabelKey          string                         `protobuf:"bytes,2,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
-	AppName              string                         `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
-	Namespace            string                         `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ApplicationSource    *v1alpha1.ApplicationSource    `protobuf:"bytes,5,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
-	TrackingMethod       string                         `protobuf:"bytes,6,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,7,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	KubeVersion          string                         `protobuf:"bytes,8,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
-	ApiVersions          []string                       `protobuf:"bytes,9,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
-	HasMultipleSources   bool                           `protobuf:"varint,10,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
-	SyncedRevision       string                         `protobuf:"bytes,11,opt,name=syncedRevision,proto3" json:"syncedRevision,omitempty"`
-	Revision             string                         `protobuf:"bytes,12,opt,name=revision,proto3" json:"revision,omitempty"`
-	Paths                []string                       `protobuf:"bytes,13,rep,name=paths,proto3" json:"paths,omitempty"`
-	NoRevisionCache      bool                           `protobuf:"varint,14,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	InstallationID       string                         `protobuf:"bytes,15,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	SyncedRefSources     map[string]*v1alpha1.RefTarget `protobuf:"bytes,16,rep,name=syncedRefSources,proto3" json:"syncedRefSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	SourceIntegrity      *v1alpha1.SourceIntegrity      `protobuf:"bytes,17,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+type GitFilesRequest struct {
+	Repo                      *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	SubmoduleEnabled          bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
+	Revision                  string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Path                      string               `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	NewGitFileGlobbingEnabled bool                 `protobuf:"varint,5,opt,name=NewGitFileGlobbingEnabled,proto3" json:"NewGitFileGlobbingEnabled,omitempty"`
+	NoRevisionCache           bool                 `protobuf:"varint,6,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	// Deprecated: Use sourceIntegrity for more detailed information. verifyCommit will be removed with the next major version.
+	VerifyCommit bool `protobuf:"varint,7,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
+	// Source integrity constrains to verify the sources before use
+	SourceIntegrity      *v1alpha1.SourceIntegrity `protobuf:"bytes,8,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionForPathsRequest{} }
-func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
-func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
+func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
+func (*GitFilesRequest) ProtoMessage()    {}
+func (*GitFilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{37}
 }
-func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateRevisionForPathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GitFilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateRevisionForPathsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GitFilesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2309,160 +2640,231 @@ func (m *UpdateRevisionForPathsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *UpdateRevisionForPathsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateRevisionForPathsRequest.Merge(m, src)
+func (m *GitFilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitFilesRequest.Merge(m, src)
 }
-func (m *UpdateRevisionForPathsRequest) XXX_Size() int {
+func (m *GitFilesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateRevisionForPathsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateRevisionForPathsRequest.DiscardUnknown(m)
+func (m *GitFilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitFilesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateRevisionForPathsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GitFilesRequest proto.InternalMessageInfo
 
-func (m *UpdateRevisionForPathsRequest) GetRepo() *v1alpha1.Repository {
+func (m *GitFilesRequest) GetRepo() *v1alpha1.Repository {
 	if m != nil {
 		return m.Repo
 	}
 	return nil
 }
 
-func (m *UpdateRevisionForPathsRequest) GetAppLabelKey() string {
+func (m *GitFilesRequest) GetSubmoduleEnabled() bool {
 	if m != nil {
-		return m.AppLabelKey
+		return m.SubmoduleEnabled
 	}
-	return ""
+	return false
 }
 
-func (m *UpdateRevisionForPathsRequest) GetAppName() string {
+func (m *GitFilesRequest) GetRevision() string {
 	if m != nil {
-		return m.AppName
+		return m.Revision
 	}
 	return ""
 }
 
-func (m *UpdateRevisionForPathsRequest) GetNamespace() string {
+func (m *GitFilesRequest) GetPath() string {
 	if m != nil {
-		return m.Namespace
+		return m.Path
 	}
 	return ""
 }
 
-func (m *UpdateRevisionForPathsRequest) GetApplicationSource() *v1alpha1.ApplicationSource {
-	if m != nil {
-		return m.ApplicationSource
-	}
-	return nil
-}
-
-func (m *UpdateRevisionForPathsRequest) GetTrackingMethod() string {
+func (m *GitFilesRequest) GetNewGitFileGlobbingEnabled() bool {
 	if m != nil {
-		return m.TrackingMethod
+		return m.NewGitFileGlobbingEnabled
 	}
-	return ""
+	return false
 }
 
-func (m *UpdateRevisionForPathsRequest) GetRefSources() map[string]*v1alpha1.RefTarget {
+func (m *GitFilesRequest) GetNoRevisionCache() bool {
 	if m != nil {
-		return m.RefSources
+		return m.NoRevisionCache
 	}
-	return nil
+	return false
 }
 
-func (m *UpdateRevisionForPathsRequest) GetKubeVersion() string {
+func (m *GitFilesRequest) GetVerifyCommit() bool {
 	if m != nil {
-		return m.KubeVersion
+		return m.VerifyCommit
 	}
-	return ""
+	return false
 }
 
-func (m *UpdateRevisionForPathsRequest) GetApiVersions() []string {
+func (m *GitFilesRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
 	if m != nil {
-		return m.ApiVersions
+		return m.SourceIntegrity
 	}
 	return nil
 }
 
-func (m *UpdateRevisionForPathsRequest) GetHasMultipleSources() bool {
-	if m != nil {
-		return m.HasMultipleSources
+type GitFilesResponse struct {
+	// Map consisting of path of the path to its contents in bytes
+	Map                  map[string][]byte `protobuf:"bytes,1,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
+func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
+func (*GitFilesResponse) ProtoMessage()    {}
+func (*GitFilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{38}
+}
+func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GitFilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GitFilesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *GitFilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitFilesResponse.Merge(m, src)
+}
+func (m *GitFilesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GitFilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitFilesResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateRevisionForPathsRequest) GetSyncedRevision() string {
+var xxx_messageInfo_GitFilesResponse proto.InternalMessageInfo
+
+func (m *GitFilesResponse) GetMap() map[string][]byte {
 	if m != nil {
-		return m.SyncedRevision
+		return m.Map
 	}
-	return ""
+	return nil
 }
 
-func (m *UpdateRevisionForPathsRequest) GetRevision() string {
-	if m != nil {
-		return m.Revision
+type GitDirectoriesRequest struct {
+	Repo             *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	SubmoduleEnabled bool                 `protobuf:"varint,2,opt,name=submoduleEnabled,proto3" json:"submoduleEnabled,omitempty"`
+	Revision         string               `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	NoRevisionCache  bool                 `protobuf:"varint,4,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	// Deprecated: Use sourceIntegrity for more detailed information. verifyCommit will be removed with the next major version.
+	VerifyCommit bool `protobuf:"varint,5,opt,name=verifyCommit,proto3" json:"verifyCommit,omitempty"`
+	// Source integrity constrains to verify the sources before use
+	SourceIntegrity      *v1alpha1.SourceIntegrity `protobuf:"bytes,6,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
+func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*GitDirectoriesRequest) ProtoMessage()    {}
+func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{39}
+}
+func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GitDirectoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GitDirectoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GitDirectoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitDirectoriesRequest.Merge(m, src)
+}
+func (m *GitDirectoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GitDirectoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitDirectoriesRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateRevisionForPathsRequest) GetPaths() []string {
+var xxx_messageInfo_GitDirectoriesRequest proto.InternalMessageInfo
+
+func (m *GitDirectoriesRequest) GetRepo() *v1alpha1.Repository {
 	if m != nil {
-		return m.Paths
+		return m.Repo
 	}
 	return nil
 }
 
-func (m *UpdateRevisionForPathsRequest) GetNoRevisionCache() bool {
+func (m *GitDirectoriesRequest) GetSubmoduleEnabled() bool {
 	if m != nil {
-		return m.NoRevisionCache
+		return m.SubmoduleEnabled
 	}
 	return false
 }
 
-func (m *UpdateRevisionForPathsRequest) GetInstallationID() string {
+func (m *GitDirectoriesRequest) GetRevision() string {
 	if m != nil {
-		return m.InstallationID
+		return m.Revision
 	}
 	return ""
 }
 
-func (m *UpdateRevisionForPathsRequest) GetSyncedRefSources() map[string]*v1alpha1.RefTarget {
+func (m *GitDirectoriesRequest) GetNoRevisionCache() bool {
 	if m != nil {
-		return m.SyncedRefSources
+		return m.NoRevisionCache
 	}
-	return nil
+	return false
 }
 
-func (m *UpdateRevisionForPathsRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
+func (m *GitDirectoriesRequest) GetVerifyCommit() bool {
+	if m != nil {
+		return m.VerifyCommit
+	}
+	return false
+}
+
+func (m *GitDirectoriesRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
 	if m != nil {
 		return m.SourceIntegrity
 	}
 	return nil
 }
 
-type UpdateRevisionForPathsResponse struct {
-	// Changes indicates whether any changes were detected in the provided paths. If false, it means that the manifest
-	// cache was updated to the new revision. If true, it means that there are relevant changes in the repo files and
-	// that new manifests should be generated.
-	Changes              bool     `protobuf:"varint,1,opt,name=changes,proto3" json:"changes,omitempty"`
-	Revision             string   `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+type GitDirectoriesResponse struct {
+	// A set of directory paths
+	Paths                []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionForPathsResponse{} }
-func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
-func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{} }
+func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*GitDirectoriesResponse) ProtoMessage()    {}
+func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{40}
 }
-func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateRevisionForPathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GitDirectoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateRevisionForPathsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GitDirectoriesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2472,246 +2874,509 @@ func (m *UpdateRevisionForPathsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *UpdateRevisionForPathsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateRevisionForPathsResponse.Merge(m, src)
+func (m *GitDirectoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitDirectoriesResponse.Merge(m, src)
 }
-func (m *UpdateRevisionForPathsResponse) XXX_Size() int {
+func (m *GitDirectoriesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateRevisionForPathsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateRevisionForPathsResponse.DiscardUnknown(m)
+func (m *GitDirectoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitDirectoriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateRevisionForPathsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GitDirectoriesResponse proto.InternalMessageInfo
 
-func (m *UpdateRevisionForPathsResponse) GetChanges() bool {
+func (m *GitDirectoriesResponse) GetPaths() []string {
 	if m != nil {
-		return m.Changes
+		return m.Paths
 	}
-	return false
+	return nil
 }
 
-func (m *UpdateRevisionForPathsResponse) GetRevision() string {
-	if m != nil {
-		return m.Revision
-	}
-	return ""
+type UpdateRevisionForPathsRequest struct {
+	Repo                 *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	AppLabelKey          string                         `protobuf:"bytes,2,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	AppName              string                         `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+	Namespace            string                         `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApplicationSource    *v1alpha1.ApplicationSource    `protobuf:"bytes,5,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
+	TrackingMethod       string                         `protobuf:"bytes,6,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,7,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KubeVersion          string                         `protobuf:"bytes,8,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	ApiVersions          []string                       `protobuf:"bytes,9,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
+	HasMultipleSources   bool                           `protobuf:"varint,10,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
+	SyncedRevision       string                         `protobuf:"bytes,11,opt,name=syncedRevision,proto3" json:"syncedRevision,omitempty"`
+	Revision             string                         `protobuf:"bytes,12,opt,name=revision,proto3" json:"revision,omitempty"`
+	Paths                []string                       `protobuf:"bytes,13,rep,name=paths,proto3" json:"paths,omitempty"`
+	NoRevisionCache      bool                           `protobuf:"varint,14,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	InstallationID       string                         `protobuf:"bytes,15,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	SyncedRefSources     map[string]*v1alpha1.RefTarget `protobuf:"bytes,16,rep,name=syncedRefSources,proto3" json:"syncedRefSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SourceIntegrity      *v1alpha1.SourceIntegrity      `protobuf:"bytes,17,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func init() {
-	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
-	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
-	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
-	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
-	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
-	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
-	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
-	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
-	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
-	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
-	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
-	proto.RegisterType((*Refs)(nil), "repository.Refs")
-	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.ListAppsRequest.EnabledSourceTypesEntry")
-	proto.RegisterType((*AppList)(nil), "repository.AppList")
-	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
-	proto.RegisterType((*PluginInfo)(nil), "repository.PluginInfo")
-	proto.RegisterType((*PluginList)(nil), "repository.PluginList")
-	proto.RegisterType((*RepoServerAppDetailsQuery)(nil), "repository.RepoServerAppDetailsQuery")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.RepoServerAppDetailsQuery.EnabledSourceTypesEntry")
-	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.RepoServerAppDetailsQuery.RefSourcesEntry")
-	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
-	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
-	proto.RegisterType((*RepoServerRevisionChartDetailsRequest)(nil), "repository.RepoServerRevisionChartDetailsRequest")
-	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
-	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
-	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
-	proto.RegisterType((*ParameterAnnouncement)(nil), "repository.ParameterAnnouncement")
-	proto.RegisterMapType((map[string]string)(nil), "repository.ParameterAnnouncement.MapEntry")
-	proto.RegisterType((*PluginAppSpec)(nil), "repository.PluginAppSpec")
-	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
-	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
-	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
-	proto.RegisterType((*GitFilesRequest)(nil), "repository.GitFilesRequest")
-	proto.RegisterType((*GitFilesResponse)(nil), "repository.GitFilesResponse")
-	proto.RegisterMapType((map[string][]byte)(nil), "repository.GitFilesResponse.MapEntry")
-	proto.RegisterType((*GitDirectoriesRequest)(nil), "repository.GitDirectoriesRequest")
-	proto.RegisterType((*GitDirectoriesResponse)(nil), "repository.GitDirectoriesResponse")
-	proto.RegisterType((*UpdateRevisionForPathsRequest)(nil), "repository.UpdateRevisionForPathsRequest")
-	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.UpdateRevisionForPathsRequest.RefSourcesEntry")
-	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.UpdateRevisionForPathsRequest.SyncedRefSourcesEntry")
-	proto.RegisterType((*UpdateRevisionForPathsResponse)(nil), "repository.UpdateRevisionForPathsResponse")
+func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionForPathsRequest{} }
+func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
+func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{41}
 }
-
-func init() {
-	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_dd8723cfcc820480)
+func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0xdc, 0x48,
-	0xd5, 0xf3, 0x61, 0x7b, 0xe6, 0xd9, 0xb1, 0xc7, 0x9d, 0xd8, 0x51, 0x26, 0x89, 0xf1, 0x0a, 0x92,
-	0xca, 0x26, 0xbb, 0xe3, 0x4a, 0x52, 0xbb, 0x81, 0xec, 0xb2, 0x5b, 0x5e, 0x27, 0xb1, 0xbd, 0x89,
-	0x13, 0x23, 0x67, 0x17, 0x02, 0x01, 0xaa, 0xad, 0x69, 0x6b, 0xb4, 0xd6, 0x47, 0x47, 0x6a, 0x39,
-	0x4c, 0xaa, 0x38, 0x41, 0x71, 0xa2, 0x28, 0x4e, 0x7b, 0xe0, 0x7f, 0x50, 0xdc, 0xe0, 0x08, 0x17,
-	0xaa, 0xb6, 0xa8, 0xe2, 0x0c, 0x95, 0xbf, 0xc0, 0x81, 0x2b, 0xd5, 0x1f, 0xd2, 0x48, 0x1a, 0xcd,
-	0xd8, 0x9b, 0x71, 0x26, 0xc0, 0xc5, 0x56, 0xb7, 0x5e, 0xbf, 0xf7, 0xf4, 0xfa, 0x7d, 0xbf, 0x81,
-	0xcb, 0x01, 0xa1, 0x7e, 0x48, 0x82, 0x43, 0x12, 0xac, 0x8a, 0x47, 0x9b, 0xf9, 0x41, 0x37, 0xf5,
-	0xd8, 0xa2, 0x81, 0xcf, 0x7c, 0x04, 0xbd, 0x9d, 0xe6, 0x03, 0xcb, 0x66, 0x9d, 0x68, 0xaf, 0x65,
-	0xfa, 0xee, 0x2a, 0x0e, 0x2c, 0x9f, 0x06, 0xfe, 0x17, 0xe2, 0xe1, 0x5d, 0xb3, 0xbd, 0x7a, 0x78,
-	0x73, 0x95, 0x1e, 0x58, 0xab, 0x98, 0xda, 0xe1, 0x2a, 0xa6, 0xd4, 0xb1, 0x4d, 0xcc, 0x6c, 0xdf,
-	0x5b, 0x3d, 0xbc, 0x8e, 0x1d, 0xda, 0xc1, 0xd7, 0x57, 0x2d, 0xe2, 0x91, 0x00, 0x33, 0xd2, 0x96,
-	0x98, 0x9b, 0xe7, 0x2d, 0xdf, 0xb7, 0x1c, 0xb2, 0x2a, 0x56, 0x7b, 0xd1, 0xfe, 0x2a, 0x71, 0x29,
-	0x53, 0x64, 0xf5, 0xbf, 0xcf, 0xc1, 0xfc, 0x36, 0xf6, 0xec, 0x7d, 0x12, 0x32, 0x83, 0x3c, 0x8b,
-	0x48, 0xc8, 0xd0, 0x53, 0xa8, 0x72, 0x66, 0xb4, 0xd2, 0x4a, 0xe9, 0xca, 0xcc, 0x8d, 0xcd, 0x56,
-	0x8f, 0x9b, 0x56, 0xcc, 0x8d, 0x78, 0xf8, 0xa9, 0xd9, 0x6e, 0x1d, 0xde, 0x6c, 0xd1, 0x03, 0xab,
-	0xc5, 0xb9, 0x69, 0xa5, 0xb8, 0x69, 0xc5, 0xdc, 0xb4, 0x8c, 0xe4, 0xb3, 0x0c, 0x81, 0x15, 0x35,
-	0xa1, 0x16, 0x90, 0x43, 0x3b, 0xb4, 0x7d, 0x4f, 0x2b, 0xaf, 0x94, 0xae, 0xd4, 0x8d, 0x64, 0x8d,
-	0x34, 0x98, 0xf6, 0xfc, 0x75, 0x6c, 0x76, 0x88, 0x56, 0x59, 0x29, 0x5d, 0xa9, 0x19, 0xf1, 0x12,
-	0xad, 0xc0, 0x0c, 0xa6, 0xf4, 0x01, 0xde, 0x23, 0xce, 0x7d, 0xd2, 0xd5, 0xaa, 0xe2, 0x60, 0x7a,
-	0x8b, 0x9f, 0xc5, 0x94, 0x3e, 0xc4, 0x2e, 0xd1, 0x26, 0xc5, 0xdb, 0x78, 0x89, 0x2e, 0x40, 0xdd,
-	0xc3, 0x2e, 0x09, 0x29, 0x36, 0x89, 0x56, 0x13, 0xef, 0x7a, 0x1b, 0xe8, 0xe7, 0xb0, 0x90, 0x62,
-	0x7c, 0xd7, 0x8f, 0x02, 0x93, 0x68, 0x20, 0x3e, 0xfd, 0xd1, 0x68, 0x9f, 0xbe, 0x96, 0x47, 0x6b,
-	0xf4, 0x53, 0x42, 0x3f, 0x81, 0x49, 0x71, 0xf3, 0xda, 0xcc, 0x4a, 0xe5, 0x44, 0xa5, 0x2d, 0xd1,
-	0x22, 0x0f, 0xa6, 0xa9, 0x13, 0x59, 0xb6, 0x17, 0x6a, 0xb3, 0x82, 0xc2, 0xe3, 0xd1, 0x28, 0xac,
-	0xfb, 0xde, 0xbe, 0x6d, 0x6d, 0x63, 0x0f, 0x5b, 0xc4, 0x25, 0x1e, 0xdb, 0x11, 0xc8, 0x8d, 0x98,
-	0x08, 0x7a, 0x01, 0x8d, 0x83, 0x28, 0x64, 0xbe, 0x6b, 0xbf, 0x20, 0x8f, 0x28, 0x3f, 0x1b, 0x6a,
-	0xa7, 0x84, 0x34, 0x1f, 0x8e, 0x46, 0xf8, 0x7e, 0x0e, 0xab, 0xd1, 0x47, 0x87, 0x2b, 0xc9, 0x41,
-	0xb4, 0x47, 0x3e, 0x27, 0x81, 0xd0, 0xae, 0x39, 0xa9, 0x24, 0xa9, 0x2d, 0xa9, 0x46, 0xb6, 0x5a,
-	0x85, 0xda, 0xfc, 0x4a, 0x45, 0xaa, 0x51, 0xb2, 0x85, 0xae, 0xc0, 0xfc, 0x21, 0x09, 0xec, 0xfd,
-	0xee, 0xae, 0x6d, 0x79, 0x98, 0x45, 0x01, 0xd1, 0x1a, 0x42, 0x15, 0xf3, 0xdb, 0xc8, 0x85, 0x53,
-	0x1d, 0xe2, 0xb8, 0x5c, 0xe4, 0xeb, 0x01, 0x69, 0x87, 0xda, 0x82, 0x90, 0xef, 0xc6, 0xe8, 0x37,
-	0x28, 0xd0, 0x19, 0x59, 0xec, 0x9c, 0x31, 0xcf, 0x37, 0x94, 0xa5, 0x48, 0x1b, 0x41, 0x92, 0xb1,
-	0xdc, 0x36, 0xba, 0x0c, 0x73, 0x2c, 0xc0, 0xe6, 0x81, 0xed, 0x59, 0xdb, 0x84, 0x75, 0xfc, 0xb6,
-	0x76, 0x5a, 0x48, 0x22, 0xb7, 0x8b, 0x4c, 0x40, 0xc4, 0xc3, 0x7b, 0x0e, 0x69, 0x4b, 0x5d, 0x7c,
-	0xdc, 0xa5, 0x24, 0xd4, 0xce, 0x88, 0xaf, 0xb8, 0xd9, 0x4a, 0x79, 0xa8, 0x9c, 0x83, 0x68, 0xdd,
-	0xed, 0x3b, 0x75, 0xd7, 0x63, 0x41, 0xd7, 0x28, 0x40, 0x87, 0x0e, 0x60, 0x86, 0x7f, 0x47, 0xac,
-	0x0a, 0x8b, 0x42, 0x15, 0xb6, 0x46, 0x93, 0xd1, 0x66, 0x0f, 0xa1, 0x91, 0xc6, 0x8e, 0x5a, 0x80,
-	0x3a, 0x38, 0xdc, 0x8e, 0x1c, 0x66, 0x53, 0x87, 0x48, 0x36, 0x42, 0x6d, 0x49, 0x88, 0xa9, 0xe0,
-	0x0d, 0xba, 0x0f, 0x10, 0x90, 0xfd, 0x18, 0xee, 0xac, 0xf8, 0xf2, 0x6b, 0xc3, 0xbe, 0xdc, 0x48,
-	0xa0, 0xe5, 0x17, 0xa7, 0x8e, 0x73, 0xe2, 0xfc, 0x33, 0x88, 0xc9, 0x94, 0xb5, 0x0b, 0xb3, 0xd6,
-	0x84, 0x8a, 0x15, 0xbc, 0xe1, 0xba, 0xa8, 0x76, 0x85, 0xd3, 0x3a, 0x27, 0xb5, 0x35, 0xb5, 0x85,
-	0x36, 0xe1, 0x1b, 0xd8, 0xf3, 0x7c, 0x26, 0x3e, 0x3f, 0x66, 0x65, 0x43, 0xb9, 0xf7, 0x1d, 0xcc,
-	0x3a, 0xa1, 0xd6, 0x14, 0xa7, 0x8e, 0x02, 0xe3, 0x2a, 0x61, 0x7b, 0x21, 0xc3, 0x8e, 0x23, 0x80,
-	0xb6, 0xee, 0x68, 0xe7, 0xa5, 0x4a, 0x64, 0x77, 0xd1, 0x73, 0x98, 0x0f, 0x05, 0x8b, 0x5b, 0x1e,
-	0x23, 0x56, 0x60, 0xb3, 0xae, 0x76, 0x41, 0xdc, 0xd8, 0xf6, 0x68, 0x37, 0xb6, 0x9b, 0x45, 0x6a,
-	0xe4, 0xa9, 0x34, 0xef, 0xc2, 0xd9, 0x01, 0x5a, 0x85, 0x1a, 0x50, 0x39, 0x20, 0x5d, 0x11, 0x8d,
-	0xea, 0x06, 0x7f, 0x44, 0x67, 0x60, 0xf2, 0x10, 0x3b, 0x11, 0x11, 0xf1, 0xa3, 0x66, 0xc8, 0xc5,
-	0xed, 0xf2, 0xb7, 0x4b, 0xcd, 0x5f, 0x95, 0x60, 0x3e, 0x77, 0x47, 0x05, 0xe7, 0x7f, 0x9c, 0x3e,
-	0x7f, 0x02, 0x16, 0xbb, 0xff, 0x18, 0x07, 0x16, 0x61, 0x29, 0x46, 0xf4, 0xbf, 0x95, 0x40, 0xcb,
-	0x29, 0xcf, 0xf7, 0x6d, 0xd6, 0xb9, 0x67, 0x3b, 0x24, 0x44, 0xb7, 0x60, 0x3a, 0x90, 0x7b, 0x2a,
-	0xc6, 0x9e, 0x1f, 0xa2, 0x73, 0x9b, 0x13, 0x46, 0x0c, 0x8d, 0x3e, 0x82, 0x9a, 0x4b, 0x18, 0x6e,
-	0x63, 0x86, 0x15, 0xef, 0x2b, 0x45, 0x27, 0x39, 0x95, 0x6d, 0x05, 0xb7, 0x39, 0x61, 0x24, 0x67,
-	0xd0, 0x7b, 0x30, 0x69, 0x76, 0x22, 0xef, 0x40, 0x44, 0xd7, 0x99, 0x1b, 0x17, 0x07, 0x1d, 0x5e,
-	0xe7, 0x40, 0x9b, 0x13, 0x86, 0x84, 0xfe, 0x64, 0x0a, 0xaa, 0x14, 0x07, 0x4c, 0xbf, 0x07, 0x67,
-	0x8a, 0x48, 0xf0, 0x90, 0x6e, 0x76, 0x88, 0x79, 0x10, 0x46, 0xae, 0x12, 0x73, 0xb2, 0x46, 0x08,
-	0xaa, 0xa1, 0xfd, 0x42, 0x8a, 0xba, 0x62, 0x88, 0x67, 0xfd, 0x6d, 0x58, 0xe8, 0xa3, 0xc6, 0x2f,
-	0x55, 0xf2, 0xc6, 0x31, 0xcc, 0x2a, 0xd2, 0x7a, 0x04, 0x8b, 0x8f, 0x85, 0x2c, 0x92, 0xb8, 0x36,
-	0x8e, 0x24, 0x45, 0xdf, 0x84, 0xa5, 0x3c, 0xd9, 0x90, 0xfa, 0x5e, 0x48, 0xb8, 0x95, 0x8b, 0x40,
-	0x60, 0x93, 0x76, 0xef, 0xad, 0xe0, 0xa2, 0x66, 0x14, 0xbc, 0xd1, 0xff, 0x5a, 0x86, 0x25, 0x83,
-	0x84, 0xbe, 0x73, 0x48, 0x62, 0x2f, 0x3d, 0x9e, 0x3c, 0xeb, 0x47, 0x50, 0xc1, 0x94, 0x2a, 0x35,
-	0xd9, 0x3a, 0xb1, 0x4c, 0xc6, 0xe0, 0x58, 0xd1, 0x3b, 0xb0, 0x80, 0xdd, 0x3d, 0xdb, 0x8a, 0xfc,
-	0x28, 0x8c, 0x3f, 0x4b, 0x28, 0x55, 0xdd, 0xe8, 0x7f, 0xc1, 0x3d, 0x5d, 0x6c, 0xef, 0x6d, 0xf2,
-	0x33, 0x91, 0xbc, 0x55, 0x8c, 0xf4, 0x56, 0x51, 0x70, 0x9b, 0x2c, 0x0c, 0x6e, 0xba, 0x09, 0x67,
-	0xfb, 0xc4, 0xa9, 0xae, 0x26, 0x9d, 0x59, 0x96, 0x72, 0x99, 0x65, 0x21, 0xc3, 0xe5, 0x01, 0x0c,
-	0xeb, 0xff, 0x2a, 0x43, 0xa3, 0x67, 0x86, 0x0a, 0xfd, 0x05, 0xa8, 0xbb, 0x6a, 0x2f, 0xd4, 0x4a,
-	0xc2, 0xad, 0xf7, 0x36, 0xb2, 0x49, 0x66, 0x39, 0x9f, 0x64, 0x2e, 0xc1, 0x94, 0xac, 0x01, 0x94,
-	0x90, 0xd4, 0x2a, 0xc3, 0x72, 0x35, 0xc7, 0xf2, 0x32, 0x40, 0x98, 0xf8, 0x42, 0x6d, 0x4a, 0xbc,
-	0x4d, 0xed, 0x20, 0x1d, 0x66, 0x65, 0x4a, 0x62, 0x90, 0x30, 0x72, 0x98, 0x36, 0x2d, 0x20, 0x32,
-	0x7b, 0xc2, 0x32, 0x7d, 0xd7, 0xc5, 0x5e, 0x3b, 0xd4, 0x6a, 0x82, 0xe5, 0x64, 0x8d, 0x7e, 0x53,
-	0x82, 0xc5, 0x9c, 0x1b, 0x56, 0x98, 0xea, 0x42, 0x67, 0x7e, 0x70, 0xa2, 0x2e, 0x7f, 0x9d, 0x3b,
-	0x04, 0x89, 0xdf, 0x28, 0x26, 0xab, 0xfb, 0x30, 0xff, 0xc0, 0xe6, 0x02, 0xdf, 0x0f, 0xc7, 0x63,
-	0xe5, 0xef, 0x43, 0x95, 0x13, 0xe3, 0x52, 0xda, 0x0b, 0xb0, 0x67, 0x76, 0x48, 0x7c, 0xb1, 0xc9,
-	0x9a, 0xfb, 0x2f, 0x86, 0xad, 0x50, 0x2b, 0x8b, 0x7d, 0xf1, 0xac, 0xff, 0xa1, 0x2c, 0x39, 0x5d,
-	0xa3, 0x34, 0x7c, 0xf3, 0x45, 0x53, 0x71, 0x1a, 0x57, 0xe9, 0x4f, 0xe3, 0x72, 0x2c, 0x7f, 0x9d,
-	0x34, 0xee, 0x84, 0xe2, 0xb3, 0x1e, 0xc1, 0xf4, 0x1a, 0xa5, 0x9c, 0x11, 0x74, 0x1d, 0xaa, 0x98,
-	0x52, 0x29, 0xf0, 0x5c, 0x28, 0x52, 0x20, 0xfc, 0xbf, 0x62, 0x49, 0x80, 0x36, 0x6f, 0x41, 0x3d,
-	0xd9, 0x3a, 0x8a, 0x6c, 0x3d, 0x4d, 0x76, 0x05, 0x40, 0xd6, 0x29, 0x5b, 0xde, 0xbe, 0xcf, 0xaf,
-	0x94, 0x5b, 0xa6, 0x3a, 0x2a, 0x9e, 0xf5, 0xdb, 0x31, 0x84, 0xe0, 0xed, 0x1d, 0x98, 0xb4, 0x19,
-	0x71, 0x63, 0xe6, 0x96, 0xd2, 0xcc, 0xf5, 0x10, 0x19, 0x12, 0x48, 0xff, 0x73, 0x0d, 0xce, 0xf1,
-	0x1b, 0xdb, 0x15, 0x36, 0xbd, 0x46, 0xe9, 0x1d, 0xc2, 0xb0, 0xed, 0x84, 0xdf, 0x8b, 0x48, 0xd0,
-	0x7d, 0xcd, 0x8a, 0x61, 0xc1, 0x94, 0x34, 0x26, 0xe5, 0xe8, 0x4f, 0xbc, 0x64, 0x55, 0xe8, 0x7b,
-	0x75, 0x6a, 0xe5, 0xf5, 0xd4, 0xa9, 0x45, 0x75, 0x63, 0x75, 0x4c, 0x75, 0xe3, 0xe0, 0xd6, 0x41,
-	0xaa, 0x21, 0x31, 0x95, 0x6d, 0x48, 0x14, 0x44, 0xac, 0xe9, 0xe3, 0x96, 0x63, 0xb5, 0xc2, 0x72,
-	0xcc, 0x2d, 0xb4, 0xe3, 0xba, 0x10, 0xf7, 0x77, 0xd3, 0x1a, 0x38, 0x50, 0xd7, 0x46, 0x29, 0xcc,
-	0xe0, 0xb5, 0x16, 0x66, 0x9f, 0x65, 0x0a, 0x2d, 0xd9, 0xea, 0x78, 0xef, 0x78, 0xdf, 0x34, 0xa4,
-	0xe4, 0xfa, 0xbf, 0xab, 0x1a, 0x7e, 0x29, 0x92, 0x45, 0xea, 0xf7, 0x64, 0x90, 0x64, 0x1f, 0x3c,
-	0x0e, 0xf1, 0x3c, 0x40, 0x39, 0x2d, 0xfe, 0x8c, 0xae, 0x41, 0x95, 0x0b, 0x59, 0x65, 0xf3, 0x67,
-	0xd3, 0xf2, 0xe4, 0x37, 0xb1, 0x46, 0xe9, 0x2e, 0x25, 0xa6, 0x21, 0x80, 0xd0, 0x6d, 0xa8, 0x27,
-	0x8a, 0xaf, 0x2c, 0xeb, 0x42, 0xfa, 0x44, 0x62, 0x27, 0xf1, 0xb1, 0x1e, 0x38, 0x3f, 0xdb, 0xb6,
-	0x03, 0x62, 0x8a, 0x5c, 0x77, 0xb2, 0xff, 0xec, 0x9d, 0xf8, 0x65, 0x72, 0x36, 0x01, 0x47, 0xd7,
-	0x61, 0x4a, 0xf6, 0x86, 0x84, 0x05, 0xcd, 0xdc, 0x38, 0xd7, 0xef, 0x4c, 0xe3, 0x53, 0x0a, 0x50,
-	0xff, 0x63, 0x19, 0xde, 0xea, 0x29, 0x44, 0x6c, 0x4d, 0x71, 0xb9, 0xf1, 0xe6, 0x23, 0xee, 0x65,
-	0x98, 0x13, 0xf5, 0x4d, 0xaf, 0x45, 0x24, 0xbb, 0x95, 0xb9, 0xdd, 0xa2, 0x6a, 0xba, 0x3a, 0x8e,
-	0x6a, 0x5a, 0xff, 0x7d, 0x09, 0x2e, 0xf5, 0x0b, 0x70, 0xbd, 0x83, 0x03, 0x96, 0xe8, 0xd5, 0x38,
-	0x84, 0x18, 0x47, 0xda, 0x72, 0x2f, 0xd2, 0x66, 0x04, 0x5b, 0xc9, 0x0a, 0x56, 0xff, 0x53, 0x19,
-	0x66, 0x52, 0x9a, 0x5b, 0x14, 0xa9, 0x79, 0x5a, 0x2c, 0x0c, 0x46, 0x94, 0xd2, 0x22, 0x1a, 0xd5,
-	0x8d, 0xd4, 0x0e, 0x3a, 0x00, 0xa0, 0x38, 0xc0, 0x2e, 0x61, 0x24, 0xe0, 0x21, 0x84, 0xbb, 0x9a,
-	0xfb, 0xa3, 0xbb, 0xb5, 0x9d, 0x18, 0xa7, 0x91, 0x42, 0xcf, 0xf3, 0x7a, 0x41, 0x3a, 0x54, 0x81,
-	0x43, 0xad, 0xd0, 0x73, 0x98, 0xdb, 0xb7, 0x1d, 0xb2, 0xd3, 0x63, 0x64, 0x4a, 0x30, 0xf2, 0x68,
-	0x74, 0x46, 0xee, 0xa5, 0xf1, 0x1a, 0x39, 0x32, 0xfa, 0x55, 0x68, 0xe4, 0x0d, 0x99, 0x33, 0x69,
-	0xbb, 0xd8, 0x4a, 0xa4, 0xa5, 0x56, 0x3a, 0x82, 0x46, 0xde, 0x70, 0xf5, 0x7f, 0x94, 0x61, 0x31,
-	0x41, 0xb7, 0xe6, 0x79, 0x7e, 0xe4, 0x99, 0xa2, 0xcf, 0x5b, 0x78, 0x17, 0x67, 0x60, 0x92, 0xd9,
-	0xcc, 0x49, 0x32, 0x2e, 0xb1, 0xe0, 0x41, 0x93, 0xf9, 0xbe, 0xc3, 0x6c, 0xaa, 0x2e, 0x38, 0x5e,
-	0xca, 0xbb, 0x7f, 0x16, 0xd9, 0x01, 0x69, 0x0b, 0x4b, 0xa8, 0x19, 0xc9, 0x9a, 0xbf, 0xe3, 0xe9,
-	0x94, 0x28, 0x76, 0xa4, 0x30, 0x93, 0xb5, 0x30, 0x38, 0xdf, 0x71, 0x88, 0xc9, 0xc5, 0x91, 0x2a,
-	0x87, 0x72, 0xbb, 0xa2, 0xcc, 0x62, 0x81, 0xed, 0x59, 0xaa, 0x18, 0x52, 0x2b, 0xce, 0x27, 0x0e,
-	0x02, 0xdc, 0x55, 0x35, 0x90, 0x5c, 0xa0, 0x0f, 0xa1, 0xe2, 0x62, 0xaa, 0x22, 0xec, 0xd5, 0x8c,
-	0x5b, 0x2a, 0x92, 0x40, 0x6b, 0x1b, 0x53, 0x19, 0x82, 0xf8, 0xb1, 0xe6, 0xfb, 0x50, 0x8b, 0x37,
-	0xbe, 0x56, 0x2e, 0xfa, 0x05, 0x9c, 0xca, 0x78, 0x3d, 0xf4, 0x04, 0x96, 0x7a, 0x1a, 0x95, 0x26,
-	0xa8, 0xb2, 0xcf, 0xb7, 0x8e, 0xe4, 0xcc, 0x18, 0x80, 0x40, 0x7f, 0x06, 0x0b, 0x5c, 0x65, 0x84,
-	0xe1, 0x8f, 0xa9, 0xa6, 0xfa, 0x00, 0xea, 0x09, 0xc9, 0x42, 0x9d, 0x69, 0x42, 0xed, 0x30, 0xee,
-	0xbf, 0xcb, 0xa2, 0x2a, 0x59, 0xeb, 0x6b, 0x80, 0xd2, 0xfc, 0xaa, 0xd0, 0x77, 0x2d, 0x9b, 0x8d,
-	0x2f, 0xe6, 0xe3, 0x9c, 0x00, 0x8f, 0x93, 0xf1, 0xaf, 0x2a, 0x30, 0xbf, 0x61, 0x8b, 0xbe, 0xd2,
-	0x98, 0x9c, 0xdc, 0x55, 0x68, 0x84, 0xd1, 0x9e, 0xeb, 0xb7, 0x23, 0x87, 0xa8, 0x6c, 0x44, 0xa5,
-	0x18, 0x7d, 0xfb, 0xc3, 0x9c, 0x1f, 0x17, 0x16, 0xc5, 0xac, 0xa3, 0xfa, 0x00, 0xe2, 0x19, 0x7d,
-	0x08, 0xe7, 0x1e, 0x92, 0xe7, 0xea, 0x7b, 0x36, 0x1c, 0x7f, 0x6f, 0xcf, 0xf6, 0xac, 0x98, 0x88,
-	0xec, 0x90, 0x0c, 0x06, 0x28, 0xca, 0x51, 0xa7, 0x8a, 0x73, 0xd4, 0xa4, 0x97, 0xb0, 0xee, 0xbb,
-	0xae, 0xcd, 0x54, 0x2a, 0x9b, 0xd9, 0x2b, 0x8a, 0x66, 0xb5, 0xb1, 0x44, 0xb3, 0x5f, 0x94, 0xa0,
-	0xd1, 0xbb, 0x52, 0xa5, 0x14, 0xb7, 0xa4, 0xf1, 0x4a, 0x95, 0xb8, 0x94, 0x56, 0x89, 0x3c, 0xe8,
-	0xab, 0xdb, 0xed, 0x6c, 0x26, 0x37, 0xab, 0xc0, 0xe2, 0x86, 0xcd, 0x62, 0x8f, 0x69, 0xff, 0xaf,
-	0xa9, 0x57, 0x81, 0x32, 0x54, 0x8f, 0xa7, 0x0c, 0x93, 0xc7, 0x53, 0x86, 0xa9, 0xb1, 0x28, 0x43,
-	0x0b, 0x96, 0xf2, 0xb7, 0xa0, 0x34, 0xe2, 0x0c, 0x4c, 0x52, 0x31, 0x13, 0x91, 0x2d, 0x1c, 0xb9,
-	0xd0, 0xff, 0x5d, 0x87, 0x8b, 0x9f, 0xd1, 0x36, 0x66, 0x49, 0xbf, 0xf0, 0x9e, 0x1f, 0x88, 0xa1,
-	0xc8, 0x78, 0xae, 0x2f, 0x37, 0xb8, 0x2e, 0x0f, 0x1d, 0x5c, 0x57, 0x86, 0x0c, 0xae, 0xab, 0xc7,
-	0x1a, 0x5c, 0x4f, 0x8e, 0x6d, 0x70, 0xdd, 0x5f, 0xd6, 0x4e, 0x15, 0x96, 0xb5, 0x4f, 0x32, 0xa5,
-	0xdf, 0xb4, 0xb0, 0xd7, 0xef, 0xa4, 0xed, 0x75, 0xe8, 0xed, 0x0c, 0x9d, 0xb8, 0xe5, 0xe6, 0xbd,
-	0xb5, 0x23, 0xe7, 0xbd, 0xf5, 0xfe, 0x79, 0x6f, 0xf1, 0xc8, 0x10, 0x06, 0x8e, 0x0c, 0x2f, 0xc3,
-	0x5c, 0xd8, 0xf5, 0x4c, 0xd2, 0x4e, 0xba, 0xc8, 0x33, 0xf2, 0xb3, 0xb3, 0xbb, 0x19, 0x53, 0x9c,
-	0xcd, 0x99, 0x62, 0xa2, 0xa9, 0xa7, 0x52, 0x9a, 0x5a, 0x64, 0xa0, 0x73, 0x03, 0x3b, 0x0a, 0xb9,
-	0x69, 0xde, 0x7c, 0xe1, 0x34, 0xef, 0x00, 0x1a, 0x31, 0x57, 0xc9, 0x05, 0x34, 0xc4, 0x05, 0x7c,
-	0x7c, 0xfc, 0x0b, 0xd8, 0xcd, 0x61, 0x90, 0xd7, 0xd0, 0x87, 0xb8, 0xc8, 0x23, 0x2c, 0x8c, 0x65,
-	0x74, 0xf8, 0xdf, 0x52, 0xbd, 0x37, 0x7f, 0x5d, 0x82, 0xc5, 0x42, 0x69, 0xbd, 0x99, 0x66, 0xc2,
-	0xe7, 0xb0, 0x3c, 0xe8, 0x66, 0x95, 0xc7, 0xd4, 0x60, 0xda, 0xec, 0x60, 0xcf, 0x12, 0x6d, 0x6f,
-	0xd1, 0xdd, 0x52, 0xcb, 0x61, 0xd5, 0xef, 0x8d, 0x2f, 0x67, 0x61, 0xa1, 0x57, 0x5c, 0xf2, 0xbf,
-	0xb6, 0x49, 0xd0, 0x23, 0x68, 0xc4, 0x23, 0xe7, 0x78, 0x72, 0x82, 0x86, 0x8d, 0x35, 0x9b, 0x17,
-	0x8a, 0x5f, 0x4a, 0xd6, 0xf4, 0x09, 0x64, 0xc2, 0xb9, 0x3c, 0xc2, 0xde, 0x04, 0xf5, 0x5b, 0x43,
-	0x30, 0x27, 0x50, 0x47, 0x91, 0xb8, 0x52, 0x42, 0x4f, 0x60, 0x2e, 0x3b, 0xe7, 0x43, 0x99, 0x6c,
-	0xbb, 0x70, 0xf4, 0xd8, 0xd4, 0x87, 0x81, 0x24, 0xfc, 0x3f, 0xe5, 0x5a, 0x99, 0x19, 0x54, 0x21,
-	0x3d, 0xdb, 0xf1, 0x2a, 0x1a, 0x0a, 0x36, 0xbf, 0x39, 0x14, 0x26, 0xc1, 0xfe, 0x01, 0xd4, 0xe2,
-	0x59, 0x49, 0x56, 0xcc, 0xb9, 0x09, 0x4a, 0xb3, 0x91, 0xc5, 0xb7, 0x1f, 0xea, 0x13, 0xe8, 0x23,
-	0x98, 0xe1, 0x60, 0x8f, 0xd6, 0xb7, 0x1e, 0x63, 0xeb, 0x95, 0xce, 0xd7, 0xe2, 0x59, 0x42, 0xff,
-	0xe1, 0xd4, 0x84, 0xa1, 0x79, 0xba, 0xa0, 0xab, 0xaf, 0x4f, 0xa0, 0x8f, 0x25, 0xfd, 0x1d, 0xf5,
-	0x93, 0xa1, 0xa5, 0x96, 0xfc, 0x85, 0x5a, 0x2b, 0xfe, 0x85, 0x5a, 0xeb, 0xae, 0x4b, 0x59, 0xb7,
-	0x59, 0xd0, 0x76, 0x57, 0x08, 0x9e, 0xc2, 0xa9, 0x0d, 0xc2, 0x7a, 0x5d, 0x32, 0x74, 0xe9, 0x58,
-	0xbd, 0xc4, 0xa6, 0x9e, 0x07, 0xeb, 0x6f, 0xb4, 0xe9, 0x13, 0xe8, 0xcb, 0x12, 0x9c, 0xde, 0x20,
-	0x2c, 0xdf, 0x77, 0x42, 0xef, 0x16, 0x13, 0x19, 0xd0, 0x9f, 0x6a, 0x3e, 0x1c, 0xd5, 0xa6, 0xb3,
-	0x68, 0xf5, 0x09, 0xf4, 0xdb, 0x12, 0xcc, 0x6d, 0x10, 0x7e, 0x6f, 0x09, 0x4f, 0xd7, 0x87, 0xf3,
-	0x54, 0xd0, 0xf2, 0x69, 0x8e, 0xd8, 0xe3, 0x4d, 0x51, 0xd7, 0x27, 0xd0, 0xef, 0x4a, 0x70, 0x36,
-	0x25, 0xab, 0x34, 0xbd, 0x57, 0xe1, 0xed, 0xd3, 0x11, 0x7f, 0x9c, 0x96, 0x42, 0xa9, 0x4f, 0xa0,
-	0x1d, 0xa1, 0x26, 0xbd, 0x8a, 0x12, 0x5d, 0x2c, 0x2c, 0x1d, 0x13, 0xea, 0xcb, 0x83, 0x5e, 0x27,
-	0xaa, 0xf1, 0x29, 0xcc, 0x6c, 0x10, 0x16, 0x57, 0x18, 0x59, 0xe5, 0xcf, 0x55, 0x9d, 0x59, 0xef,
-	0x93, 0x2f, 0x4a, 0x84, 0x12, 0x2f, 0x48, 0x5c, 0xa9, 0x64, 0x36, 0xeb, 0x7e, 0x0a, 0xcb, 0x8d,
-	0xac, 0x12, 0x17, 0xe7, 0xc2, 0xfa, 0x04, 0x7a, 0x06, 0x4b, 0xc5, 0xde, 0x1f, 0xbd, 0x7d, 0xec,
-	0xd8, 0xdf, 0xbc, 0x7a, 0x1c, 0xd0, 0x98, 0xe4, 0x27, 0x6b, 0x7f, 0x79, 0xb9, 0x5c, 0xfa, 0xea,
-	0xe5, 0x72, 0xe9, 0x9f, 0x2f, 0x97, 0x4b, 0x3f, 0xbc, 0x79, 0xc4, 0x8f, 0x58, 0x53, 0xbf, 0x8b,
-	0xc5, 0xd4, 0x36, 0x1d, 0x9b, 0x78, 0x6c, 0x6f, 0x4a, 0xb8, 0x80, 0x9b, 0xff, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0xe0, 0x92, 0xc0, 0x64, 0x36, 0x2b, 0x00, 0x00,
+func (m *UpdateRevisionForPathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRevisionForPathsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
+func (m *UpdateRevisionForPathsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRevisionForPathsRequest.Merge(m, src)
+}
+func (m *UpdateRevisionForPathsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRevisionForPathsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRevisionForPathsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRevisionForPathsRequest proto.InternalMessageInfo
+
+func (m *UpdateRevisionForPathsRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *UpdateRevisionForPathsRequest) GetAppLabelKey() string {
+	if m != nil {
+		return m.AppLabelKey
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetApplicationSource() *v1alpha1.ApplicationSource {
+	if m != nil {
+		return m.ApplicationSource
+	}
+	return nil
+}
+
+func (m *UpdateRevisionForPathsRequest) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetRefSources() map[string]*v1alpha1.RefTarget {
+	if m != nil {
+		return m.RefSources
+	}
+	return nil
+}
+
+func (m *UpdateRevisionForPathsRequest) GetKubeVersion() string {
+	if m != nil {
+		return m.KubeVersion
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetApiVersions() []string {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
+func (m *UpdateRevisionForPathsRequest) GetHasMultipleSources() bool {
+	if m != nil {
+		return m.HasMultipleSources
+	}
+	return false
+}
+
+func (m *UpdateRevisionForPathsRequest) GetSyncedRevision() string {
+	if m != nil {
+		return m.SyncedRevision
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *UpdateRevisionForPathsRequest) GetNoRevisionCache() bool {
+	if m != nil {
+		return m.NoRevisionCache
+	}
+	return false
+}
+
+func (m *UpdateRevisionForPathsRequest) GetInstallationID() string {
+	if m != nil {
+		return m.InstallationID
+	}
+	return ""
+}
+
+func (m *UpdateRevisionForPathsRequest) GetSyncedRefSources() map[string]*v1alpha1.RefTarget {
+	if m != nil {
+		return m.SyncedRefSources
+	}
+	return nil
+}
+
+func (m *UpdateRevisionForPathsRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
+	if m != nil {
+		return m.SourceIntegrity
+	}
+	return nil
+}
+
+type UpdateRevisionForPathsResponse struct {
+	// Changes indicates whether any changes were detected in the provided paths. If false, it means that the manifest
+	// cache was updated to the new revision. If true, it means that there are relevant changes in the repo files and
+	// that new manifests should be generated.
+	Changes              bool     `protobuf:"varint,1,opt,name=changes,proto3" json:"changes,omitempty"`
+	Revision             string   `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionForPathsResponse{} }
+func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
+func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{42}
+}
+func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRevisionForPathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRevisionForPathsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateRevisionForPathsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRevisionForPathsResponse.Merge(m, src)
+}
+func (m *UpdateRevisionForPathsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRevisionForPathsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRevisionForPathsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRevisionForPathsResponse proto.InternalMessageInfo
+
+func (m *UpdateRevisionForPathsResponse) GetChanges() bool {
+	if m != nil {
+		return m.Changes
+	}
+	return false
+}
+
+func (m *UpdateRevisionForPathsResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
+	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
+	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
+	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
+	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
+	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
+	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
+	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
+	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
+	proto.RegisterType((*Refs)(nil), "repository.Refs")
+	proto.RegisterType((*OCIRepositories)(nil), "repository.OCIRepositories")
+	proto.RegisterType((*OCIArtifactsRequest)(nil), "repository.OCIArtifactsRequest")
+	proto.RegisterType((*OCIArtifact)(nil), "repository.OCIArtifact")
+	proto.RegisterMapType((map[string]string)(nil), "repository.OCIArtifact.AnnotationsEntry")
+	proto.RegisterType((*OCIArtifactsResponse)(nil), "repository.OCIArtifactsResponse")
+	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.ListAppsRequest.EnabledSourceTypesEntry")
+	proto.RegisterType((*AppList)(nil), "repository.AppList")
+	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
+	proto.RegisterType((*PluginInfo)(nil), "repository.PluginInfo")
+	proto.RegisterType((*PluginList)(nil), "repository.PluginList")
+	proto.RegisterType((*RepoServerAppDetailsQuery)(nil), "repository.RepoServerAppDetailsQuery")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.RepoServerAppDetailsQuery.EnabledSourceTypesEntry")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.RepoServerAppDetailsQuery.RefSourcesEntry")
+	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
+	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
+	proto.RegisterType((*RepoServerRevisionChartDetailsRequest)(nil), "repository.RepoServerRevisionChartDetailsRequest")
+	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
+	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
+	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+	proto.RegisterType((*ParameterAnnouncement)(nil), "repository.ParameterAnnouncement")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ParameterAnnouncement.MapEntry")
+	proto.RegisterType((*PluginAppSpec)(nil), "repository.PluginAppSpec")
+	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
+	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
+	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
+	proto.RegisterType((*HelmChartVersionsRequest)(nil), "repository.HelmChartVersionsRequest")
+	proto.RegisterType((*HelmChartVersion)(nil), "repository.HelmChartVersion")
+	proto.RegisterType((*HelmChartVersionsResponse)(nil), "repository.HelmChartVersionsResponse")
+	proto.RegisterType((*GitTagsRequest)(nil), "repository.GitTagsRequest")
+	proto.RegisterType((*GitTag)(nil), "repository.GitTag")
+	proto.RegisterType((*GitTagsResponse)(nil), "repository.GitTagsResponse")
+	proto.RegisterType((*GitFilesRequest)(nil), "repository.GitFilesRequest")
+	proto.RegisterType((*GitFilesResponse)(nil), "repository.GitFilesResponse")
+	proto.RegisterMapType((map[string][]byte)(nil), "repository.GitFilesResponse.MapEntry")
+	proto.RegisterType((*GitDirectoriesRequest)(nil), "repository.GitDirectoriesRequest")
+	proto.RegisterType((*GitDirectoriesResponse)(nil), "repository.GitDirectoriesResponse")
+	proto.RegisterType((*UpdateRevisionForPathsRequest)(nil), "repository.UpdateRevisionForPathsRequest")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.UpdateRevisionForPathsRequest.RefSourcesEntry")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.UpdateRevisionForPathsRequest.SyncedRefSourcesEntry")
+	proto.RegisterType((*UpdateRevisionForPathsResponse)(nil), "repository.UpdateRevisionForPathsResponse")
+}
+
+func init() {
+	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_dd8723cfcc820480)
+}
+
+var fileDescriptor_dd8723cfcc820480 = []byte{
+	// 2938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0xcd, 0x73, 0x1c, 0x47,
+	0xf5, 0xda, 0x0f, 0xad, 0x76, 0x9f, 0x6c, 0x69, 0xd5, 0x96, 0xe4, 0xf1, 0xda, 0x71, 0x94, 0xf9,
+	0xc5, 0x2e, 0xc7, 0x49, 0x56, 0x65, 0xbb, 0x9c, 0xfc, 0xf2, 0x81, 0x53, 0x8a, 0x62, 0x4b, 0x8a,
+	0x23, 0xcb, 0x19, 0x39, 0x81, 0x40, 0x80, 0x6a, 0xcd, 0xb6, 0x66, 0x27, 0x9a, 0x2f, 0xcf, 0xf4,
+	0xca, 0x28, 0x55, 0x5c, 0x80, 0x82, 0x0b, 0x45, 0x71, 0xa2, 0x28, 0xfe, 0x04, 0xee, 0x14, 0x37,
+	0x38, 0x70, 0x80, 0x0b, 0x55, 0x29, 0xaa, 0x38, 0x43, 0xe5, 0x3f, 0xa0, 0x38, 0x70, 0xa5, 0xfa,
+	0x63, 0x66, 0x7b, 0x66, 0x7b, 0x57, 0x72, 0xd6, 0x5e, 0x03, 0x17, 0x7b, 0xfa, 0xed, 0xeb, 0xd7,
+	0xaf, 0xdf, 0x7b, 0xfd, 0xbe, 0xba, 0x05, 0x97, 0x63, 0x12, 0x85, 0x09, 0x89, 0x0f, 0x49, 0xbc,
+	0xca, 0x3f, 0x5d, 0x1a, 0xc6, 0x47, 0xca, 0x67, 0x3b, 0x8a, 0x43, 0x1a, 0x22, 0xe8, 0x43, 0x5a,
+	0x1f, 0x38, 0x2e, 0xed, 0xf6, 0xf6, 0xda, 0x76, 0xe8, 0xaf, 0xe2, 0xd8, 0x09, 0xa3, 0x38, 0xfc,
+	0x8c, 0x7f, 0xbc, 0x6a, 0x77, 0x56, 0x0f, 0x6f, 0xac, 0x46, 0x07, 0xce, 0x2a, 0x8e, 0xdc, 0x64,
+	0x15, 0x47, 0x91, 0xe7, 0xda, 0x98, 0xba, 0x61, 0xb0, 0x7a, 0x78, 0x0d, 0x7b, 0x51, 0x17, 0x5f,
+	0x5b, 0x75, 0x48, 0x40, 0x62, 0x4c, 0x49, 0x47, 0x50, 0x6e, 0x9d, 0x77, 0xc2, 0xd0, 0xf1, 0xc8,
+	0x2a, 0x1f, 0xed, 0xf5, 0xf6, 0x57, 0x89, 0x1f, 0x51, 0xb9, 0xac, 0xf9, 0xd7, 0x39, 0x98, 0xdf,
+	0xc6, 0x81, 0xbb, 0x4f, 0x12, 0x6a, 0x91, 0x87, 0x3d, 0x92, 0x50, 0xf4, 0x29, 0x54, 0x19, 0x33,
+	0x46, 0x69, 0xa5, 0x74, 0x65, 0xf6, 0xfa, 0x66, 0xbb, 0xcf, 0x4d, 0x3b, 0xe5, 0x86, 0x7f, 0x7c,
+	0xd7, 0xee, 0xb4, 0x0f, 0x6f, 0xb4, 0xa3, 0x03, 0xa7, 0xcd, 0xb8, 0x69, 0x2b, 0xdc, 0xb4, 0x53,
+	0x6e, 0xda, 0x56, 0xb6, 0x2d, 0x8b, 0x53, 0x45, 0x2d, 0xa8, 0xc7, 0xe4, 0xd0, 0x4d, 0xdc, 0x30,
+	0x30, 0xca, 0x2b, 0xa5, 0x2b, 0x0d, 0x2b, 0x1b, 0x23, 0x03, 0x66, 0x82, 0x70, 0x1d, 0xdb, 0x5d,
+	0x62, 0x54, 0x56, 0x4a, 0x57, 0xea, 0x56, 0x3a, 0x44, 0x2b, 0x30, 0x8b, 0xa3, 0xe8, 0x03, 0xbc,
+	0x47, 0xbc, 0xbb, 0xe4, 0xc8, 0xa8, 0xf2, 0x89, 0x2a, 0x88, 0xcd, 0xc5, 0x51, 0x74, 0x0f, 0xfb,
+	0xc4, 0x98, 0xe6, 0xbf, 0xa6, 0x43, 0x74, 0x01, 0x1a, 0x01, 0xf6, 0x49, 0x12, 0x61, 0x9b, 0x18,
+	0x75, 0xfe, 0x5b, 0x1f, 0x80, 0xbe, 0x0f, 0x0b, 0x0a, 0xe3, 0xbb, 0x61, 0x2f, 0xb6, 0x89, 0x01,
+	0x7c, 0xeb, 0x3b, 0xe3, 0x6d, 0x7d, 0xad, 0x48, 0xd6, 0x1a, 0x5c, 0x09, 0x7d, 0x07, 0xa6, 0xb9,
+	0xe6, 0x8d, 0xd9, 0x95, 0xca, 0x13, 0x95, 0xb6, 0x20, 0x8b, 0x02, 0x98, 0x89, 0xbc, 0x9e, 0xe3,
+	0x06, 0x89, 0x71, 0x8a, 0xaf, 0xf0, 0x60, 0xbc, 0x15, 0xd6, 0xc3, 0x60, 0xdf, 0x75, 0xb6, 0x71,
+	0x80, 0x1d, 0xe2, 0x93, 0x80, 0xde, 0xe7, 0xc4, 0xad, 0x74, 0x11, 0xf4, 0x39, 0x34, 0x0f, 0x7a,
+	0x09, 0x0d, 0x7d, 0xf7, 0x73, 0xb2, 0x13, 0xb1, 0xb9, 0x89, 0x71, 0x9a, 0x4b, 0xf3, 0xde, 0x78,
+	0x0b, 0xdf, 0x2d, 0x50, 0xb5, 0x06, 0xd6, 0x61, 0x46, 0x72, 0xd0, 0xdb, 0x23, 0x1f, 0x93, 0x98,
+	0x5b, 0xd7, 0x9c, 0x30, 0x12, 0x05, 0x24, 0xcc, 0xc8, 0x95, 0xa3, 0xc4, 0x98, 0x5f, 0xa9, 0x08,
+	0x33, 0xca, 0x40, 0xe8, 0x0a, 0xcc, 0x1f, 0x92, 0xd8, 0xdd, 0x3f, 0xda, 0x75, 0x9d, 0x00, 0xd3,
+	0x5e, 0x4c, 0x8c, 0x26, 0x37, 0xc5, 0x22, 0x18, 0xf9, 0x70, 0xba, 0x4b, 0x3c, 0x9f, 0x89, 0x7c,
+	0x3d, 0x26, 0x9d, 0xc4, 0x58, 0xe0, 0xf2, 0xdd, 0x18, 0x5f, 0x83, 0x9c, 0x9c, 0x95, 0xa7, 0xce,
+	0x18, 0x0b, 0x42, 0x4b, 0x9e, 0x14, 0x71, 0x46, 0x90, 0x60, 0xac, 0x00, 0x46, 0x97, 0x61, 0x8e,
+	0xc6, 0xd8, 0x3e, 0x70, 0x03, 0x67, 0x9b, 0xd0, 0x6e, 0xd8, 0x31, 0xce, 0x70, 0x49, 0x14, 0xa0,
+	0xc8, 0x06, 0x44, 0x02, 0xbc, 0xe7, 0x91, 0x8e, 0xb0, 0xc5, 0x07, 0x47, 0x11, 0x49, 0x8c, 0x45,
+	0xbe, 0x8b, 0x1b, 0x6d, 0xc5, 0x43, 0x15, 0x1c, 0x44, 0xfb, 0xf6, 0xc0, 0xac, 0xdb, 0x01, 0x8d,
+	0x8f, 0x2c, 0x0d, 0x39, 0x74, 0x00, 0xb3, 0x6c, 0x1f, 0xa9, 0x29, 0x2c, 0x71, 0x53, 0xd8, 0x1a,
+	0x4f, 0x46, 0x9b, 0x7d, 0x82, 0x96, 0x4a, 0x1d, 0xb5, 0x01, 0x75, 0x71, 0xb2, 0xdd, 0xf3, 0xa8,
+	0x1b, 0x79, 0x44, 0xb0, 0x91, 0x18, 0xcb, 0x5c, 0x4c, 0x9a, 0x5f, 0xd0, 0x5d, 0x80, 0x98, 0xec,
+	0xa7, 0x78, 0x67, 0xf9, 0xce, 0x5f, 0x1e, 0xb5, 0x73, 0x2b, 0xc3, 0x16, 0x3b, 0x56, 0xa6, 0xb3,
+	0xc5, 0xd9, 0x36, 0x88, 0x4d, 0x05, 0x84, 0x9f, 0x45, 0xc3, 0xe0, 0x26, 0xa6, 0xf9, 0x85, 0xd9,
+	0xa2, 0x84, 0x72, 0xa7, 0x75, 0x4e, 0x58, 0xab, 0x02, 0x42, 0x9b, 0xf0, 0x3c, 0x0e, 0x82, 0x90,
+	0xf2, 0xed, 0xa7, 0xac, 0x6c, 0x48, 0xf7, 0x7e, 0x1f, 0xd3, 0x6e, 0x62, 0xb4, 0xf8, 0xac, 0xe3,
+	0xd0, 0x98, 0x49, 0xb8, 0x41, 0x42, 0xb1, 0xe7, 0x71, 0xa4, 0xad, 0xf7, 0x8c, 0xf3, 0xc2, 0x24,
+	0xf2, 0x50, 0xf4, 0x08, 0xe6, 0x13, 0xce, 0xe2, 0x56, 0x40, 0x89, 0x13, 0xbb, 0xf4, 0xc8, 0xb8,
+	0xc0, 0x35, 0xb6, 0x3d, 0x9e, 0xc6, 0x76, 0xf3, 0x44, 0xad, 0xe2, 0x2a, 0xad, 0xdb, 0x70, 0x76,
+	0x88, 0x55, 0xa1, 0x26, 0x54, 0x0e, 0xc8, 0x11, 0x8f, 0x46, 0x0d, 0x8b, 0x7d, 0xa2, 0x45, 0x98,
+	0x3e, 0xc4, 0x5e, 0x8f, 0xf0, 0xf8, 0x51, 0xb7, 0xc4, 0xe0, 0xcd, 0xf2, 0xff, 0x97, 0x5a, 0x3f,
+	0x2e, 0xc1, 0x7c, 0x41, 0x47, 0x9a, 0xf9, 0xdf, 0x56, 0xe7, 0x3f, 0x81, 0x13, 0xbb, 0xff, 0x00,
+	0xc7, 0x0e, 0xa1, 0x0a, 0x23, 0xe6, 0x5f, 0x4a, 0x60, 0x14, 0x8c, 0xe7, 0xeb, 0x2e, 0xed, 0xde,
+	0x71, 0x3d, 0x92, 0xa0, 0xd7, 0x61, 0x26, 0x16, 0x30, 0x19, 0x63, 0xcf, 0x8f, 0xb0, 0xb9, 0xcd,
+	0x29, 0x2b, 0xc5, 0x46, 0xb7, 0xa0, 0xee, 0x13, 0x8a, 0x3b, 0x98, 0x62, 0xc9, 0xfb, 0x8a, 0x6e,
+	0x26, 0x5b, 0x65, 0x5b, 0xe2, 0x6d, 0x4e, 0x59, 0xd9, 0x1c, 0x74, 0x13, 0xa6, 0xed, 0x6e, 0x2f,
+	0x38, 0xe0, 0xd1, 0x75, 0xf6, 0xfa, 0x73, 0xc3, 0x26, 0xaf, 0x33, 0xa4, 0xcd, 0x29, 0x4b, 0x60,
+	0xbf, 0x5b, 0x83, 0x6a, 0x84, 0x63, 0x6a, 0xde, 0x81, 0x45, 0xdd, 0x12, 0x2c, 0xa4, 0xdb, 0x5d,
+	0x62, 0x1f, 0x24, 0x3d, 0x5f, 0x8a, 0x39, 0x1b, 0x23, 0x04, 0xd5, 0xc4, 0xfd, 0x5c, 0x88, 0xba,
+	0x62, 0xf1, 0x6f, 0xf3, 0x25, 0x58, 0x18, 0x58, 0x8d, 0x29, 0x55, 0xf0, 0xc6, 0x28, 0x9c, 0x92,
+	0x4b, 0x9b, 0x3d, 0x58, 0x7a, 0xc0, 0x65, 0x91, 0xc5, 0xb5, 0x49, 0x24, 0x29, 0xe6, 0x26, 0x2c,
+	0x17, 0x97, 0x4d, 0xa2, 0x30, 0x48, 0x08, 0x3b, 0xe5, 0x3c, 0x10, 0xb8, 0xa4, 0xd3, 0xff, 0x95,
+	0x73, 0x51, 0xb7, 0x34, 0xbf, 0x98, 0x7f, 0x2e, 0xc3, 0xb2, 0x45, 0x92, 0xd0, 0x3b, 0x24, 0xa9,
+	0x97, 0x9e, 0x4c, 0x9e, 0xf5, 0x2d, 0xa8, 0xe0, 0x28, 0x32, 0xca, 0x4f, 0xc2, 0xe1, 0x2a, 0x99,
+	0x8c, 0xc5, 0xa8, 0xa2, 0x57, 0x60, 0x01, 0xfb, 0x7b, 0xae, 0xd3, 0x0b, 0x7b, 0x49, 0xba, 0x2d,
+	0x6e, 0x54, 0x0d, 0x6b, 0xf0, 0x07, 0xe6, 0xe9, 0xd2, 0xf3, 0xde, 0x21, 0xdf, 0xe3, 0xc9, 0x5b,
+	0xc5, 0x52, 0x41, 0xba, 0xe0, 0x36, 0xad, 0x0d, 0x6e, 0xa6, 0x0d, 0x67, 0x07, 0xc4, 0x29, 0x55,
+	0xa3, 0x66, 0x96, 0xa5, 0x42, 0x66, 0xa9, 0x65, 0xb8, 0x3c, 0x84, 0x61, 0xf3, 0x9f, 0x65, 0x68,
+	0xf6, 0x8f, 0xa1, 0x24, 0x7f, 0x01, 0x1a, 0xbe, 0x84, 0x25, 0x46, 0x89, 0xbb, 0xf5, 0x3e, 0x20,
+	0x9f, 0x64, 0x96, 0x8b, 0x49, 0xe6, 0x32, 0xd4, 0x44, 0x0d, 0x20, 0x85, 0x24, 0x47, 0x39, 0x96,
+	0xab, 0x05, 0x96, 0x2f, 0x02, 0x24, 0x99, 0x2f, 0x34, 0x6a, 0xfc, 0x57, 0x05, 0x82, 0x4c, 0x38,
+	0x25, 0x52, 0x12, 0x8b, 0x24, 0x3d, 0x8f, 0x1a, 0x33, 0x1c, 0x23, 0x07, 0xe3, 0x27, 0x33, 0xf4,
+	0x7d, 0x1c, 0x74, 0x12, 0xa3, 0xce, 0x59, 0xce, 0xc6, 0xe8, 0x67, 0x25, 0x58, 0x2a, 0xb8, 0x61,
+	0x49, 0xa9, 0xc1, 0x6d, 0xe6, 0x1b, 0x4f, 0xd4, 0xe5, 0xaf, 0x33, 0x87, 0x20, 0xe8, 0x5b, 0xfa,
+	0x65, 0xcd, 0x10, 0xe6, 0x3f, 0x70, 0x99, 0xc0, 0xf7, 0x93, 0xc9, 0x9c, 0xf2, 0xd7, 0xa0, 0xca,
+	0x16, 0x63, 0x52, 0xda, 0x8b, 0x71, 0x60, 0x77, 0x49, 0xaa, 0xd8, 0x6c, 0xcc, 0xfc, 0x17, 0xc5,
+	0x4e, 0x62, 0x94, 0x39, 0x9c, 0x7f, 0x9b, 0x37, 0x61, 0x7e, 0x67, 0x7d, 0x2b, 0x23, 0xe7, 0x92,
+	0x84, 0x29, 0x23, 0x56, 0xc6, 0x92, 0x4c, 0x0e, 0x66, 0xfe, 0xa4, 0x04, 0x67, 0x76, 0xd6, 0xb7,
+	0xd6, 0x62, 0xea, 0xee, 0x63, 0x9b, 0x4e, 0x66, 0x93, 0xda, 0x0d, 0xfc, 0xa1, 0x04, 0xb3, 0x0a,
+	0x27, 0x2c, 0x44, 0x52, 0xec, 0xa4, 0x21, 0x92, 0x62, 0x87, 0x19, 0x6c, 0xc7, 0x75, 0x58, 0x84,
+	0x12, 0xb6, 0x2c, 0x47, 0xe8, 0x7d, 0x98, 0xed, 0xe7, 0x1a, 0x89, 0x51, 0xe1, 0x29, 0xd3, 0x15,
+	0x35, 0x8e, 0x28, 0x74, 0xdb, 0x6b, 0x7d, 0x54, 0x91, 0x2f, 0xa9, 0x93, 0x5b, 0xb7, 0xa0, 0x59,
+	0x44, 0x38, 0x2e, 0xd8, 0x37, 0xd4, 0x18, 0xbb, 0x0d, 0x8b, 0x79, 0x71, 0xca, 0x83, 0x7a, 0x13,
+	0x1a, 0x38, 0x05, 0x72, 0x45, 0xcc, 0x5e, 0x3f, 0x3b, 0x84, 0x43, 0xab, 0x8f, 0x69, 0xfe, 0xb6,
+	0x2c, 0xec, 0x6f, 0x2d, 0x8a, 0x92, 0x67, 0x5f, 0x0a, 0xeb, 0x93, 0xf3, 0xca, 0x60, 0x72, 0x5e,
+	0x60, 0xf9, 0x71, 0x92, 0xf3, 0x27, 0x94, 0x75, 0x99, 0x3d, 0x98, 0x59, 0x8b, 0x22, 0xc6, 0x08,
+	0xba, 0x06, 0x55, 0x1c, 0x45, 0xa9, 0xd8, 0x73, 0x09, 0x86, 0x44, 0x61, 0xff, 0x4b, 0x96, 0x38,
+	0x6a, 0xeb, 0x75, 0x68, 0x64, 0xa0, 0xc7, 0xd2, 0xff, 0x0a, 0x80, 0xa8, 0x3e, 0xb7, 0x82, 0x7d,
+	0x6e, 0xe7, 0xcc, 0xdf, 0xca, 0xa9, 0xfc, 0xdb, 0x7c, 0x33, 0xc5, 0xe0, 0xbc, 0xbd, 0x02, 0xd3,
+	0x2e, 0x25, 0x7e, 0xca, 0xdc, 0xb2, 0xca, 0x5c, 0x9f, 0x90, 0x25, 0x90, 0xcc, 0x3f, 0xd6, 0xe1,
+	0x1c, 0xd3, 0xd8, 0x2e, 0xf7, 0xd4, 0x6b, 0x51, 0xf4, 0x1e, 0xa1, 0xd8, 0xf5, 0x92, 0x0f, 0x7b,
+	0x24, 0x3e, 0x7a, 0xca, 0x86, 0xe1, 0x40, 0x4d, 0xb8, 0x48, 0xa3, 0xfc, 0x74, 0x1a, 0x11, 0xb5,
+	0xa4, 0xd0, 0x7d, 0xa8, 0x3c, 0x9d, 0xee, 0x83, 0xae, 0x1b, 0x50, 0x9d, 0x50, 0x37, 0x60, 0x78,
+	0x43, 0x48, 0x69, 0x33, 0xd5, 0xf2, 0x6d, 0x26, 0x4d, 0x1e, 0x32, 0x73, 0xd2, 0x22, 0xbb, 0xae,
+	0x2d, 0xb2, 0x7d, 0xed, 0x39, 0x6e, 0x70, 0x71, 0x7f, 0x4d, 0xb5, 0xc0, 0xa1, 0xb6, 0x36, 0x4e,
+	0xb9, 0x0d, 0x4f, 0xb5, 0xdc, 0xfe, 0x28, 0x57, 0x3e, 0x8b, 0x06, 0xd6, 0xcd, 0x93, 0xed, 0x69,
+	0x44, 0x21, 0xfd, 0x3f, 0x57, 0x0b, 0xfe, 0x88, 0x97, 0x00, 0x51, 0xd8, 0x97, 0x41, 0x16, 0xaa,
+	0x58, 0x70, 0x66, 0xd9, 0x9d, 0x74, 0x5a, 0xec, 0x1b, 0xbd, 0x0c, 0x55, 0x26, 0x64, 0x59, 0xa3,
+	0xe5, 0x22, 0x17, 0xd3, 0xc4, 0x5a, 0x14, 0xed, 0x46, 0xc4, 0xb6, 0x38, 0x12, 0x7a, 0x13, 0x1a,
+	0x99, 0xe1, 0xcb, 0x93, 0x75, 0x41, 0x9d, 0x91, 0x9d, 0x93, 0x74, 0x5a, 0x1f, 0x9d, 0xcd, 0xed,
+	0xb8, 0x31, 0xb1, 0x19, 0xa2, 0x31, 0x3d, 0x38, 0xf7, 0xbd, 0xf4, 0xc7, 0x6c, 0x6e, 0x86, 0x8e,
+	0xae, 0x41, 0x4d, 0x74, 0xfc, 0xf8, 0x09, 0x9a, 0xbd, 0x7e, 0x6e, 0xd0, 0x99, 0xa6, 0xb3, 0x24,
+	0xa2, 0xf9, 0xbb, 0x32, 0xbc, 0xd0, 0x37, 0x88, 0xf4, 0x34, 0xa5, 0x45, 0xe4, 0xb3, 0x8f, 0xb8,
+	0x97, 0x61, 0x8e, 0x57, 0xad, 0xfd, 0xc6, 0x9f, 0xe8, 0x41, 0x17, 0xa0, 0xba, 0x1e, 0x49, 0x75,
+	0x12, 0x3d, 0x12, 0xf3, 0x37, 0x25, 0xb8, 0x34, 0x28, 0xc0, 0xf5, 0x2e, 0x8e, 0x69, 0x66, 0x57,
+	0x13, 0xca, 0x28, 0x79, 0xa4, 0x2d, 0xf7, 0x23, 0x6d, 0x4e, 0xb0, 0x95, 0xbc, 0x60, 0xcd, 0xdf,
+	0x97, 0x61, 0x56, 0xb1, 0x5c, 0x5d, 0xa4, 0x66, 0xc5, 0x0e, 0x3f, 0x30, 0xbc, 0x41, 0xc2, 0xa3,
+	0x51, 0xc3, 0x52, 0x20, 0xe8, 0x00, 0x20, 0xc2, 0x31, 0xf6, 0x09, 0x25, 0x31, 0x0b, 0x21, 0xcc,
+	0xd5, 0xdc, 0x1d, 0xdf, 0xad, 0xdd, 0x4f, 0x69, 0x5a, 0x0a, 0x79, 0x96, 0xfc, 0xf2, 0xa5, 0x13,
+	0x19, 0x38, 0xe4, 0x08, 0x3d, 0x82, 0xb9, 0x7d, 0xd7, 0x23, 0xf7, 0xfb, 0x8c, 0xd4, 0x56, 0x2a,
+	0xe3, 0x87, 0x67, 0xc6, 0xc8, 0x1d, 0x95, 0xae, 0x55, 0x58, 0xc6, 0xbc, 0x0a, 0xcd, 0xe2, 0x41,
+	0x66, 0x4c, 0xba, 0x3e, 0x76, 0x32, 0x69, 0xc9, 0x91, 0x89, 0xa0, 0x59, 0x3c, 0xb8, 0xe6, 0xdf,
+	0xca, 0xb0, 0x94, 0x91, 0x63, 0x39, 0x77, 0x2f, 0xb0, 0x79, 0xf7, 0x5e, 0xab, 0x8b, 0x45, 0x98,
+	0xa6, 0x2e, 0xf5, 0xb2, 0x8c, 0x8b, 0x0f, 0x58, 0xd0, 0xa4, 0x61, 0xe8, 0x51, 0x37, 0x92, 0x0a,
+	0x4e, 0x87, 0x42, 0xf7, 0x0f, 0x7b, 0x6e, 0x4c, 0x3a, 0xfc, 0x24, 0xd4, 0xad, 0x6c, 0xcc, 0x7e,
+	0x63, 0xe9, 0x14, 0x2f, 0x61, 0x85, 0x30, 0xb3, 0x31, 0x3f, 0x70, 0xa1, 0xe7, 0x11, 0x9b, 0x89,
+	0x43, 0x29, 0x72, 0x0b, 0x50, 0xb6, 0xd3, 0x84, 0xc6, 0x6e, 0xe0, 0xc8, 0x12, 0x57, 0x8e, 0x18,
+	0x9f, 0x38, 0x8e, 0xf1, 0x91, 0xac, 0x6c, 0xc5, 0x00, 0xbd, 0x0d, 0x15, 0x1f, 0x47, 0x32, 0xc2,
+	0x5e, 0xcd, 0xb9, 0x25, 0x9d, 0x04, 0xda, 0xdb, 0x38, 0x12, 0x21, 0x88, 0x4d, 0x6b, 0xbd, 0x06,
+	0xf5, 0x14, 0xf0, 0x58, 0xb9, 0xe8, 0x67, 0x70, 0x3a, 0xe7, 0xf5, 0xd0, 0x27, 0xb0, 0xdc, 0xb7,
+	0x28, 0x75, 0x41, 0x99, 0x7d, 0xbe, 0x70, 0x2c, 0x67, 0xd6, 0x10, 0x02, 0xe6, 0x43, 0x58, 0x60,
+	0x26, 0xc3, 0x0f, 0xfe, 0x84, 0x2a, 0xe5, 0xb7, 0xa0, 0x91, 0x2d, 0xa9, 0xb5, 0x99, 0x16, 0xd4,
+	0x0f, 0xd3, 0x5b, 0x15, 0x51, 0x69, 0x66, 0x63, 0x73, 0x0d, 0x90, 0xca, 0xaf, 0x0c, 0x7d, 0x2f,
+	0xe7, 0xb3, 0xf1, 0xa5, 0x62, 0x9c, 0xe3, 0xe8, 0x69, 0x32, 0xfe, 0xeb, 0x12, 0x18, 0x19, 0x30,
+	0xbd, 0xab, 0x99, 0x8c, 0xb7, 0x5b, 0x86, 0x9a, 0xcd, 0x39, 0x97, 0xfb, 0x92, 0xa3, 0xe1, 0x77,
+	0x95, 0xe6, 0x2f, 0xcb, 0xd0, 0x2c, 0x32, 0xab, 0x15, 0x9a, 0x01, 0x33, 0x52, 0x48, 0xd2, 0xa0,
+	0xd2, 0x21, 0x73, 0x87, 0x38, 0x8a, 0xe4, 0x5c, 0x79, 0xde, 0x14, 0x08, 0xeb, 0xa8, 0x75, 0x48,
+	0x62, 0xc7, 0x2e, 0xcf, 0xc4, 0xd2, 0xeb, 0x50, 0x05, 0xc4, 0x68, 0xdb, 0x31, 0x61, 0xd7, 0xc0,
+	0x69, 0xf6, 0x2b, 0x87, 0x4a, 0x69, 0x5f, 0xcb, 0x95, 0xf6, 0x08, 0xaa, 0xdd, 0xd0, 0x27, 0xf2,
+	0x90, 0xf1, 0x6f, 0x06, 0x73, 0xed, 0x30, 0x90, 0xb9, 0x2d, 0xff, 0x66, 0xaa, 0x3e, 0x20, 0x47,
+	0x8f, 0xc2, 0xb8, 0x23, 0xf2, 0xd8, 0x86, 0x95, 0x8d, 0x19, 0xdf, 0x1d, 0x12, 0xc5, 0xc4, 0xe6,
+	0x0b, 0x03, 0x97, 0x8b, 0x02, 0x31, 0x77, 0xe0, 0x9c, 0x46, 0x8d, 0xd2, 0x22, 0xae, 0xe7, 0x2d,
+	0xe2, 0x82, 0xd6, 0x22, 0xe4, 0xac, 0xd4, 0x30, 0x7e, 0x50, 0x82, 0xb9, 0x0d, 0x97, 0x3e, 0xc0,
+	0xce, 0x33, 0x6c, 0xa7, 0x50, 0xa8, 0x09, 0x1e, 0xb4, 0x5a, 0x6e, 0x42, 0x25, 0xe9, 0x62, 0xa9,
+	0x61, 0xf6, 0xc9, 0x34, 0x80, 0x7b, 0xb4, 0x1b, 0x66, 0xdd, 0x40, 0x31, 0x62, 0xb3, 0x3b, 0x98,
+	0x12, 0xa9, 0x4e, 0xfe, 0xcd, 0xf4, 0xe8, 0x93, 0x24, 0xc1, 0x4e, 0x56, 0xc5, 0xc8, 0xa1, 0xf9,
+	0x06, 0xcc, 0x67, 0x3b, 0x97, 0x12, 0xbc, 0x2c, 0x99, 0x13, 0x02, 0x44, 0xaa, 0x00, 0x05, 0xaa,
+	0x64, 0xf8, 0x8b, 0x0a, 0x9f, 0xcb, 0x43, 0xeb, 0x64, 0xc4, 0x76, 0x15, 0x9a, 0x49, 0x6f, 0xcf,
+	0x0f, 0x3b, 0x3d, 0x8f, 0xc8, 0xe4, 0x5e, 0x66, 0xec, 0x03, 0xf0, 0x51, 0xb9, 0x04, 0x13, 0x51,
+	0x84, 0x69, 0x37, 0x15, 0x11, 0xfb, 0x46, 0x6f, 0xc3, 0xb9, 0x7b, 0xe4, 0x91, 0xdc, 0xcf, 0x86,
+	0x17, 0xee, 0xed, 0xb9, 0x81, 0x93, 0x2e, 0x22, 0xda, 0xc8, 0xc3, 0x11, 0x74, 0x25, 0x5f, 0x4d,
+	0x5f, 0xf2, 0x65, 0x0d, 0xd7, 0xf5, 0xd0, 0xf7, 0x5d, 0x2a, 0x2b, 0xc3, 0x1c, 0x4c, 0x97, 0x1c,
+	0xd6, 0x27, 0x92, 0x1c, 0xfe, 0xb0, 0x04, 0xcd, 0xbe, 0x4a, 0xa5, 0x3d, 0xbc, 0x2e, 0x62, 0xa1,
+	0x30, 0x87, 0x4b, 0x05, 0x73, 0xc8, 0xa1, 0x7e, 0xf5, 0x30, 0x78, 0x2a, 0x57, 0xea, 0x54, 0x60,
+	0x69, 0xc3, 0xa5, 0x69, 0x02, 0xe2, 0xfe, 0xb7, 0x99, 0x97, 0xc6, 0x18, 0xaa, 0x27, 0x33, 0x86,
+	0xe9, 0x93, 0x19, 0x43, 0x6d, 0x22, 0xc6, 0xd0, 0x86, 0xe5, 0xa2, 0x16, 0xa4, 0x45, 0x2c, 0xc2,
+	0x74, 0xc4, 0x2f, 0x8e, 0x45, 0x83, 0x5a, 0x0c, 0xcc, 0x7f, 0x35, 0xe0, 0xb9, 0x8f, 0x22, 0xe6,
+	0x6f, 0xd2, 0x4d, 0xde, 0x09, 0x63, 0x7e, 0x73, 0x3c, 0x19, 0xf5, 0x15, 0x5e, 0xf7, 0x94, 0x47,
+	0xbe, 0xee, 0xa9, 0x8c, 0x78, 0xdd, 0x53, 0x3d, 0xd1, 0xeb, 0x9e, 0xe9, 0x89, 0xbd, 0xee, 0x19,
+	0xec, 0x12, 0xd5, 0xb4, 0x5d, 0xa2, 0x4f, 0x72, 0x9d, 0x94, 0x19, 0x7e, 0x5e, 0xdf, 0x50, 0xcf,
+	0xeb, 0x48, 0xed, 0x8c, 0x7c, 0x96, 0x50, 0x78, 0x14, 0x53, 0x3f, 0xf6, 0x51, 0x4c, 0x63, 0xf0,
+	0x51, 0x8c, 0xfe, 0x5d, 0x05, 0x0c, 0x7d, 0x57, 0x71, 0x19, 0xe6, 0x92, 0xa3, 0xc0, 0x26, 0x9d,
+	0x94, 0x61, 0x63, 0x56, 0x6c, 0x3b, 0x0f, 0xcd, 0x1d, 0xc5, 0x53, 0x85, 0xa3, 0x98, 0x59, 0xea,
+	0x69, 0xc5, 0x52, 0x75, 0x07, 0x74, 0x6e, 0x68, 0x83, 0xae, 0xf0, 0xe4, 0x61, 0x5e, 0xfb, 0xe4,
+	0xe1, 0x00, 0x9a, 0x29, 0x57, 0x99, 0x02, 0x9a, 0x5c, 0x01, 0xef, 0x9c, 0x5c, 0x01, 0xbb, 0x05,
+	0x0a, 0x42, 0x0d, 0x03, 0x84, 0x75, 0x1e, 0x61, 0x61, 0x22, 0xef, 0x2b, 0xfe, 0x53, 0x9a, 0x61,
+	0xad, 0x9f, 0x96, 0x60, 0x49, 0x2b, 0xad, 0x67, 0xd3, 0x9b, 0xfb, 0x18, 0x2e, 0x0e, 0xd3, 0xac,
+	0xf4, 0x98, 0x2c, 0x91, 0xee, 0xe2, 0xc0, 0xe1, 0x97, 0x7a, 0x3c, 0xcf, 0x97, 0xc3, 0x51, 0xcd,
+	0xa4, 0xeb, 0xff, 0x98, 0x83, 0x85, 0x7e, 0xaf, 0x86, 0xfd, 0xeb, 0xda, 0x04, 0xed, 0x40, 0x33,
+	0x7d, 0x97, 0x93, 0x5e, 0x2f, 0xa3, 0x51, 0x6f, 0x3f, 0x5a, 0x17, 0xf4, 0x3f, 0x0a, 0xd6, 0xcc,
+	0x29, 0x64, 0xc3, 0xb9, 0x22, 0xc1, 0xfe, 0x33, 0x93, 0x17, 0x47, 0x50, 0xce, 0xb0, 0x8e, 0x5b,
+	0xe2, 0x4a, 0x09, 0x7d, 0x02, 0x73, 0xf9, 0xc7, 0x10, 0x28, 0x57, 0xbc, 0x6a, 0xdf, 0x67, 0xb4,
+	0xcc, 0x51, 0x28, 0x19, 0xff, 0x9f, 0xc2, 0x7c, 0xe1, 0x36, 0x1f, 0x99, 0xf9, 0x06, 0xb2, 0xee,
+	0xe5, 0x44, 0xeb, 0xff, 0x46, 0xe2, 0x64, 0xd4, 0xdf, 0x82, 0x7a, 0x7a, 0xa1, 0x9c, 0x17, 0x73,
+	0xe1, 0x9a, 0xb9, 0xd5, 0xcc, 0xd3, 0xdb, 0x4f, 0xcc, 0x29, 0x74, 0x0b, 0x66, 0x19, 0xda, 0xce,
+	0xfa, 0x16, 0x4b, 0xb1, 0x1f, 0x7f, 0xfe, 0x87, 0x70, 0x46, 0xce, 0xcf, 0x5d, 0x14, 0x8f, 0xa4,
+	0x73, 0xbe, 0x70, 0x4d, 0xa9, 0xce, 0x34, 0xa7, 0xd0, 0xc7, 0x30, 0xbf, 0x41, 0xa8, 0x7a, 0xe7,
+	0x89, 0x9e, 0x1f, 0x72, 0xb1, 0x99, 0x91, 0x5c, 0x19, 0x8e, 0x90, 0xc9, 0xe9, 0x96, 0x90, 0x13,
+	0xbb, 0x85, 0x1b, 0xe4, 0x4f, 0xb9, 0x5b, 0x6c, 0x9d, 0xd1, 0xdc, 0xe7, 0x99, 0x53, 0xe8, 0x1d,
+	0x21, 0xaa, 0xfb, 0xf2, 0x09, 0xe8, 0x72, 0x5b, 0xbc, 0x38, 0x6e, 0xa7, 0x2f, 0x8e, 0xdb, 0xb7,
+	0xd9, 0x8b, 0xe3, 0x96, 0xe6, 0xc2, 0x4d, 0x12, 0xf8, 0x14, 0x4e, 0x6f, 0x10, 0xda, 0xef, 0x8f,
+	0xa3, 0x4b, 0x27, 0xba, 0x45, 0x68, 0x99, 0x45, 0xb4, 0xc1, 0x16, 0xbb, 0x39, 0x85, 0x7e, 0x51,
+	0x82, 0x33, 0x1b, 0x84, 0x16, 0x3b, 0xce, 0xe8, 0x55, 0xfd, 0x22, 0x43, 0x3a, 0xd3, 0xad, 0x7b,
+	0xe3, 0xba, 0x9f, 0x3c, 0x59, 0x73, 0x0a, 0xfd, 0x9c, 0x15, 0xaf, 0x5c, 0xa1, 0x19, 0x4f, 0xd7,
+	0x46, 0xf3, 0xa4, 0x69, 0xf6, 0xb6, 0xc6, 0xbc, 0xdd, 0x51, 0x56, 0x37, 0xa7, 0xd0, 0xaf, 0x4a,
+	0x70, 0x56, 0x91, 0x95, 0xba, 0xde, 0x57, 0xe1, 0xed, 0xfd, 0x31, 0x1f, 0x1b, 0x2b, 0x24, 0xcd,
+	0x29, 0x74, 0x9f, 0x9b, 0x49, 0xbf, 0x97, 0x84, 0x9e, 0xd3, 0xb6, 0x08, 0xb2, 0xd5, 0x2f, 0x0e,
+	0xfb, 0x39, 0x33, 0x0d, 0x02, 0x8b, 0x2a, 0xc5, 0x2c, 0xe1, 0x79, 0x71, 0x54, 0xef, 0x21, 0xa3,
+	0x7f, 0xe9, 0x18, 0xac, 0x6c, 0x99, 0x0d, 0x80, 0x0d, 0x42, 0x65, 0xb5, 0x8e, 0x5a, 0x83, 0x75,
+	0xb9, 0xde, 0x03, 0x14, 0xca, 0x7b, 0x73, 0x8a, 0x3d, 0xbf, 0x10, 0x84, 0x84, 0x87, 0x3f, 0xaf,
+	0x2f, 0xe9, 0x34, 0xb1, 0xa3, 0x58, 0xef, 0xf1, 0x43, 0xb7, 0x20, 0x68, 0x29, 0x75, 0x42, 0xde,
+	0xb3, 0x6b, 0x2b, 0xb9, 0x96, 0x39, 0x0a, 0x25, 0xa3, 0xfe, 0x10, 0x96, 0xf5, 0x81, 0x15, 0xbd,
+	0x74, 0xe2, 0xb4, 0xaa, 0x75, 0xf5, 0x24, 0xa8, 0xe9, 0x92, 0xef, 0xae, 0xfd, 0xe9, 0xcb, 0x8b,
+	0xa5, 0x2f, 0xbe, 0xbc, 0x58, 0xfa, 0xfb, 0x97, 0x17, 0x4b, 0xdf, 0xbc, 0x71, 0xcc, 0x1f, 0x51,
+	0x28, 0x7f, 0x97, 0x81, 0x23, 0xd7, 0xf6, 0x5c, 0x12, 0xd0, 0xbd, 0x1a, 0x77, 0x59, 0x37, 0xfe,
+	0x3d, 0x00, 0xff, 0x19, 0x55, 0x9d, 0xb6, 0x31, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
 var _ grpc.ClientConn
 
 // This is a compile-time assertion to ensure that this generated file
@@ -2734,6 +3399,10 @@ type RepoServerServiceClient interface {
 	ListRefs(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (*Refs, error)
 	// Returns a list of oci tags in the repo
 	ListOCITags(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (*Refs, error)
+	// Returns a list of the repositories of an oci registry namespace
+	ListOCIRepositories(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (*OCIRepositories, error)
+	// Returns the digest and the annotations of tags of an oci repository
+	GetOCIArtifacts(ctx context.Context, in *OCIArtifactsRequest, opts ...grpc.CallOption) (*OCIArtifactsResponse, error)
 	// ListApps returns a list of apps in the repo
 	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error)
 	// ListPlugins returns a list of cmp v2 plugins running as sidecar to reposerver
@@ -2748,6 +3417,10 @@ type RepoServerServiceClient interface {
 	GetRevisionChartDetails(ctx context.Context, in *RepoServerRevisionChartDetailsRequest, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(ctx context.Context, in *HelmChartsRequest, opts ...grpc.CallOption) (*HelmChartsResponse, error)
+	// GetHelmChartVersions returns the versions of charts of a Helm repository with their metadata
+	GetHelmChartVersions(ctx context.Context, in *HelmChartVersionsRequest, opts ...grpc.CallOption) (*HelmChartVersionsResponse, error)
+	// GetGitTags returns the commit SHA and the commit metadata of the given tags of a Git repository
+	GetGitTags(ctx context.Context, in *GitTagsRequest, opts ...grpc.CallOption) (*GitTagsResponse, error)
 	// GetGitFiles returns a set of file paths and their contents for the given repo
 	GetGitFiles(ctx context.Context, in *GitFilesRequest, opts ...grpc.CallOption) (*GitFilesResponse, error)
 	// GetGitDirectories returns a set of directory paths for the given repo
@@ -2843,6 +3516,24 @@ func (c *repoServerServiceClient) ListOCITags(ctx context.Context, in *ListRefsR
 	return out, nil
 }
 
+func (c *repoServerServiceClient) ListOCIRepositories(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (*OCIRepositories, error) {
+	out := new(OCIRepositories)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ListOCIRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetOCIArtifacts(ctx context.Context, in *OCIArtifactsRequest, opts ...grpc.CallOption) (*OCIArtifactsResponse, error) {
+	out := new(OCIArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetOCIArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repoServerServiceClient) ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error) {
 	out := new(AppList)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ListApps", in, out, opts...)
@@ -2906,7 +3597,25 @@ func (c *repoServerServiceClient) GetHelmCharts(ctx context.Context, in *HelmCha
 	return out, nil
 }
 
-func (c *repoServerServiceClient) GetGitFiles(ctx context.Context, in *GitFilesRequest, opts ...grpc.CallOption) (*GitFilesResponse, error) {
+func (c *repoServerServiceClient) GetHelmChartVersions(ctx context.Context, in *HelmChartVersionsRequest, opts ...grpc.CallOption) (*HelmChartVersionsResponse, error) {
+	out := new(HelmChartVersionsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetHelmChartVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetGitTags(ctx context.Context, in *GitTagsRequest, opts ...grpc.CallOption) (*GitTagsResponse, error) {
+	out := new(GitTagsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetGitTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServerServiceClient) GetGitFiles(ctx context.Context, in *GitFilesRequest, opts ...grpc.CallOption) (*GitFilesResponse, error) {
 	out := new(GitFilesResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetGitFiles", in, out, opts...)
 	if err != nil {
@@ -2947,6 +3656,10 @@ type RepoServerServiceServer interface {
 	ListRefs(context.Context, *ListRefsRequest) (*Refs, error)
 	// Returns a list of oci tags in the repo
 	ListOCITags(context.Context, *ListRefsRequest) (*Refs, error)
+	// Returns a list of the repositories of an oci registry namespace
+	ListOCIRepositories(context.Context, *ListRefsRequest) (*OCIRepositories, error)
+	// Returns the digest and the annotations of tags of an oci repository
+	GetOCIArtifacts(context.Context, *OCIArtifactsRequest) (*OCIArtifactsResponse, error)
 	// ListApps returns a list of apps in the repo
 	ListApps(context.Context, *ListAppsRequest) (*AppList, error)
 	// ListPlugins returns a list of cmp v2 plugins running as sidecar to reposerver
@@ -2961,6 +3674,10 @@ type RepoServerServiceServer interface {
 	GetRevisionChartDetails(context.Context, *RepoServerRevisionChartDetailsRequest) (*v1alpha1.ChartDetails, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(context.Context, *HelmChartsRequest) (*HelmChartsResponse, error)
+	// GetHelmChartVersions returns the versions of charts of a Helm repository with their metadata
+	GetHelmChartVersions(context.Context, *HelmChartVersionsRequest) (*HelmChartVersionsResponse, error)
+	// GetGitTags returns the commit SHA and the commit metadata of the given tags of a Git repository
+	GetGitTags(context.Context, *GitTagsRequest) (*GitTagsResponse, error)
 	// GetGitFiles returns a set of file paths and their contents for the given repo
 	GetGitFiles(context.Context, *GitFilesRequest) (*GitFilesResponse, error)
 	// GetGitDirectories returns a set of directory paths for the given repo
@@ -2991,6 +3708,12 @@ func (*UnimplementedRepoServerServiceServer) ListRefs(ctx context.Context, req *
 func (*UnimplementedRepoServerServiceServer) ListOCITags(ctx context.Context, req *ListRefsRequest) (*Refs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOCITags not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) ListOCIRepositories(ctx context.Context, req *ListRefsRequest) (*OCIRepositories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOCIRepositories not implemented")
+}
+func (*UnimplementedRepoServerServiceServer) GetOCIArtifacts(ctx context.Context, req *OCIArtifactsRequest) (*OCIArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOCIArtifacts not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) ListApps(ctx context.Context, req *ListAppsRequest) (*AppList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApps not implemented")
 }
@@ -3012,6 +3735,12 @@ func (*UnimplementedRepoServerServiceServer) GetRevisionChartDetails(ctx context
 func (*UnimplementedRepoServerServiceServer) GetHelmCharts(ctx context.Context, req *HelmChartsRequest) (*HelmChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHelmCharts not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) GetHelmChartVersions(ctx context.Context, req *HelmChartVersionsRequest) (*HelmChartVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHelmChartVersions not implemented")
+}
+func (*UnimplementedRepoServerServiceServer) GetGitTags(ctx context.Context, req *GitTagsRequest) (*GitTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGitTags not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) GetGitFiles(ctx context.Context, req *GitFilesRequest) (*GitFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGitFiles not implemented")
 }
@@ -3142,6 +3871,42 @@ func _RepoServerService_ListOCITags_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_ListOCIRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).ListOCIRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/ListOCIRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).ListOCIRepositories(ctx, req.(*ListRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_GetOCIArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OCIArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetOCIArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetOCIArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetOCIArtifacts(ctx, req.(*OCIArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepoServerService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAppsRequest)
 	if err := dec(in); err != nil {
@@ -3268,6 +4033,42 @@ func _RepoServerService_GetHelmCharts_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_GetHelmChartVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelmChartVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetHelmChartVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetHelmChartVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetHelmChartVersions(ctx, req.(*HelmChartVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_GetGitTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GitTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetGitTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetGitTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetGitTags(ctx, req.(*GitTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepoServerService_GetGitFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GitFilesRequest)
 	if err := dec(in); err != nil {
@@ -3346,6 +4147,14 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListOCITags",
 			Handler:    _RepoServerService_ListOCITags_Handler,
 		},
+		{
+			MethodName: "ListOCIRepositories",
+			Handler:    _RepoServerService_ListOCIRepositories_Handler,
+		},
+		{
+			MethodName: "GetOCIArtifacts",
+			Handler:    _RepoServerService_GetOCIArtifacts_Handler,
+		},
 		{
 			MethodName: "ListApps",
 			Handler:    _RepoServerService_ListApps_Handler,
@@ -3374,6 +4183,14 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHelmCharts",
 			Handler:    _RepoServerService_GetHelmCharts_Handler,
 		},
+		{
+			MethodName: "GetHelmChartVersions",
+			Handler:    _RepoServerService_GetHelmChartVersions_Handler,
+		},
+		{
+			MethodName: "GetGitTags",
+			Handler:    _RepoServerService_GetGitTags_Handler,
+		},
 		{
 			MethodName: "GetGitFiles",
 			Handler:    _RepoServerService_GetGitFiles_Handler,
@@ -4259,6 +5076,191 @@ func (m *Refs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCIRepositories) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIRepositories) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIRepositories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Repositories) > 0 {
+		for iNdEx := len(m.Repositories) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repositories[iNdEx])
+			copy(dAtA[i:], m.Repositories[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Repositories[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIArtifactsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIArtifactsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIArtifactsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIArtifactsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIArtifactsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIArtifactsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5234,7 +6236,7 @@ func (m *HelmChartsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GitFilesRequest) Marshal() (dAtA []byte, err error) {
+func (m *HelmChartVersionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5244,12 +6246,12 @@ func (m *GitFilesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitFilesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *HelmChartVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HelmChartVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5258,9 +6260,28 @@ func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SourceIntegrity != nil {
+	if m.NoCache {
+		i--
+		if m.NoCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Charts) > 0 {
+		for iNdEx := len(m.Charts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Charts[iNdEx])
+			copy(dAtA[i:], m.Charts[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Charts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Repo != nil {
 		{
-			size, err := m.SourceIntegrity.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5268,61 +6289,186 @@ func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRepository(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0xa
 	}
-	if m.VerifyCommit {
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmChartVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmChartVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmChartVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Deprecated {
 		i--
-		if m.VerifyCommit {
+		if m.Deprecated {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x50
 	}
-	if m.NoRevisionCache {
-		i--
-		if m.NoRevisionCache {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Keywords) > 0 {
+		for iNdEx := len(m.Keywords) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keywords[iNdEx])
+			copy(dAtA[i:], m.Keywords[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Keywords[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
 		}
+	}
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Icon)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x42
 	}
-	if m.NewGitFileGlobbingEnabled {
+	if len(m.Home) > 0 {
+		i -= len(m.Home)
+		copy(dAtA[i:], m.Home)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Home)))
 		i--
-		if m.NewGitFileGlobbingEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x3a
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Digest)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x32
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+	if len(m.Created) > 0 {
+		i -= len(m.Created)
+		copy(dAtA[i:], m.Created)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Created)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Revision) > 0 {
-		i -= len(m.Revision)
-		copy(dAtA[i:], m.Revision)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppVersion)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.SubmoduleEnabled {
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Version)))
 		i--
-		if m.SubmoduleEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmChartVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmChartVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmChartVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GitTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if m.Repo != nil {
 		{
@@ -5339,7 +6485,7 @@ func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GitFilesResponse) Marshal() (dAtA []byte, err error) {
+func (m *GitTag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5349,12 +6495,12 @@ func (m *GitFilesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitFilesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitTag) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitFilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5363,23 +6509,78 @@ func (m *GitFilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Map) > 0 {
-		for k := range m.Map {
-			v := m.Map[k]
-			baseI := i
-			if len(v) > 0 {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintRepository(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sha) > 0 {
+		i -= len(m.Sha)
+		copy(dAtA[i:], m.Sha)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Sha)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GitTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitTagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5387,7 +6588,7 @@ func (m *GitFilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GitDirectoriesRequest) Marshal() (dAtA []byte, err error) {
+func (m *GitFilesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5397,12 +6598,12 @@ func (m *GitDirectoriesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitDirectoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitFilesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitFilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5421,7 +6622,7 @@ func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRepository(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x42
 	}
 	if m.VerifyCommit {
 		i--
@@ -5431,7 +6632,7 @@ func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x38
 	}
 	if m.NoRevisionCache {
 		i--
@@ -5441,7 +6642,24 @@ func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
+	}
+	if m.NewGitFileGlobbingEnabled {
+		i--
+		if m.NewGitFileGlobbingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Revision) > 0 {
 		i -= len(m.Revision)
@@ -5475,7 +6693,7 @@ func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GitDirectoriesResponse) Marshal() (dAtA []byte, err error) {
+func (m *GitFilesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5485,12 +6703,12 @@ func (m *GitDirectoriesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitDirectoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitFilesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitDirectoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitFilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5499,13 +6717,149 @@ func (m *GitDirectoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Paths) > 0 {
-		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Paths[iNdEx])
-			copy(dAtA[i:], m.Paths[iNdEx])
-			i = encodeVarintRepository(dAtA, i, uint64(len(m.Paths[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if len(m.Map) > 0 {
+		for k := range m.Map {
+			v := m.Map[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GitDirectoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitDirectoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitDirectoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SourceIntegrity != nil {
+		{
+			size, err := m.SourceIntegrity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VerifyCommit {
+		i--
+		if m.VerifyCommit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NoRevisionCache {
+		i--
+		if m.NoRevisionCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SubmoduleEnabled {
+		i--
+		if m.SubmoduleEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GitDirectoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitDirectoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitDirectoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
@@ -6165,6 +7519,92 @@ func (m *Refs) Size() (n int) {
 	return n
 }
 
+func (m *OCIRepositories) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Repositories) > 0 {
+		for _, s := range m.Repositories {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OCIArtifactsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OCIArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OCIArtifactsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ListAppsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6594,7 +8034,7 @@ func (m *HelmChartsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GitFilesRequest) Size() (n int) {
+func (m *HelmChartVersionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6604,54 +8044,222 @@ func (m *GitFilesRequest) Size() (n int) {
 		l = m.Repo.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	if m.SubmoduleEnabled {
-		n += 2
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.NewGitFileGlobbingEnabled {
-		n += 2
-	}
-	if m.NoRevisionCache {
-		n += 2
+	if len(m.Charts) > 0 {
+		for _, s := range m.Charts {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
 	}
-	if m.VerifyCommit {
+	if m.NoCache {
 		n += 2
 	}
-	if m.SourceIntegrity != nil {
-		l = m.SourceIntegrity.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GitFilesResponse) Size() (n int) {
+func (m *HelmChartVersion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Map) > 0 {
-		for k, v := range m.Map {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovRepository(uint64(len(v)))
-			}
-			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
-		}
-	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Created)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Home)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Keywords) > 0 {
+		for _, s := range m.Keywords {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmChartVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GitTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GitTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Sha)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GitTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GitFilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.SubmoduleEnabled {
+		n += 2
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.NewGitFileGlobbingEnabled {
+		n += 2
+	}
+	if m.NoRevisionCache {
+		n += 2
+	}
+	if m.VerifyCommit {
+		n += 2
+	}
+	if m.SourceIntegrity != nil {
+		l = m.SourceIntegrity.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GitFilesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Map) > 0 {
+		for k, v := range m.Map {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovRepository(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9166,7 +10774,7 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
+func (m *OCIRepositories) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9189,17 +10797,17 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAppsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIRepositories: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIRepositories: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -9209,33 +10817,80 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Repositories = append(m.Repositories, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIArtifactsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIArtifactsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIArtifactsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -9245,29 +10900,33 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -9277,106 +10936,23 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnabledSourceTypes == nil {
-				m.EnabledSourceTypes = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.EnabledSourceTypes[mapkey] = mapvalue
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9400,7 +10976,7 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppList) Unmarshal(dAtA []byte) error {
+func (m *OCIArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9423,15 +10999,79 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9458,8 +11098,8 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apps == nil {
-				m.Apps = make(map[string]string)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -9554,7 +11194,7 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Apps[mapkey] = mapvalue
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9578,7 +11218,7 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginInfo) Unmarshal(dAtA []byte) error {
+func (m *OCIArtifactsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9601,17 +11241,17 @@ func (m *PluginInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIArtifactsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIArtifactsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -9621,23 +11261,25 @@ func (m *PluginInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Artifacts = append(m.Artifacts, &OCIArtifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9661,7 +11303,7 @@ func (m *PluginInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginList) Unmarshal(dAtA []byte) error {
+func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9684,15 +11326,15 @@ func (m *PluginList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAppsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9719,65 +11361,48 @@ func (m *PluginList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &PluginInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerAppDetailsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9804,86 +11429,146 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if m.EnabledSourceTypes == nil {
+				m.EnabledSourceTypes = make(map[string]bool)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
+			m.EnabledSourceTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &v1alpha1.ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repos = append(m.Repos, &v1alpha1.Repository{})
-			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9910,167 +11595,27 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KustomizeOptions == nil {
-				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
-			}
-			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			if m.Apps == nil {
+				m.Apps = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.NoCache = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoRevisionCache", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.NoRevisionCache = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EnabledSourceTypes == nil {
-				m.EnabledSourceTypes = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
@@ -10103,7 +11648,7 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRepository
@@ -10113,12 +11658,24 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10134,49 +11691,64 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.EnabledSourceTypes[mapkey] = mapvalue
+			m.Apps[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HelmOptions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HelmOptions == nil {
-				m.HelmOptions = &v1alpha1.HelmOptions{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			if err := m.HelmOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefSources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10186,27 +11758,592 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RefSources == nil {
-				m.RefSources = make(map[string]*v1alpha1.RefTarget)
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *v1alpha1.RefTarget
-			for iNdEx < postIndex {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &PluginInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerAppDetailsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &v1alpha1.Repository{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCache = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoRevisionCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoRevisionCache = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnabledSourceTypes == nil {
+				m.EnabledSourceTypes = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnabledSourceTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmOptions == nil {
+				m.HelmOptions = &v1alpha1.HelmOptions{}
+			}
+			if err := m.HelmOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefSources == nil {
+				m.RefSources = make(map[string]*v1alpha1.RefTarget)
+			}
+			var mapkey string
+			var mapvalue *v1alpha1.RefTarget
+			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10223,83 +12360,934 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1alpha1.RefTarget{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1alpha1.RefTarget{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RefSources[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAppDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAppDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &HelmAppSpec{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kustomize == nil {
+				m.Kustomize = &KustomizeAppSpec{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &DirectoryAppSpec{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginAppSpec{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckSignature", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckSignature = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIntegrity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceIntegrity == nil {
+				m.SourceIntegrity = &v1alpha1.SourceIntegrity{}
+			}
+			if err := m.SourceIntegrity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerRevisionChartDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerRevisionChartDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, &v1alpha1.HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileParameters = append(m.FileParameters, &v1alpha1.HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RefSources[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10323,7 +13311,7 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10346,15 +13334,66 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAppDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectoryAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAppDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectoryAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParameterAnnouncement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParameterAnnouncement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10382,13 +13421,13 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10398,33 +13437,29 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &HelmAppSpec{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tooltip", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10434,33 +13469,29 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &KustomizeAppSpec{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tooltip = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10470,33 +13501,17 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Directory == nil {
-				m.Directory = &DirectoryAppSpec{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.Required = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10506,84 +13521,29 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginAppSpec{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ItemType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10593,31 +13553,27 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollectionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10645,13 +13601,13 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.String_ = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckSignature", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10661,15 +13617,27 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CheckSignature = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceIntegrity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10696,12 +13664,103 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceIntegrity == nil {
-				m.SourceIntegrity = &v1alpha1.SourceIntegrity{}
+			if m.Map == nil {
+				m.Map = make(map[string]string)
 			}
-			if err := m.SourceIntegrity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Map[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10725,7 +13784,7 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10748,15 +13807,15 @@ func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerRevisionChartDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerRevisionChartDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParametersAnnouncement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10783,77 +13842,11 @@ func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ParametersAnnouncement = append(m.ParametersAnnouncement, &ParameterAnnouncement{})
+			if err := m.ParametersAnnouncement[len(m.ParametersAnnouncement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10876,7 +13869,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
+func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10899,17 +13892,17 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10919,93 +13912,82 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmChart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, &v1alpha1.HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmChart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmChart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11033,13 +14015,13 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -11049,25 +14031,23 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileParameters = append(m.FileParameters, &v1alpha1.HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11091,7 +14071,7 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
+func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11114,17 +14094,17 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -11134,23 +14114,25 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, &HelmChart{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11174,7 +14156,7 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
+func (m *HelmChartVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11197,12 +14179,100 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DirectoryAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectoryAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCache = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -11225,7 +14295,7 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
+func (m *HelmChartVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11248,10 +14318,10 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParameterAnnouncement: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParameterAnnouncement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11288,7 +14358,7 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11316,11 +14386,11 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tooltip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11348,13 +14418,13 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tooltip = string(dAtA[iNdEx:postIndex])
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -11364,15 +14434,27 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Required = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ItemType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11400,11 +14482,11 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ItemType = string(dAtA[iNdEx:postIndex])
+			m.Created = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11432,11 +14514,11 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectionType = string(dAtA[iNdEx:postIndex])
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11464,11 +14546,11 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.String_ = string(dAtA[iNdEx:postIndex])
+			m.Home = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11496,13 +14578,45 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			m.Icon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keywords", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keywords = append(m.Keywords, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -11512,119 +14626,12 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Map == nil {
-				m.Map = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Map[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -11647,7 +14654,7 @@ func (m *ParameterAnnouncement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
+func (m *HelmChartVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11670,15 +14677,15 @@ func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParametersAnnouncement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11705,8 +14712,8 @@ func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParametersAnnouncement = append(m.ParametersAnnouncement, &ParameterAnnouncement{})
-			if err := m.ParametersAnnouncement[len(m.ParametersAnnouncement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &HelmChartVersion{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11732,7 +14739,7 @@ func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
+func (m *GitTagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11755,10 +14762,10 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChartsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitTagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChartsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11797,6 +14804,38 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -11819,7 +14858,7 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChart) Unmarshal(dAtA []byte) error {
+func (m *GitTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11842,10 +14881,10 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChart: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11882,7 +14921,7 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11910,7 +14949,103 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			m.Sha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11934,7 +15069,7 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
+func (m *GitTagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11957,15 +15092,15 @@ func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChartsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitTagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChartsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11992,8 +15127,8 @@ func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &HelmChart{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, &GitTag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	goio "io"
 	"io/fs"
+	"maps"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	gosync "sync"
@@ -101,6 +103,7 @@ type Service struct {
 	parallelismLimitSemaphore *semaphore.Weighted
 	metricsServer             *metrics.MetricsServer
 	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, noProxy string, mediaTypes []string, opts ...oci.ClientOpts) (oci.Client, error)
+	listOCIRepositories       func(ctx context.Context, repoURL string, creds oci.Creds, proxy string, noProxy string) ([]string, error)
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
 	initConstants             RepoServerInitConstants
@@ -153,6 +156,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		metricsServer:             metricsServer,
 		newGitClient:              git.NewClientExt,
 		newOCIClient:              oci.NewClient,
+		listOCIRepositories:       oci.ListRepositories,
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client {
 			// Add User-Agent option if configured
 			if initConstants.HelmUserAgent != "" {
@@ -230,6 +234,58 @@ func (s *Service) ListOCITags(ctx context.Context, q *apiclient.ListRefsRequest)
 	return &res, nil
 }
 
+// ListOCIRepositories lists the repositories of an OCI registry namespace
+func (s *Service) ListOCIRepositories(ctx context.Context, q *apiclient.ListRefsRequest) (*apiclient.OCIRepositories, error) {
+	if q.Repo == nil {
+		return nil, status.Error(codes.InvalidArgument, "must pass a valid repo")
+	}
+
+	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+
+	repositories, err := s.listOCIRepositories(ctx, q.Repo.Repo, q.Repo.GetOCICreds(), q.Repo.Proxy, q.Repo.NoProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiclient.OCIRepositories{Repositories: repositories}, nil
+}
+
+// GetOCIArtifacts returns the digest and the manifest annotations of the given tags of an OCI repository
+func (s *Service) GetOCIArtifacts(ctx context.Context, q *apiclient.OCIArtifactsRequest) (*apiclient.OCIArtifactsResponse, error) {
+	if q.Repo == nil {
+		return nil, status.Error(codes.InvalidArgument, "must pass a valid repo")
+	}
+
+	ociClient, err := s.newOCIClient(q.Repo.Repo, q.Repo.GetOCICreds(), q.Repo.Proxy, q.Repo.NoProxy, s.initConstants.OCIMediaTypes, s.ociClientStandardOpts()...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating oci client: %w", err)
+	}
+
+	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+
+	res := &apiclient.OCIArtifactsResponse{}
+	for _, tag := range q.Tags {
+		// By convention: tags are listed with the underscore (_) of the registry changed to plus (+)
+		digest, err := ociClient.ResolveRevision(ctx, strings.ReplaceAll(tag, "+", "_"), true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve tag %q: %w", tag, err)
+		}
+		manifest, err := ociClient.DigestMetadata(ctx, digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata of tag %q: %w", tag, err)
+		}
+		res.Artifacts = append(res.Artifacts, &apiclient.OCIArtifact{
+			Tag:         tag,
+			Digest:      digest,
+			Annotations: manifest.Annotations,
+		})
+	}
+
+	return res, nil
+}
+
 // ListRefs List a subset of the refs (currently, branches and tags) of a git repo
 func (s *Service) ListRefs(_ context.Context, q *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
 	if q.Repo == nil {
@@ -3132,6 +3188,109 @@ func (s *Service) GetHelmCharts(ctx context.Context, q *apiclient.HelmChartsRequ
 	return &res, nil
 }
 
+// GetHelmChartVersions returns the versions of charts of a Helm repository with their metadata. The versions of charts
+// of OCI repositories are listed from their tags, without metadata.
+func (s *Service) GetHelmChartVersions(ctx context.Context, q *apiclient.HelmChartVersionsRequest) (*apiclient.HelmChartVersionsResponse, error) {
+	if q.Repo == nil {
+		return nil, status.Error(codes.InvalidArgument, "must pass a valid repo")
+	}
+
+	enableOCI := q.Repo.EnableOCI || helm.IsHelmOciRepo(q.Repo.Repo)
+	opts := []helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths)}
+	if q.Repo.InsecureOCIForceHttp {
+		opts = append(opts, helm.WithPlainHTTP())
+	}
+	helmClient := s.newHelmClient(q.Repo.Repo, q.Repo.GetHelmCreds(), enableOCI, q.Repo.Proxy, q.Repo.NoProxy, opts...)
+
+	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+
+	res := &apiclient.HelmChartVersionsResponse{}
+	if enableOCI {
+		if len(q.Charts) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "charts must be specified for OCI Helm repositories")
+		}
+		for _, chart := range q.Charts {
+			tags, err := helmClient.GetTags(ctx, chart, q.NoCache)
+			if err != nil {
+				return nil, fmt.Errorf("error getting tags of chart %q: %w", chart, err)
+			}
+			for _, tag := range tags {
+				res.Items = append(res.Items, &apiclient.HelmChartVersion{Name: chart, Version: tag})
+			}
+		}
+		return res, nil
+	}
+
+	index, err := helmClient.GetIndex(ctx, q.NoCache, s.initConstants.HelmRegistryMaxIndexSize)
+	if err != nil {
+		return nil, err
+	}
+	charts := q.Charts
+	if len(charts) == 0 {
+		charts = slices.Sorted(maps.Keys(index.Entries))
+	}
+	for _, chart := range charts {
+		entries, err := index.GetEntries(chart)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			version := &apiclient.HelmChartVersion{
+				Name:        chart,
+				Version:     entry.Version,
+				AppVersion:  entry.AppVersion,
+				Description: entry.Description,
+				Digest:      entry.Digest,
+				Home:        entry.Home,
+				Icon:        entry.Icon,
+				Keywords:    entry.Keywords,
+				Deprecated:  entry.Deprecated,
+			}
+			if !entry.Created.IsZero() {
+				version.Created = entry.Created.Format(time.RFC3339)
+			}
+			res.Items = append(res.Items, version)
+		}
+	}
+	return res, nil
+}
+
+// GetGitTags resolves the given tags of a Git repository and returns the metadata of the commits they point to
+func (s *Service) GetGitTags(ctx context.Context, q *apiclient.GitTagsRequest) (*apiclient.GitTagsResponse, error) {
+	if q.Repo == nil {
+		return nil, status.Error(codes.InvalidArgument, "must pass a valid repo")
+	}
+
+	gitClient, err := s.newClient(q.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("error creating git client: %w", err)
+	}
+
+	res := &apiclient.GitTagsResponse{}
+	for _, tag := range q.Tags {
+		commitSHA, err := gitClient.LsRemote(tag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve tag %q: %w", tag, err)
+		}
+		metadata, err := s.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{Repo: q.Repo, Revision: commitSHA})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata of tag %q: %w", tag, err)
+		}
+		gitTag := &apiclient.GitTag{
+			Name:    tag,
+			Sha:     commitSHA,
+			Author:  metadata.Author,
+			Message: metadata.Message,
+		}
+		if metadata.Date != nil {
+			gitTag.Date = metadata.Date.Format(time.RFC3339)
+		}
+		res.Tags = append(res.Tags, gitTag)
+	}
+	return res, nil
+}
+
 func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
 	repo := q.Repo
 	// per Type doc, "git" should be assumed if empty or absent
@@ -37,6 +37,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
@@ -1936,6 +1937,69 @@ func TestGetHelmCharts(t *testing.T) {
 	assert.Equal(t, []string{"1.0.0", "1.1.0"}, item2.Versions)
 }
 
+func TestGetHelmChartVersions(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	service, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, helmClient *helmmocks.Client, _ *ocimocks.Client, _ *iomocks.TempPaths) {
+		helmClient.EXPECT().GetIndex(mock.Anything, true, mock.Anything).Return(&helm.Index{Entries: map[string]helm.Entries{
+			"nginx":     {{Version: "1.0.0"}},
+			"guestbook": {{Version: "1.1.0", AppVersion: "v2", Created: created, Digest: "sha256:abc", Keywords: []string{"demo"}, Deprecated: true}},
+		}}, nil)
+		helmClient.EXPECT().GetTags(mock.Anything, "guestbook", false).Return([]string{"1.0.0", "1.1.0"}, nil)
+	}, t.TempDir())
+
+	t.Run("Helm repository", func(t *testing.T) {
+		res, err := service.GetHelmChartVersions(t.Context(), &apiclient.HelmChartVersionsRequest{Repo: &v1alpha1.Repository{Repo: "https://charts.example.com"}, NoCache: true})
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.HelmChartVersion{
+			{Name: "guestbook", Version: "1.1.0", AppVersion: "v2", Created: "2024-01-01T00:00:00Z", Digest: "sha256:abc", Keywords: []string{"demo"}, Deprecated: true},
+			{Name: "nginx", Version: "1.0.0"},
+		}, res.Items)
+	})
+
+	t.Run("Unknown chart", func(t *testing.T) {
+		_, err := service.GetHelmChartVersions(t.Context(), &apiclient.HelmChartVersionsRequest{Repo: &v1alpha1.Repository{Repo: "https://charts.example.com"}, Charts: []string{"missing"}, NoCache: true})
+		require.Error(t, err)
+	})
+
+	t.Run("OCI Helm repository", func(t *testing.T) {
+		res, err := service.GetHelmChartVersions(t.Context(), &apiclient.HelmChartVersionsRequest{Repo: &v1alpha1.Repository{Repo: "registry.example.com/charts", EnableOCI: true}, Charts: []string{"guestbook"}})
+		require.NoError(t, err)
+		assert.Equal(t, []*apiclient.HelmChartVersion{{Name: "guestbook", Version: "1.0.0"}, {Name: "guestbook", Version: "1.1.0"}}, res.Items)
+
+		_, err = service.GetHelmChartVersions(t.Context(), &apiclient.HelmChartVersionsRequest{Repo: &v1alpha1.Repository{Repo: "registry.example.com/charts", EnableOCI: true}})
+		require.ErrorContains(t, err, "charts must be specified for OCI Helm repositories")
+	})
+}
+
+func TestGetGitTags(t *testing.T) {
+	const sha = "c0b400fc458875d925171398f9ba9eabd5529923"
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	service, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
+		gitClient.EXPECT().LsRemote("v1.0.0").Return(sha, nil)
+		gitClient.EXPECT().LsRemote("missing").Return("", errors.New("unable to resolve 'missing' to a commit SHA"))
+		paths.EXPECT().GetPath(mock.Anything).Return(t.TempDir(), nil)
+	}, t.TempDir())
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}
+	require.NoError(t, service.cache.SetRevisionMetadata(repo.Repo, sha, &v1alpha1.RevisionMetadata{
+		Author:  "Jane Doe <jane@example.com>",
+		Date:    &metav1.Time{Time: date},
+		Message: "Release v1.0.0",
+	}))
+
+	res, err := service.GetGitTags(t.Context(), &apiclient.GitTagsRequest{Repo: repo, Tags: []string{"v1.0.0"}})
+	require.NoError(t, err)
+	assert.Equal(t, []*apiclient.GitTag{{
+		Name:    "v1.0.0",
+		Sha:     sha,
+		Author:  "Jane Doe <jane@example.com>",
+		Date:    "2024-01-01T00:00:00Z",
+		Message: "Release v1.0.0",
+	}}, res.Tags)
+
+	_, err = service.GetGitTags(t.Context(), &apiclient.GitTagsRequest{Repo: repo, Tags: []string{"missing"}})
+	require.ErrorContains(t, err, `failed to resolve tag "missing"`)
+}
+
 func TestGetRevisionMetadata(t *testing.T) {
 	service, gitClient, _ := newServiceWithMocks(t, "../..")
 	now := time.Now()
@@ -6071,6 +6135,48 @@ func TestGetOCIMetadata(t *testing.T) {
 	})
 }
 
+func TestListOCIRepositories(t *testing.T) {
+	service := newService(t, t.TempDir())
+	service.listOCIRepositories = func(_ context.Context, repoURL string, creds oci.Creds, _ string, _ string) ([]string, error) {
+		assert.Equal(t, "oci://registry.example.com/charts", repoURL)
+		assert.Equal(t, "user", creds.Username)
+		return []string{"guestbook", "helm-guestbook"}, nil
+	}
+
+	res, err := service.ListOCIRepositories(t.Context(), &apiclient.ListRefsRequest{
+		Repo: &v1alpha1.Repository{Repo: "oci://registry.example.com/charts", Type: "oci", Username: "user"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guestbook", "helm-guestbook"}, res.Repositories)
+}
+
+func TestGetOCIArtifacts(t *testing.T) {
+	service, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, ociClient *ocimocks.Client, _ *iomocks.TempPaths) {
+		ociClient.EXPECT().ResolveRevision(mock.Anything, "1.0.0_build.1", true).Return("sha256:abc", nil)
+		ociClient.EXPECT().DigestMetadata(mock.Anything, "sha256:abc").Return(&imagev1.Manifest{
+			Annotations: map[string]string{"org.opencontainers.image.version": "1.0.0+build.1"},
+		}, nil)
+		ociClient.EXPECT().ResolveRevision(mock.Anything, "missing", true).Return("", errors.New("not found"))
+	}, t.TempDir())
+
+	res, err := service.GetOCIArtifacts(t.Context(), &apiclient.OCIArtifactsRequest{
+		Repo: &v1alpha1.Repository{Repo: "oci://registry.example.com/charts/guestbook", Type: "oci"},
+		Tags: []string{"1.0.0+build.1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*apiclient.OCIArtifact{{
+		Tag:         "1.0.0+build.1",
+		Digest:      "sha256:abc",
+		Annotations: map[string]string{"org.opencontainers.image.version": "1.0.0+build.1"},
+	}}, res.Artifacts)
+
+	_, err = service.GetOCIArtifacts(t.Context(), &apiclient.OCIArtifactsRequest{
+		Repo: &v1alpha1.Repository{Repo: "oci://registry.example.com/charts/guestbook", Type: "oci"},
+		Tags: []string{"missing"},
+	})
+	require.ErrorContains(t, err, `failed to resolve tag "missing": not found`)
+}
+
 func Test_GenerateManifests_Commands(t *testing.T) {
 	t.Run("helm", func(t *testing.T) {
 		service := newService(t, "testdata/my-chart")
@@ -2081,19 +2081,30 @@ func isTheSelectedOne(currentNode *v1alpha1.ResourceNode, q *application.Applica
 
 // Sync syncs an application to its target state
 func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*v1alpha1.Application, error) {
+	// a plan is a dry-run of the sync
+	if syncReq.GetPlan() {
+		syncReq.DryRun = new(true)
+	}
 	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, syncReq.GetProject(), syncReq.GetAppNamespace(), syncReq.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
 
+	if syncReq.GetPlanID() != "" {
+		syncReq, err = newSyncPlanRequest(a, syncReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true, nil)
+	canSync, blockedReason, err := proj.Spec.SyncWindows.Matches(a).CanSyncWithReason(true, nil)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
 	if !canSync {
-		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window: %s", blockedReason)
 	}
 
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
@@ -2116,6 +2127,16 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err != nil {
 		return nil, err
 	}
+	if syncReq.GetPlanID() != "" {
+		planResult := a.Status.OperationState.SyncResult
+		revisionChanged := revision != planResult.Revision
+		if a.Spec.HasMultipleSources() {
+			revisionChanged = !slices.Equal(sourceRevisions, planResult.Revisions)
+		}
+		if revisionChanged {
+			return nil, status.Errorf(codes.FailedPrecondition, "sync plan %s is out of date: the target revision of the application changed since it was previewed", syncReq.GetPlanID())
+		}
+	}
 
 	var retry *v1alpha1.RetryStrategy
 	var syncOptions v1alpha1.SyncOptions
@@ -2158,6 +2179,8 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			Revision:     revision,
 			Prune:        syncReq.GetPrune(),
 			DryRun:       syncReq.GetDryRun(),
+			Plan:         syncReq.GetPlan(),
+			PlanID:       syncReq.GetPlanID(),
 			SyncOptions:  syncOptions,
 			SyncStrategy: syncReq.Strategy,
 			Resources:    resources,
@@ -2192,10 +2215,47 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if syncReq.GetPlanID() != "" {
+		reason = fmt.Sprintf("%s, applying sync plan %s", reason, syncReq.GetPlanID())
+	}
 	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
 
+// newSyncPlanRequest returns the request to apply the last sync plan of an application, which uses the options the
+// plan was previewed with
+func newSyncPlanRequest(a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (*application.ApplicationSyncRequest, error) {
+	if syncReq.GetRevision() != "" || len(syncReq.Revisions) > 0 || len(syncReq.SourcePositions) > 0 || len(syncReq.Resources) > 0 || syncReq.Manifests != nil || syncReq.GetDryRun() || syncReq.GetPlan() {
+		return nil, status.Error(codes.InvalidArgument, "revisions, resources, local manifests, dry-run and plan cannot be specified when applying a sync plan")
+	}
+	opState := a.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || !opState.Operation.Sync.Plan || opState.SyncResult == nil || opState.SyncResult.Plan == nil || opState.SyncResult.Plan.ID != syncReq.GetPlanID() {
+		return nil, status.Errorf(codes.FailedPrecondition, "sync plan %s is not the last sync plan of the application", syncReq.GetPlanID())
+	}
+	if opState.Phase != common.OperationSucceeded {
+		return nil, status.Errorf(codes.FailedPrecondition, "sync plan %s has completed with phase: %s", syncReq.GetPlanID(), opState.Phase)
+	}
+	planOp := opState.Operation.Sync
+	if planOp.Manifests != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "sync plan %s was previewed with local manifests and cannot be applied", syncReq.GetPlanID())
+	}
+	planReq := &application.ApplicationSyncRequest{
+		Name:          syncReq.Name,
+		AppNamespace:  syncReq.AppNamespace,
+		Project:       syncReq.Project,
+		Infos:         syncReq.Infos,
+		RetryStrategy: syncReq.RetryStrategy,
+		PlanID:        syncReq.PlanID,
+		Prune:         new(planOp.Prune),
+		Strategy:      planOp.SyncStrategy,
+		SyncOptions:   &application.SyncOptions{Items: planOp.SyncOptions},
+	}
+	for i := range planOp.Resources {
+		planReq.Resources = append(planReq.Resources, &planOp.Resources[i])
+	}
+	return planReq, nil
+}
+
 func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
 	requireOverridePrivilegeForRevisionSync, err := s.settingsMgr.RequireOverridePrivilegeForRevisionSync()
 	if err != nil {
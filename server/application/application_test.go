@@ -2647,6 +2647,91 @@ func TestSyncRBACOverrideNotRequired_DiffRevisionWithAutosyncPrevented(t *testin
 		"should not be able to sync to different revision with auto-sync enabled, multi-source app")
 }
 
+func TestSyncPlan(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
+		Automated: &v1alpha1.SyncPolicyAutomated{},
+	}
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	// a plan is a dry-run, so it is allowed for a revision other than the one auto-sync is set to
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{
+		Name:     &app.Name,
+		Revision: new("revisionbranch"),
+		Plan:     new(true),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.True(t, app.Operation.Sync.Plan)
+	assert.True(t, app.Operation.Sync.DryRun)
+	assert.True(t, app.Operation.DryRun())
+}
+
+func TestSyncPlanID(t *testing.T) {
+	newPlanApp := func(revision string) *v1alpha1.Application {
+		app := newTestApp()
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{
+				Revision:    revision,
+				Prune:       true,
+				DryRun:      true,
+				Plan:        true,
+				SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true"},
+				Resources:   []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "config"}},
+			}},
+			Phase: synccommon.OperationSucceeded,
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision: revision,
+				Plan:     &v1alpha1.SyncPlan{ID: "plan-id"},
+			},
+		}
+		return app
+	}
+
+	t.Run("applies the plan with its options", func(t *testing.T) {
+		app := newPlanApp(fakeResolveRevisionResponse().Revision)
+		appServer := newTestAppServer(t, app)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &app.Name, PlanID: new("plan-id")})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, "plan-id", app.Operation.Sync.PlanID)
+		assert.Equal(t, fakeResolveRevisionResponse().Revision, app.Operation.Sync.Revision)
+		assert.True(t, app.Operation.Sync.Prune)
+		assert.False(t, app.Operation.Sync.DryRun)
+		assert.False(t, app.Operation.Sync.Plan)
+		assert.Equal(t, v1alpha1.SyncOptions{"ServerSideApply=true"}, app.Operation.Sync.SyncOptions)
+		assert.Equal(t, []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "config"}}, app.Operation.Sync.Resources)
+	})
+
+	t.Run("refuses a plan which is not the last plan", func(t *testing.T) {
+		app := newPlanApp(fakeResolveRevisionResponse().Revision)
+		appServer := newTestAppServer(t, app)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &app.Name, PlanID: new("other-plan-id")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = sync plan other-plan-id is not the last sync plan of the application")
+	})
+
+	t.Run("refuses a plan previewed for another revision", func(t *testing.T) {
+		app := newPlanApp("0000000000000000000000000000000000000000")
+		appServer := newTestAppServer(t, app)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &app.Name, PlanID: new("plan-id")})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = sync plan plan-id is out of date: the target revision of the application changed since it was previewed")
+	})
+
+	t.Run("refuses a plan with other options", func(t *testing.T) {
+		app := newPlanApp(fakeResolveRevisionResponse().Revision)
+		appServer := newTestAppServer(t, app)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &app.Name, PlanID: new("plan-id"), Revision: new("HEAD")})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = revisions, resources, local manifests, dry-run and plan cannot be specified when applying a sync plan")
+	})
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
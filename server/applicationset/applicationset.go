@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -20,20 +21,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	appsettemplate "github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/progressivesync"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	eventspb "github.com/argoproj/argo-cd/v3/pkg/apiclient/events"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -41,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/broadcast"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/collections"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/github_app"
@@ -74,6 +80,12 @@ type Server struct {
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
 	EnableGitHubAPIMetrics   bool
+	// the settings of the ApplicationSet controller which affect how the generated Applications are written, to
+	// compute the changes of an ApplicationSet the same way
+	GlobalPreservedAnnotations []string
+	GlobalPreservedLabels      []string
+	Policy                     v1alpha1.ApplicationsSyncPolicy
+	EnablePolicyOverride       bool
 }
 
 func (s *Server) Watch(q *applicationset.ApplicationSetWatchQuery, ws applicationset.ApplicationSetService_WatchServer) error {
@@ -188,6 +200,10 @@ func NewServer(
 	allowedScmProviders []string,
 	enableScmProviders bool,
 	enableGitHubAPIMetrics bool,
+	globalPreservedAnnotations []string,
+	globalPreservedLabels []string,
+	policy v1alpha1.ApplicationsSyncPolicy,
+	enablePolicyOverride bool,
 	enableK8sEvent []string,
 	clusterInformer *settings.ClusterInformer,
 ) applicationset.ApplicationSetServiceServer {
@@ -227,6 +243,11 @@ func NewServer(
 		AllowedScmProviders:      allowedScmProviders,
 		EnableScmProviders:       enableScmProviders,
 		EnableGitHubAPIMetrics:   enableGitHubAPIMetrics,
+
+		GlobalPreservedAnnotations: globalPreservedAnnotations,
+		GlobalPreservedLabels:      globalPreservedLabels,
+		Policy:                     policy,
+		EnablePolicyOverride:       enablePolicyOverride,
 	}
 	return s
 }
@@ -371,9 +392,13 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 
 	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig, s.clusterInformer)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig, s.clusterInformer, s.db)
 
-	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
+	importedAppSet, err := appsetutils.ImportGeneratorTemplates(ctx, s.client, &appset)
+	if err != nil {
+		return nil, fmt.Errorf("error importing generator templates: %w", err)
+	}
+	apps, _, err := appsettemplate.GenerateApplications(logEntry, *importedAppSet, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
 		return nil, fmt.Errorf("error generating applications: %w", err)
 	}
@@ -444,6 +469,91 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 	return &applicationset.ApplicationSetResponse{}, nil
 }
 
+// PromoteRollout approves a progressive sync step which requires manual approval
+func (s *Server) PromoteRollout(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return s.setRolloutApproval(ctx, q, v1alpha1.ApplicationSetRolloutApproved)
+}
+
+// AbortRollout rejects a progressive sync step which requires manual approval, halting the rollout
+func (s *Server) AbortRollout(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return s.setRolloutApproval(ctx, q, v1alpha1.ApplicationSetRolloutAborted)
+}
+
+// setRolloutApproval records the decision taken on a progressive sync step on the status of its Applications
+func (s *Server) setRolloutApproval(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest, phase v1alpha1.ApplicationSetRolloutApprovalPhase) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	appset, err := s.getAppSetEnforceRBAC(ctx, rbac.ActionUpdate, namespace, q.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !progressivesync.RollingSyncStrategyEnabled(appset) {
+		return nil, status.Errorf(codes.FailedPrecondition, "ApplicationSet %s does not use the RollingSync strategy", appset.QualifiedName())
+	}
+
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+
+	for range 10 {
+		step := int(q.Step)
+		if step == 0 {
+			step = progressivesync.GetStepAwaitingApproval(appset)
+			if step == 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "ApplicationSet %s has no rollout step waiting for approval", appset.QualifiedName())
+			}
+		}
+		if step < 1 || step > len(appset.Spec.Strategy.RollingSync.Steps) {
+			return nil, status.Errorf(codes.InvalidArgument, "ApplicationSet %s has no rollout step %d", appset.QualifiedName(), step)
+		}
+		if !appset.Spec.Strategy.RollingSync.Steps[step-1].ManualApproval {
+			return nil, status.Errorf(codes.InvalidArgument, "rollout step %d of ApplicationSet %s does not require manual approval", step, appset.QualifiedName())
+		}
+		appNames := progressivesync.GetStepApplications(appset, step)
+		if len(appNames) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "rollout step %d of ApplicationSet %s has no Applications", step, appset.QualifiedName())
+		}
+
+		updated := appset.DeepCopy()
+		approval := v1alpha1.ApplicationSetRolloutApprovalStatus{Phase: phase, User: user, Time: metav1.Now()}
+		for i := range updated.Status.ApplicationStatus {
+			if updated.Status.ApplicationStatus[i].Step == strconv.Itoa(step) {
+				updated.Status.ApplicationStatus[i].Approval = approval.DeepCopy()
+			}
+		}
+		// the resource version makes the patch fail with a conflict if the status was changed concurrently
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{"resourceVersion": appset.ResourceVersion},
+			"status":   map[string]any{"applicationStatus": updated.Status.ApplicationStatus},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling ApplicationSet status patch: %w", err)
+		}
+		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, q.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+		if err == nil {
+			action := fmt.Sprintf("promoted rollout step %d", step)
+			reason := argo.EventReasonRolloutPromoted
+			if phase == v1alpha1.ApplicationSetRolloutAborted {
+				action = fmt.Sprintf("aborted rollout step %d", step)
+				reason = argo.EventReasonRolloutAborted
+			}
+			s.logAppSetEvent(ctx, res, reason, action)
+			s.waitSync(res)
+			return res, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating ApplicationSet status: %w", err)
+		}
+
+		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update ApplicationSet status. Too many conflicts")
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *applicationset.ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
@@ -456,8 +566,116 @@ func (s *Server) ResourceTree(ctx context.Context, q *applicationset.Application
 }
 
 func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetGenerateRequest) (*applicationset.ApplicationSetGenerateResponse, error) {
+	apps, err := s.generate(ctx, q.GetApplicationSet())
+	if err != nil {
+		return nil, err
+	}
+	res := &applicationset.ApplicationSetGenerateResponse{}
+	for i := range apps {
+		res.Applications = append(res.Applications, &apps[i])
+	}
+	return res, nil
+}
+
+// Diff returns the Applications the ApplicationSet controller would create, update or delete for the given
+// ApplicationSet, comparing the generated Applications with the live ones the same way the controller does.
+func (s *Server) Diff(ctx context.Context, q *applicationset.ApplicationSetGenerateRequest) (*applicationset.ApplicationSetDiffResponse, error) {
 	appset := q.GetApplicationSet()
+	if appset != nil {
+		// the Applications are generated in the namespace of the ApplicationSet, so it must be set to find the live ones
+		appset = appset.DeepCopy()
+		appset.Namespace = s.appsetNamespaceOrDefault(appset.Namespace)
+	}
+	apps, err := s.generate(ctx, appset)
+	if err != nil {
+		return nil, err
+	}
+
+	diffConfig, err := appsetutils.BuildIgnoreDiffConfig(appset.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error building ignore diff config: %w", err)
+	}
+	policy := appsetutils.DefaultPolicy(appset.Spec.SyncPolicy, s.Policy, s.EnablePolicyOverride)
 
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(appset.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Applications: %w", err)
+	}
+	current := map[string]*v1alpha1.Application{}
+	for i := range appList.Items {
+		app := &appList.Items[i]
+		if owner := metav1.GetControllerOf(app); owner != nil && owner.Kind == application.ApplicationSetKind && owner.Name == appset.Name {
+			current[app.Name] = app
+		}
+	}
+
+	res := &applicationset.ApplicationSetDiffResponse{}
+	desired := map[string]bool{}
+	for i := range apps {
+		generatedApp := apps[i]
+		desired[generatedApp.Name] = true
+		// without the update policy, the controller only creates the Applications it does not own yet
+		if _, exists := current[generatedApp.Name]; exists && !policy.AllowUpdate() {
+			continue
+		}
+
+		generatedApp.Spec = *argo.NormalizeApplicationSpec(&generatedApp.Spec)
+		found := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      generatedApp.Name,
+				Namespace: generatedApp.Namespace,
+			},
+			TypeMeta: metav1.TypeMeta{
+				Kind:       application.ApplicationKind,
+				APIVersion: "argoproj.io/v1alpha1",
+			},
+		}
+		// The controller reference is not set: the ApplicationSet of the request may not exist yet, and the
+		// Applications it owns already have it.
+		action, live, err := appsetutils.DryRunCreateOrUpdate(ctx, s.client, diffConfig, found, func() error {
+			appsetutils.ApplyGeneratedApplication(found, &generatedApp, appset.Spec.PreservedFields, s.GlobalPreservedAnnotations, s.GlobalPreservedLabels)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error comparing Application %s: %w", generatedApp.Name, err)
+		}
+		switch action {
+		case controllerutil.OperationResultCreated:
+			res.Diffs = append(res.Diffs, &applicationset.ApplicationSetApplicationDiff{Action: "create", TargetState: found})
+		case controllerutil.OperationResultUpdated:
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, live.RBACName(s.ns)); err != nil {
+				return nil, err
+			}
+			res.Diffs = append(res.Diffs, &applicationset.ApplicationSetApplicationDiff{Action: "update", LiveState: live, TargetState: found})
+		}
+	}
+
+	if policy.AllowDelete() {
+		for name, app := range current {
+			if desired[name] {
+				continue
+			}
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, app.RBACName(s.ns)); err != nil {
+				return nil, err
+			}
+			res.Diffs = append(res.Diffs, &applicationset.ApplicationSetApplicationDiff{Action: "delete", LiveState: app})
+		}
+	}
+	sort.Slice(res.Diffs, func(i, j int) bool {
+		return diffApplicationName(res.Diffs[i]) < diffApplicationName(res.Diffs[j])
+	})
+	return res, nil
+}
+
+func diffApplicationName(diff *applicationset.ApplicationSetApplicationDiff) string {
+	if diff.TargetState != nil {
+		return diff.TargetState.Name
+	}
+	return diff.LiveState.Name
+}
+
+// generate validates the given ApplicationSet and the permissions to create it, and returns the Applications it generates
+func (s *Server) generate(ctx context.Context, appset *v1alpha1.ApplicationSet) ([]v1alpha1.Application, error) {
 	if appset == nil {
 		return nil, errors.New("error creating ApplicationSets: ApplicationSets is nil in request")
 	}
@@ -495,11 +713,7 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate Applications of ApplicationSet: %w\n%s", err, logs.String())
 	}
-	res := &applicationset.ApplicationSetGenerateResponse{}
-	for i := range apps {
-		res.Applications = append(res.Applications, &apps[i])
-	}
-	return res, nil
+	return apps, nil
 }
 
 func (s *Server) buildApplicationSetTree(a *v1alpha1.ApplicationSet) (*v1alpha1.ApplicationSetTree, error) {
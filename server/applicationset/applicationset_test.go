@@ -213,6 +213,10 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		[]string{},
 		true,
 		true,
+		nil,
+		nil,
+		appsv1.ApplicationsSyncPolicySync,
+		true,
 		testEnableEventList,
 		clusterInformer,
 	)
@@ -658,6 +662,90 @@ func TestUpsertAppSet(t *testing.T) {
 	})
 }
 
+func newTestRolloutAppSet() *appsv1.ApplicationSet {
+	return newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+			Type: "RollingSync",
+			RollingSync: &appsv1.ApplicationSetRolloutStrategy{
+				Steps: []appsv1.ApplicationSetRolloutStep{
+					{MatchExpressions: []appsv1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}},
+					{MatchExpressions: []appsv1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}, ManualApproval: true},
+				},
+			},
+		}
+		appset.Status.ApplicationStatus = []appsv1.ApplicationSetApplicationStatus{
+			{Application: "app-dev", Status: appsv1.ProgressiveSyncHealthy, Step: "1"},
+			{Application: "app-prod", Status: appsv1.ProgressiveSyncWaiting, Step: "2"},
+		}
+	})
+}
+
+func TestPromoteRollout(t *testing.T) {
+	t.Run("Promote step waiting for approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestRolloutAppSet())
+
+		res, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		require.NoError(t, err)
+		require.Len(t, res.Status.ApplicationStatus, 2)
+		assert.Nil(t, res.Status.ApplicationStatus[0].Approval)
+		require.NotNil(t, res.Status.ApplicationStatus[1].Approval)
+		assert.Equal(t, appsv1.ApplicationSetRolloutApproved, res.Status.ApplicationStatus[1].Approval.Phase)
+
+		stored, err := appSetServer.appclientset.ArgoprojV1alpha1().ApplicationSets(testNamespace).Get(t.Context(), "AppSet1", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, stored.Status.ApplicationStatus[1].Approval)
+		assert.Equal(t, appsv1.ApplicationSetRolloutApproved, stored.Status.ApplicationStatus[1].Approval.Phase)
+	})
+
+	t.Run("Promote step without manual approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestRolloutAppSet())
+
+		_, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Step: 1})
+		assert.ErrorContains(t, err, "does not require manual approval")
+	})
+
+	t.Run("Promote step out of range", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestRolloutAppSet())
+
+		_, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Step: 3})
+		assert.ErrorContains(t, err, "has no rollout step 3")
+	})
+
+	t.Run("Promote without RollingSync strategy", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+		}))
+
+		_, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		assert.ErrorContains(t, err, "does not use the RollingSync strategy")
+	})
+
+	t.Run("Promote is denied without update permission", func(t *testing.T) {
+		f := func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}
+		appSetServer, _ := newTestAppSetServerWithEnforcerConfigure(t, f, testNamespace, newTestRolloutAppSet())
+
+		_, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestAbortRollout(t *testing.T) {
+	appSetServer := newTestAppSetServer(t, newTestRolloutAppSet())
+
+	res, err := appSetServer.AbortRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Step: 2})
+	require.NoError(t, err)
+	require.NotNil(t, res.Status.ApplicationStatus[1].Approval)
+	assert.Equal(t, appsv1.ApplicationSetRolloutAborted, res.Status.ApplicationStatus[1].Approval.Phase)
+
+	// an aborted step is no longer waiting for approval
+	_, err = appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+	assert.ErrorContains(t, err, "has no rollout step waiting for approval")
+}
+
 func TestResourceTree(t *testing.T) {
 	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Name = "AppSet1"
@@ -893,3 +981,82 @@ func TestAppSet_Generate_Cluster(t *testing.T) {
 		assert.EqualError(t, err, "namespace 'NOT-ALLOWED' is not permitted")
 	})
 }
+
+func TestAppSet_Diff(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "guestbook"
+		appset.Spec.GoTemplate = true
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{{
+			List: &appsv1.ListGenerator{Elements: []apiextensionsv1.JSON{
+				{Raw: []byte(`{"name":"created"}`)},
+				{Raw: []byte(`{"name":"updated"}`)},
+				{Raw: []byte(`{"name":"unchanged"}`)},
+			}},
+		}}
+		appset.Spec.Template.Name = "{{.name}}"
+		appset.Spec.Template.Spec.Source = &appsv1.ApplicationSource{RepoURL: fakeRepoURL, Path: "guestbook", TargetRevision: "HEAD"}
+		appset.Spec.Template.Spec.Destination = appsv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "{{.name}}"}
+		appset.Spec.PreservedFields = &appsv1.ApplicationPreservedFields{Annotations: []string{"preserved"}}
+		appset.Spec.IgnoreApplicationDifferences = appsv1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/source/targetRevision"}}}
+	})
+	liveApp := func(name, owner, path, targetRevision string) *appsv1.Application {
+		return &appsv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   testNamespace,
+				Annotations: map[string]string{"preserved": name},
+				Finalizers:  []string{appsv1.ResourcesFinalizerName},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "argoproj.io/v1alpha1",
+					Kind:       "ApplicationSet",
+					Name:       owner,
+					Controller: new(true),
+				}},
+			},
+			Spec: appsv1.ApplicationSpec{
+				Project:     "default",
+				Source:      &appsv1.ApplicationSource{RepoURL: fakeRepoURL, Path: path, TargetRevision: targetRevision},
+				Destination: appsv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: name},
+			},
+		}
+	}
+	objects := []client.Object{
+		liveApp("updated", "guestbook", "old-guestbook", "v1"),
+		liveApp("unchanged", "guestbook", "guestbook", "v1"),
+		liveApp("deleted", "guestbook", "guestbook", "HEAD"),
+		liveApp("other", "other", "guestbook", "HEAD"),
+	}
+
+	t.Run("lists the Applications which would be created, updated or deleted", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, objects...)
+
+		res, err := appSetServer.Diff(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet})
+		require.NoError(t, err)
+		require.Len(t, res.Diffs, 3)
+
+		assert.Equal(t, "create", res.Diffs[0].Action)
+		assert.Nil(t, res.Diffs[0].LiveState)
+		assert.Equal(t, "created", res.Diffs[0].TargetState.Name)
+
+		assert.Equal(t, "delete", res.Diffs[1].Action)
+		assert.Equal(t, "deleted", res.Diffs[1].LiveState.Name)
+		assert.Nil(t, res.Diffs[1].TargetState)
+
+		assert.Equal(t, "update", res.Diffs[2].Action)
+		assert.Equal(t, "old-guestbook", res.Diffs[2].LiveState.Spec.Source.Path)
+		assert.Equal(t, "guestbook", res.Diffs[2].TargetState.Spec.Source.Path)
+		assert.Equal(t, "updated", res.Diffs[2].TargetState.Annotations["preserved"])
+	})
+
+	t.Run("respects the policy of the ApplicationSet", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, objects...)
+
+		createOnly := appSet.DeepCopy()
+		createOnly.Spec.SyncPolicy = &appsv1.ApplicationSetSyncPolicy{ApplicationsSync: new(appsv1.ApplicationsSyncPolicyCreateOnly)}
+		res, err := appSetServer.Diff(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: createOnly})
+		require.NoError(t, err)
+		require.Len(t, res.Diffs, 1)
+		assert.Equal(t, "create", res.Diffs[0].Action)
+		assert.Equal(t, "created", res.Diffs[0].TargetState.Name)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -41,6 +42,10 @@ import (
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+	// defaultSyncWindowSimulationRange is the time range sync windows are simulated over if no end is given
+	defaultSyncWindowSimulationRange = 7 * 24 * time.Hour
+	// maxSyncWindowSimulationRange is the longest time range sync windows can be simulated over
+	maxSyncWindowSimulationRange = 31 * 24 * time.Hour
 )
 
 // Server provides a Project service
@@ -547,6 +552,77 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// SimulateSyncWindows returns the periods of a time range during which the sync windows of a project allow or deny
+// manual and automated syncs
+func (s *Server) SimulateSyncWindows(ctx context.Context, q *project.SyncWindowsSimulationRequest) (*project.SyncWindowsSimulationResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Now()
+	if q.From != "" {
+		if from, err = time.Parse(time.RFC3339, q.From); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start of time range: %v", err)
+		}
+	}
+	to := from.Add(defaultSyncWindowSimulationRange)
+	if q.To != "" {
+		if to, err = time.Parse(time.RFC3339, q.To); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end of time range: %v", err)
+		}
+	}
+	if to.Sub(from) > maxSyncWindowSimulationRange {
+		return nil, status.Errorf(codes.InvalidArgument, "time range must not be longer than %s", maxSyncWindowSimulationRange)
+	}
+
+	app := &v1alpha1.Application{}
+	if q.Application != "" {
+		appNs := q.AppNamespace
+		if appNs == "" {
+			appNs = s.ns
+		}
+		app, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, q.Application, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, status.Errorf(codes.NotFound, "application '%s' not found", q.Application)
+			}
+			return nil, err
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, app.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+		if app.Spec.GetProject() != proj.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "application '%s' does not belong to project '%s'", q.Application, proj.Name)
+		}
+	}
+	if q.Namespace != "" {
+		app.Spec.Destination.Namespace = q.Namespace
+	}
+	if q.Cluster != "" {
+		// sync windows match clusters by name or by server URL
+		app.Spec.Destination.Name = q.Cluster
+		app.Spec.Destination.Server = q.Cluster
+	}
+
+	windows := proj.Spec.SyncWindows.Matches(app)
+	periods, err := windows.Simulate(from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot simulate sync windows: %v", err)
+	}
+	res := &project.SyncWindowsSimulationResponse{Windows: []*v1alpha1.SyncWindow{}}
+	if windows.HasWindows() {
+		res.Windows = *windows
+	}
+	for i := range periods {
+		res.Periods = append(res.Periods, &periods[i])
+	}
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
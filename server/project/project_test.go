@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -714,6 +715,62 @@ p, role:admin, projects, update, *, allow`)
 		assert.Len(t, res.Windows, 1)
 	})
 
+	t.Run("TestSimulateSyncWindows", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "deny", Schedule: "0 22 * * 5", Duration: "8h", Namespaces: []string{"ns1"}},
+			{Kind: "deny", Schedule: "0 0 * * *", Duration: "1h", Namespaces: []string{"ns2"}},
+		}
+		app := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "test", Destination: v1alpha1.ApplicationDestination{Namespace: "ns1", Server: "https://server1"}},
+		}
+		otherApp := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-app", Namespace: "default"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "other", Destination: v1alpha1.ApplicationDestination{Namespace: "ns1", Server: "https://server1"}},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows, &app, &otherApp), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		res, err := projectServer.SimulateSyncWindows(ctx, &project.SyncWindowsSimulationRequest{
+			Name:        projectWithSyncWindows.Name,
+			Application: app.Name,
+			From:        "2026-06-05T12:00:00Z",
+			To:          "2026-06-06T12:00:00Z",
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Windows, 1)
+		assert.Equal(t, "0 22 * * 5", res.Windows[0].Schedule)
+		require.Len(t, res.Periods, 3)
+		assert.True(t, res.Periods[0].AutoSync)
+		assert.Equal(t, "2026-06-05T22:00:00Z", res.Periods[1].Start.UTC().Format(time.RFC3339))
+		assert.Equal(t, "2026-06-06T06:00:00Z", res.Periods[1].End.UTC().Format(time.RFC3339))
+		assert.False(t, res.Periods[1].AutoSync)
+		assert.False(t, res.Periods[1].ManualSync)
+		assert.True(t, res.Periods[2].AutoSync)
+
+		res, err = projectServer.SimulateSyncWindows(ctx, &project.SyncWindowsSimulationRequest{
+			Name:        projectWithSyncWindows.Name,
+			Application: app.Name,
+			Namespace:   "ns2",
+			From:        "2026-06-05T12:00:00Z",
+			To:          "2026-06-06T12:00:00Z",
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Windows, 1)
+		assert.Equal(t, "0 0 * * *", res.Windows[0].Schedule)
+
+		_, err = projectServer.SimulateSyncWindows(ctx, &project.SyncWindowsSimulationRequest{Name: projectWithSyncWindows.Name, Application: otherApp.Name})
+		require.ErrorContains(t, err, "does not belong to project")
+
+		_, err = projectServer.SimulateSyncWindows(ctx, &project.SyncWindowsSimulationRequest{Name: projectWithSyncWindows.Name, From: "friday"})
+		require.ErrorContains(t, err, "invalid start of time range")
+
+		_, err = projectServer.SimulateSyncWindows(ctx, &project.SyncWindowsSimulationRequest{Name: projectWithSyncWindows.Name, From: "2026-06-01T00:00:00Z", To: "2026-08-01T00:00:00Z"})
+		require.ErrorContains(t, err, "must not be longer than")
+	})
+
 	t.Run("TestGetSyncWindowsStateCannotGetProjectDetails", func(t *testing.T) {
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
 		projectWithSyncWindows := existingProj.DeepCopy()
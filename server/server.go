@@ -264,6 +264,11 @@ type ApplicationSetOpts struct {
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
 	EnableGitHubAPIMetrics   bool
+
+	GlobalPreservedAnnotations []string
+	GlobalPreservedLabels      []string
+	Policy                     v1alpha1.ApplicationsSyncPolicy
+	EnablePolicyOverride       bool
 }
 
 // GracefulRestartSignal implements a signal to be used for a graceful restart trigger.
@@ -1088,6 +1093,10 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.AllowedScmProviders,
 		a.EnableScmProviders,
 		a.EnableGitHubAPIMetrics,
+		a.GlobalPreservedAnnotations,
+		a.GlobalPreservedLabels,
+		a.Policy,
+		a.EnablePolicyOverride,
 		a.EnableK8sEvent,
 		a.clusterInformer,
 	)
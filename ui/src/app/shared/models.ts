@@ -34,7 +34,26 @@ export interface SyncOperation {
     revision: string;
     prune: boolean;
     dryRun: boolean;
+    plan?: boolean;
+    planID?: string;
     resources?: SyncOperationResource[];
+    drift?: ResourceDrift[];
+}
+
+export interface ResourceDrift {
+    group: string;
+    kind: string;
+    namespace: string;
+    name: string;
+    fields: string[];
+    managers?: ResourceDriftManager[];
+}
+
+export interface ResourceDriftManager {
+    manager: string;
+    operation: string;
+    time?: models.Time;
+    fields: string[];
 }
 
 export interface RetryBackoff {
@@ -131,6 +150,31 @@ export interface SyncOperationResult {
     resources: ResourceResult[];
     revision: string;
     revisions: string[];
+    plan?: SyncPlan;
+}
+
+export interface SyncPlan {
+    steps: SyncPlanStep[];
+    id: string;
+}
+
+export interface SyncPlanStep {
+    phase: string;
+    wave: number;
+    resources: SyncPlanResource[];
+}
+
+export interface SyncPlanResource {
+    group: string;
+    version: string;
+    kind: string;
+    namespace: string;
+    name: string;
+    action: string;
+    hookType?: HookType;
+    modified?: boolean;
+    status: ResultCode;
+    message: string;
 }
 
 export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
@@ -153,6 +197,7 @@ export interface ResourceResult {
     hookType: HookType;
     hookPhase: OperationPhase;
     images?: string[];
+    retries?: number;
 }
 
 export type SyncResourceResult = ResourceResult & {
@@ -336,6 +381,12 @@ export interface Automated {
     prune: boolean;
     selfHeal: boolean;
     enabled: boolean;
+    rollbackOnDegraded?: RollbackOnDegraded;
+}
+
+export interface RollbackOnDegraded {
+    window?: string;
+    fallbackToUnverifiedRevision?: boolean;
 }
 
 export interface SyncPolicy {
@@ -359,6 +410,20 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name: string;
+    namespace?: string;
+}
+
+export interface ApplicationDependencyStatus {
+    name: string;
+    namespace?: string;
+    syncStatus?: SyncStatusCode;
+    healthStatus?: HealthStatusCode;
+    message?: string;
 }
 
 export interface ResourceIgnoreDifferences {
@@ -496,6 +561,7 @@ export interface AbstractApplicationTree {
 export interface ApplicationTree extends AbstractApplicationTree {
     orphanedNodes: ResourceNode[];
     hosts: Node[];
+    blockingDependencies?: ApplicationDependencyStatus[];
 }
 
 export interface ApplicationSetTree extends AbstractApplicationTree {
@@ -918,6 +984,23 @@ export interface SyncWindow {
     andOperator: boolean;
     description: string;
     syncOverrun: boolean;
+    calendar?: SyncWindowCalendar;
+    activeEvent?: SyncWindowCalendarEvent;
+}
+
+export interface SyncWindowCalendar {
+    url?: string;
+    configMapKeyRef?: {
+        configMapName: string;
+        key: string;
+    };
+}
+
+export interface SyncWindowCalendarEvent {
+    uid?: string;
+    summary?: string;
+    start: models.Time;
+    end: models.Time;
 }
 
 export interface Project {
@@ -44,6 +44,10 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonRolloutPromoted    = "RolloutPromoted"
+	EventReasonRolloutAborted     = "RolloutAborted"
+	EventReasonAutomatedRollback  = "AutomatedRollback"
+	EventReasonResourceDrift      = "ResourceDrift"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
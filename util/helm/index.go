@@ -6,8 +6,15 @@ import (
 )
 
 type Entry struct {
-	Version string
-	Created time.Time
+	Version     string
+	Created     time.Time
+	AppVersion  string `yaml:"appVersion"`
+	Description string
+	Digest      string
+	Home        string
+	Icon        string
+	Keywords    []string
+	Deprecated  bool
 }
 
 type Entries []Entry
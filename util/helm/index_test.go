@@ -2,9 +2,11 @@ package helm
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 var index = Index{
@@ -37,3 +39,38 @@ func TestIndex_GetEntries(t *testing.T) {
 		assert.Len(t, ts, len(index.Entries["argo-cd"]))
 	})
 }
+
+func TestIndex_Decode(t *testing.T) {
+	t.Parallel()
+	data := `
+apiVersion: v1
+entries:
+  argo-cd:
+  - apiVersion: v2
+    appVersion: v3.1.0
+    created: "2025-07-01T10:00:00Z"
+    deprecated: true
+    description: A Helm chart for Argo CD
+    digest: 0123456789abcdef
+    home: https://github.com/argoproj/argo-helm
+    icon: https://argo-cd.readthedocs.io/en/stable/assets/logo.png
+    keywords:
+    - argoproj
+    - gitops
+    name: argo-cd
+    version: 8.1.0
+`
+	var decoded Index
+	require.NoError(t, yaml.Unmarshal([]byte(data), &decoded))
+	assert.Equal(t, Entries{{
+		Version:     "8.1.0",
+		Created:     time.Date(2025, time.July, 1, 10, 0, 0, 0, time.UTC),
+		AppVersion:  "v3.1.0",
+		Description: "A Helm chart for Argo CD",
+		Digest:      "0123456789abcdef",
+		Home:        "https://github.com/argoproj/argo-helm",
+		Icon:        "https://argo-cd.readthedocs.io/en/stable/assets/logo.png",
+		Keywords:    []string{"argoproj", "gitops"},
+		Deprecated:  true,
+	}}, decoded.Entries["argo-cd"])
+}
@@ -129,9 +129,76 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy
 	}
 
 	repo.PlainHTTP = creds.InsecureHTTPOnly
+	repo.Client, err = newAuthClient(repo.Reference.Registry, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
+	}
+
+	reg, err := remote.NewRegistry(parsed.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup registry config: %w", err)
+	}
+	reg.PlainHTTP = repo.PlainHTTP
+	reg.Client = repo.Client
+	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
+		var t []string
 
+		err := repo.Tags(ctx, last, func(tags []string) error {
+			t = append(t, tags...)
+			return nil
+		})
+
+		return t, err
+	}, reg.Ping, layerMediaTypes, opts...), nil
+}
+
+// ListRepositories lists the repositories of a registry namespace, e.g. oci://registry.example.com/charts, with the
+// catalog API of the registry. The names of the repositories are returned relative to the namespace.
+func ListRepositories(ctx context.Context, repoURL string, creds Creds, proxyURL, noProxy string) ([]string, error) {
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
+	}
+
+	reg, err := remote.NewRegistry(parsed.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup registry config: %w", err)
+	}
+	reg.PlainHTTP = creds.InsecureHTTPOnly
+	reg.Client, err = newAuthClient(parsed.Host, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Trim(parsed.Path, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	var repositories []string
+	err = reg.Repositories(ctx, "", func(repos []string) error {
+		for _, repo := range repos {
+			if name, ok := strings.CutPrefix(repo, prefix); ok && name != "" {
+				repositories = append(repositories, name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+	return repositories, nil
+}
+
+// newAuthClient returns a client which authenticates to the registry with the given credentials
+func newAuthClient(registry string, creds Creds, proxyURL, noProxy string) (*auth.Client, error) {
 	var tlsConf *tls.Config
-	if !repo.PlainHTTP {
+	if !creds.InsecureHTTPOnly {
+		var err error
 		tlsConf, err = newTLSConfig(creds)
 		if err != nil {
 			return nil, fmt.Errorf("failed setup tlsConfig: %w", err)
@@ -151,36 +218,14 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy
 			},
 		*/
 	}
-	repo.Client = &auth.Client{
+	return &auth.Client{
 		Client: client,
 		Cache:  nil,
-		Credential: auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+		Credential: auth.StaticCredential(registry, auth.Credential{
 			Username: creds.Username,
 			Password: creds.Password,
 		}),
-	}
-
-	parsed, err := url.Parse(repoURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
-	}
-
-	reg, err := remote.NewRegistry(parsed.Host)
-	if err != nil {
-		return nil, fmt.Errorf("failed to setup registry config: %w", err)
-	}
-	reg.PlainHTTP = repo.PlainHTTP
-	reg.Client = repo.Client
-	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
-		var t []string
-
-		err := repo.Tags(ctx, last, func(tags []string) error {
-			t = append(t, tags...)
-			return nil
-		})
-
-		return t, err
-	}, reg.Ping, layerMediaTypes, opts...), nil
+	}, nil
 }
 
 func newClientWithLock(repoURL string, repoLock sync.KeyLock, repo oras.ReadOnlyTarget, tagsFunc func(context.Context, string) ([]string, error), pingFunc func(ctx context.Context) error, layerMediaTypes []string, opts ...ClientOpts) Client {
@@ -851,6 +851,30 @@ func TestNewClientUsesHTTP2(t *testing.T) {
 	})
 }
 
+func TestListRepositories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/_catalog", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"repositories":["charts/guestbook","charts/team/helm-guestbook","charts","images/guestbook"]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	t.Run("should list the repositories of a namespace", func(t *testing.T) {
+		repositories, err := ListRepositories(t.Context(), "oci://"+serverURL.Host+"/charts", Creds{InsecureHTTPOnly: true}, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"guestbook", "team/helm-guestbook"}, repositories)
+	})
+
+	t.Run("should list all the repositories of a registry", func(t *testing.T) {
+		repositories, err := ListRepositories(t.Context(), "oci://"+serverURL.Host, Creds{InsecureHTTPOnly: true}, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"charts/guestbook", "charts/team/helm-guestbook", "charts", "images/guestbook"}, repositories)
+	})
+}
+
 func fakeEventHandlers(t *testing.T, repoURL string) EventHandlers {
 	t.Helper()
 	return EventHandlers{
@@ -0,0 +1,293 @@
+package synccalendar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/teambition/rrule-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	icalDateFormat     = "20060102"
+	icalDateTimeFormat = "20060102T150405"
+)
+
+var icalDurationRegex = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// icalTime is a DATE or DATE-TIME value of an iCal property. Times without a time zone are resolved in the location
+// of the sync window, since the same calendar can be referenced by sync windows in different time zones.
+type icalTime struct {
+	value string
+	tzid  string
+}
+
+func newICalTime(prop *ics.IANAProperty) icalTime {
+	t := icalTime{value: prop.Value}
+	if tzid, ok := prop.ICalParameters[string(ics.ParameterTzid)]; ok && len(tzid) > 0 {
+		t.tzid = tzid[0]
+	}
+	return t
+}
+
+// isDate returns true if the value is a DATE, i.e. the event lasts whole days
+func (t icalTime) isDate() bool {
+	return len(t.value) == len(icalDateFormat)
+}
+
+// in returns the time of the value, using the given location if the value has no time zone
+func (t icalTime) in(loc *time.Location) (time.Time, error) {
+	if t.tzid != "" {
+		tzLoc, err := time.LoadLocation(t.tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time zone '%s': %w", t.tzid, err)
+		}
+		loc = tzLoc
+	}
+	switch {
+	case t.isDate():
+		return time.ParseInLocation(icalDateFormat, t.value, loc)
+	case strings.HasSuffix(t.value, "Z"):
+		return time.ParseInLocation(icalDateTimeFormat, strings.TrimSuffix(t.value, "Z"), time.UTC)
+	default:
+		return time.ParseInLocation(icalDateTimeFormat, t.value, loc)
+	}
+}
+
+// icalDuration is a DURATION value of an iCal property. Weeks and days are nominal, so that an event lasting a day
+// ends at the same time of the next day across daylight saving time changes.
+type icalDuration struct {
+	days     int
+	duration time.Duration
+}
+
+func parseICalDuration(value string) (icalDuration, error) {
+	matches := icalDurationRegex.FindStringSubmatch(value)
+	if matches == nil || strings.HasSuffix(value, "P") || strings.HasSuffix(value, "T") {
+		return icalDuration{}, fmt.Errorf("invalid duration '%s'", value)
+	}
+	if matches[1] == "-" {
+		return icalDuration{}, fmt.Errorf("negative duration '%s' is not supported", value)
+	}
+	parts := make([]int, 5)
+	for i, match := range matches[2:] {
+		if match == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match)
+		if err != nil {
+			return icalDuration{}, fmt.Errorf("invalid duration '%s': %w", value, err)
+		}
+		parts[i] = n
+	}
+	return icalDuration{
+		days:     parts[0]*7 + parts[1],
+		duration: time.Duration(parts[2])*time.Hour + time.Duration(parts[3])*time.Minute + time.Duration(parts[4])*time.Second,
+	}, nil
+}
+
+func (d icalDuration) after(t time.Time) time.Time {
+	return t.AddDate(0, 0, d.days).Add(d.duration)
+}
+
+// event is a VEVENT of a calendar
+type event struct {
+	uid     string
+	summary string
+	start   icalTime
+	// end is nil if the event specifies a duration instead of an end
+	end      *icalTime
+	duration icalDuration
+	rrule    string
+	rdates   []icalTime
+	exdates  []icalTime
+	// recurrenceID is set if the event overrides an occurrence of the recurring event with the same uid
+	recurrenceID *icalTime
+}
+
+// Calendar contains the events of an iCal calendar
+type Calendar struct {
+	events []event
+}
+
+// ParseCalendar parses an iCal (RFC 5545) calendar. Cancelled events are ignored.
+func ParseCalendar(r io.Reader) (*Calendar, error) {
+	cal, err := ics.ParseCalendar(r)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing calendar: %w", err)
+	}
+	c := &Calendar{}
+	for _, vevent := range cal.Events() {
+		if status := vevent.GetProperty(ics.ComponentPropertyStatus); status != nil && strings.EqualFold(status.Value, string(ics.ObjectStatusCancelled)) {
+			continue
+		}
+		e, err := parseEvent(vevent)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing event '%s': %w", vevent.Id(), err)
+		}
+		c.events = append(c.events, e)
+	}
+	return c, nil
+}
+
+func parseEvent(vevent *ics.VEvent) (event, error) {
+	e := event{uid: vevent.Id()}
+	if summary := vevent.GetProperty(ics.ComponentPropertySummary); summary != nil {
+		e.summary = ics.FromText(summary.Value)
+	}
+	start := vevent.GetProperty(ics.ComponentPropertyDtStart)
+	if start == nil {
+		return event{}, errors.New("event has no start")
+	}
+	e.start = newICalTime(start)
+	if end := vevent.GetProperty(ics.ComponentPropertyDtEnd); end != nil {
+		endTime := newICalTime(end)
+		e.end = &endTime
+	} else if duration := vevent.GetProperty(ics.ComponentPropertyDuration); duration != nil {
+		d, err := parseICalDuration(duration.Value)
+		if err != nil {
+			return event{}, err
+		}
+		e.duration = d
+	} else if e.start.isDate() {
+		// an all-day event without end lasts one day
+		e.duration = icalDuration{days: 1}
+	}
+	if rule := vevent.GetProperty(ics.ComponentPropertyRrule); rule != nil {
+		e.rrule = rule.Value
+	}
+	for _, prop := range vevent.GetProperties(ics.ComponentPropertyRdate) {
+		e.rdates = append(e.rdates, splitICalTimes(prop)...)
+	}
+	for _, prop := range vevent.GetProperties(ics.ComponentPropertyExdate) {
+		e.exdates = append(e.exdates, splitICalTimes(prop)...)
+	}
+	if recurrenceID := vevent.GetProperty(ics.ComponentPropertyRecurrenceId); recurrenceID != nil {
+		t := newICalTime(recurrenceID)
+		e.recurrenceID = &t
+	}
+	return e, nil
+}
+
+// splitICalTimes returns the times of a property which may contain a comma separated list of values
+func splitICalTimes(prop *ics.IANAProperty) []icalTime {
+	t := newICalTime(prop)
+	var times []icalTime
+	for value := range strings.SplitSeq(prop.Value, ",") {
+		times = append(times, icalTime{value: strings.TrimSpace(value), tzid: t.tzid})
+	}
+	return times
+}
+
+// Events returns the occurrences of the events of the calendar which are in progress at any time between from and
+// to. Times of the calendar which have no time zone are resolved in the given location.
+func (c *Calendar) Events(loc *time.Location, from time.Time, to time.Time) ([]v1alpha1.SyncWindowCalendarEvent, error) {
+	// occurrences of recurring events which are overridden by other events
+	overridden := map[string][]time.Time{}
+	for _, e := range c.events {
+		if e.recurrenceID == nil {
+			continue
+		}
+		t, err := e.recurrenceID.in(loc)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing recurrence id of event '%s': %w", e.uid, err)
+		}
+		overridden[e.uid] = append(overridden[e.uid], t)
+	}
+
+	var events []v1alpha1.SyncWindowCalendarEvent
+	for _, e := range c.events {
+		occurrences, err := e.occurrences(loc, from, to, overridden[e.uid])
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating event '%s': %w", e.uid, err)
+		}
+		events = append(events, occurrences...)
+	}
+	slices.SortFunc(events, func(a, b v1alpha1.SyncWindowCalendarEvent) int {
+		return a.Start.Compare(b.Start.Time)
+	})
+	return events, nil
+}
+
+// occurrences returns the occurrences of the event which are in progress at any time between from and to
+func (e event) occurrences(loc *time.Location, from time.Time, to time.Time, overridden []time.Time) ([]v1alpha1.SyncWindowCalendarEvent, error) {
+	start, err := e.start.in(loc)
+	if err != nil {
+		return nil, err
+	}
+	endOf := e.duration.after
+	if e.end != nil {
+		end, err := e.end.in(loc)
+		if err != nil {
+			return nil, err
+		}
+		if e.start.isDate() && e.end.isDate() {
+			days := int(end.Sub(start).Round(24*time.Hour) / (24 * time.Hour))
+			endOf = icalDuration{days: days}.after
+		} else {
+			length := end.Sub(start)
+			endOf = func(t time.Time) time.Time { return t.Add(length) }
+		}
+	}
+
+	starts := []time.Time{start}
+	if e.recurrenceID == nil && (e.rrule != "" || len(e.rdates) > 0) {
+		set := rrule.Set{}
+		if e.rrule != "" {
+			option, err := rrule.StrToROptionInLocation(e.rrule, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recurrence rule '%s': %w", e.rrule, err)
+			}
+			option.Dtstart = start
+			rule, err := rrule.NewRRule(*option)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recurrence rule '%s': %w", e.rrule, err)
+			}
+			set.RRule(rule)
+		} else {
+			// without a recurrence rule, the start is the first occurrence of the recurrence set
+			set.RDate(start)
+		}
+		for _, rdate := range e.rdates {
+			t, err := rdate.in(loc)
+			if err != nil {
+				return nil, err
+			}
+			set.RDate(t)
+		}
+		for _, exdate := range e.exdates {
+			t, err := exdate.in(loc)
+			if err != nil {
+				return nil, err
+			}
+			set.ExDate(t)
+		}
+		// an occurrence starting before from can still be in progress
+		lookback := endOf(start).Sub(start) + time.Hour
+		starts = set.Between(from.Add(-lookback), to, true)
+	}
+
+	var occurrences []v1alpha1.SyncWindowCalendarEvent
+	for _, s := range starts {
+		end := endOf(s)
+		if s.After(to) || !end.After(from) || slices.ContainsFunc(overridden, s.Equal) && e.recurrenceID == nil {
+			continue
+		}
+		occurrences = append(occurrences, v1alpha1.SyncWindowCalendarEvent{
+			UID:     e.uid,
+			Summary: e.summary,
+			Start:   metav1.NewTime(s),
+			End:     metav1.NewTime(end),
+		})
+	}
+	return occurrences, nil
+}
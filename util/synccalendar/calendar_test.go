@@ -0,0 +1,217 @@
+package synccalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newCalendar(events ...string) string {
+	return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//argo-cd//test//EN\r\n" +
+		strings.Join(events, "") +
+		"END:VCALENDAR\r\n"
+}
+
+func newEvent(lines ...string) string {
+	return "BEGIN:VEVENT\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VEVENT\r\n"
+}
+
+func parseCalendar(t *testing.T, data string) *Calendar {
+	t.Helper()
+	cal, err := ParseCalendar(strings.NewReader(data))
+	require.NoError(t, err)
+	return cal
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func summaries(events []v1alpha1.SyncWindowCalendarEvent) []string {
+	var s []string
+	for _, e := range events {
+		s = append(s, e.Summary)
+	}
+	return s
+}
+
+func TestCalendar_Events(t *testing.T) {
+	t.Run("single event", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(newEvent(
+			"UID:release-freeze",
+			"SUMMARY:Release freeze",
+			"DTSTART:20261020T080000Z",
+			"DTEND:20261020T180000Z",
+		)))
+
+		events, err := cal.Events(time.UTC, time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "release-freeze", events[0].UID)
+		assert.Equal(t, "Release freeze", events[0].Summary)
+		assert.True(t, events[0].Start.Time.Equal(time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC)))
+		assert.True(t, events[0].End.Time.Equal(time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC)))
+
+		events, err = cal.Events(time.UTC, time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC), time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+	t.Run("all-day event uses location of the window", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(newEvent(
+			"UID:christmas",
+			"SUMMARY:Christmas",
+			"DTSTART;VALUE=DATE:20261225",
+		)))
+		berlin := mustLoadLocation(t, "Europe/Berlin")
+
+		events, err := cal.Events(berlin, time.Date(2026, 12, 24, 23, 30, 0, 0, time.UTC), time.Date(2026, 12, 24, 23, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.True(t, events[0].Start.Time.Equal(time.Date(2026, 12, 25, 0, 0, 0, 0, berlin)))
+		assert.True(t, events[0].End.Time.Equal(time.Date(2026, 12, 26, 0, 0, 0, 0, berlin)))
+
+		events, err = cal.Events(time.UTC, time.Date(2026, 12, 24, 23, 30, 0, 0, time.UTC), time.Date(2026, 12, 24, 23, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+	t.Run("time zone of the event", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(newEvent(
+			"UID:maintenance",
+			"SUMMARY:Maintenance",
+			"DTSTART;TZID=America/New_York:20261020T090000",
+			"DURATION:PT2H",
+		)))
+
+		events, err := cal.Events(time.UTC, time.Date(2026, 10, 20, 14, 0, 0, 0, time.UTC), time.Date(2026, 10, 20, 14, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.True(t, events[0].End.Time.Equal(time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC)))
+	})
+	t.Run("recurring event", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(newEvent(
+			"UID:weekend",
+			"SUMMARY:Weekend",
+			"DTSTART;VALUE=DATE:20261017",
+			"DTEND;VALUE=DATE:20261019",
+			"RRULE:FREQ=WEEKLY;BYDAY=SA",
+			"EXDATE;VALUE=DATE:20261024",
+		)))
+
+		// Sunday of the first occurrence
+		events, err := cal.Events(time.UTC, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.True(t, events[0].Start.Time.Equal(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)))
+
+		// excluded occurrence
+		events, err = cal.Events(time.UTC, time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+
+		events, err = cal.Events(time.UTC, time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC), time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+	})
+	t.Run("recurrence dates without rule", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(newEvent(
+			"UID:holidays",
+			"SUMMARY:Holiday",
+			"DTSTART;VALUE=DATE:20261225",
+			"RDATE;VALUE=DATE:20261226,20270101",
+		)))
+
+		for _, day := range []int{25, 26} {
+			events, err := cal.Events(time.UTC, time.Date(2026, 12, day, 12, 0, 0, 0, time.UTC), time.Date(2026, 12, day, 12, 0, 0, 0, time.UTC))
+			require.NoError(t, err)
+			assert.Len(t, events, 1, "December %d", day)
+		}
+		events, err := cal.Events(time.UTC, time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+		events, err = cal.Events(time.UTC, time.Date(2026, 12, 27, 12, 0, 0, 0, time.UTC), time.Date(2026, 12, 27, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+	t.Run("overridden occurrence", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(
+			newEvent(
+				"UID:standup",
+				"SUMMARY:Daily freeze",
+				"DTSTART:20261019T090000Z",
+				"DTEND:20261019T100000Z",
+				"RRULE:FREQ=DAILY;COUNT=5",
+			),
+			newEvent(
+				"UID:standup",
+				"SUMMARY:Moved freeze",
+				"RECURRENCE-ID:20261020T090000Z",
+				"DTSTART:20261020T140000Z",
+				"DTEND:20261020T150000Z",
+			),
+		))
+
+		events, err := cal.Events(time.UTC, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 20, 23, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Moved freeze"}, summaries(events))
+	})
+	t.Run("cancelled events are ignored", func(t *testing.T) {
+		cal := parseCalendar(t, newCalendar(newEvent(
+			"UID:cancelled",
+			"SUMMARY:Cancelled freeze",
+			"STATUS:CANCELLED",
+			"DTSTART:20261020T080000Z",
+			"DTEND:20261020T180000Z",
+		)))
+
+		events, err := cal.Events(time.UTC, time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC), time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func TestParseCalendar_Errors(t *testing.T) {
+	_, err := ParseCalendar(strings.NewReader(newCalendar(newEvent(
+		"UID:no-start",
+		"SUMMARY:No start",
+	))))
+	require.ErrorContains(t, err, "event has no start")
+
+	_, err = ParseCalendar(strings.NewReader(newCalendar(newEvent(
+		"UID:negative",
+		"DTSTART:20261020T080000Z",
+		"DURATION:-PT1H",
+	))))
+	require.ErrorContains(t, err, "negative duration")
+}
+
+func TestParseICalDuration(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected icalDuration
+	}{
+		{value: "PT1H30M", expected: icalDuration{duration: 90 * time.Minute}},
+		{value: "P1D", expected: icalDuration{days: 1}},
+		{value: "P2W", expected: icalDuration{days: 14}},
+		{value: "P1DT12H", expected: icalDuration{days: 1, duration: 12 * time.Hour}},
+		{value: "+PT15S", expected: icalDuration{duration: 15 * time.Second}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			d, err := parseICalDuration(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d)
+		})
+	}
+	for _, value := range []string{"", "P", "PT", "1H", "PT1X"} {
+		_, err := parseICalDuration(value)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,150 @@
+package synccalendar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// maxCalendarSize is the maximum size of a calendar which is loaded
+	maxCalendarSize = 5 * 1024 * 1024
+	// requestTimeout is the timeout of the requests which load a calendar
+	requestTimeout = 30 * time.Second
+)
+
+// cacheExpiration is the duration after which a loaded calendar is refreshed
+var cacheExpiration = env.ParseDurationFromEnv("ARGOCD_SYNC_WINDOW_CALENDAR_CACHE_EXPIRATION", 5*time.Minute, 0, math.MaxInt64)
+
+type cachedCalendar struct {
+	calendar *Calendar
+	loadedAt time.Time
+}
+
+// Loader loads sync window calendars from HTTP(S) URLs and from ConfigMaps in the Argo CD namespace. Loaded calendars
+// are cached. If a calendar cannot be refreshed, the last successfully loaded version is used until it can.
+type Loader struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	httpClient *http.Client
+	expiration time.Duration
+	now        func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]*cachedCalendar
+}
+
+var _ v1alpha1.SyncWindowCalendarLoader = &Loader{}
+
+// NewLoader returns a loader which reads ConfigMaps from the given namespace
+func NewLoader(kubeClient kubernetes.Interface, namespace string) *Loader {
+	return &Loader{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		expiration: cacheExpiration,
+		now:        time.Now,
+		cache:      map[string]*cachedCalendar{},
+	}
+}
+
+// GetEvents returns the occurrences of the calendar events which are in progress at any time between from and to
+func (l *Loader) GetEvents(calendar *v1alpha1.SyncWindowCalendar, loc *time.Location, from time.Time, to time.Time) ([]v1alpha1.SyncWindowCalendarEvent, error) {
+	cal, err := l.getCalendar(calendar)
+	if err != nil {
+		return nil, err
+	}
+	return cal.Events(loc, from, to)
+}
+
+func (l *Loader) getCalendar(calendar *v1alpha1.SyncWindowCalendar) (*Calendar, error) {
+	if err := calendar.Validate(); err != nil {
+		return nil, err
+	}
+	key := calendar.Source()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	cached, ok := l.cache[key]
+	if ok && l.now().Sub(cached.loadedAt) < l.expiration {
+		return cached.calendar, nil
+	}
+	cal, err := l.load(calendar)
+	if err != nil {
+		if ok {
+			log.Warnf("Failed to refresh sync window calendar '%s', using previously loaded calendar: %v", key, err)
+			return cached.calendar, nil
+		}
+		return nil, err
+	}
+	l.cache[key] = &cachedCalendar{calendar: cal, loadedAt: l.now()}
+	return cal, nil
+}
+
+func (l *Loader) load(calendar *v1alpha1.SyncWindowCalendar) (*Calendar, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if calendar.ConfigMapKeyRef != nil {
+		return l.loadFromConfigMap(ctx, calendar.ConfigMapKeyRef)
+	}
+	return l.loadFromURL(ctx, calendar.URL)
+}
+
+func (l *Loader) loadFromConfigMap(ctx context.Context, ref *v1alpha1.ConfigMapKeyRef) (*Calendar, error) {
+	if l.kubeClient == nil {
+		return nil, errors.New("loading calendars from ConfigMaps is not supported")
+	}
+	cm, err := l.kubeClient.CoreV1().ConfigMaps(l.namespace).Get(ctx, ref.ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("ConfigMap '%s' not found in namespace '%s'", ref.ConfigMapName, l.namespace)
+		}
+		return nil, fmt.Errorf("error getting ConfigMap '%s': %w", ref.ConfigMapName, err)
+	}
+	data, ok := cm.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key '%s' not found in ConfigMap '%s'", ref.Key, ref.ConfigMapName)
+	}
+	return ParseCalendar(strings.NewReader(data))
+}
+
+func (l *Loader) loadFromURL(ctx context.Context, url string) (*Calendar, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "text/calendar")
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting calendar: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCalendarSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading calendar: %w", err)
+	}
+	if len(data) > maxCalendarSize {
+		return nil, fmt.Errorf("calendar exceeds the maximum size of %d bytes", maxCalendarSize)
+	}
+	return ParseCalendar(bytes.NewReader(data))
+}
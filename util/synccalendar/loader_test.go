@@ -0,0 +1,97 @@
+package synccalendar
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var testCalendar = newCalendar(newEvent(
+	"UID:release-freeze",
+	"SUMMARY:Release freeze",
+	"DTSTART:20261020T080000Z",
+	"DTEND:20261020T180000Z",
+))
+
+var duringEvent = time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+
+func TestLoader_ConfigMap(t *testing.T) {
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: "argocd"},
+		Data:       map[string]string{"calendar.ics": testCalendar},
+	})
+	loader := NewLoader(kubeClient, "argocd")
+
+	events, err := loader.GetEvents(&v1alpha1.SyncWindowCalendar{
+		ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{ConfigMapName: "holidays", Key: "calendar.ics"},
+	}, time.UTC, duringEvent, duringEvent)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Release freeze"}, summaries(events))
+
+	_, err = loader.GetEvents(&v1alpha1.SyncWindowCalendar{
+		ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{ConfigMapName: "holidays", Key: "missing.ics"},
+	}, time.UTC, duringEvent, duringEvent)
+	require.ErrorContains(t, err, "key 'missing.ics' not found")
+
+	_, err = loader.GetEvents(&v1alpha1.SyncWindowCalendar{
+		ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{ConfigMapName: "missing", Key: "calendar.ics"},
+	}, time.UTC, duringEvent, duringEvent)
+	require.ErrorContains(t, err, "ConfigMap 'missing' not found")
+}
+
+func TestLoader_URL(t *testing.T) {
+	requests := 0
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(testCalendar))
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	loader := NewLoader(nil, "argocd")
+	loader.now = func() time.Time { return now }
+	calendar := &v1alpha1.SyncWindowCalendar{URL: server.URL}
+
+	events, err := loader.GetEvents(calendar, time.UTC, duringEvent, duringEvent)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Release freeze"}, summaries(events))
+
+	t.Run("calendar is cached", func(t *testing.T) {
+		_, err := loader.GetEvents(calendar, time.UTC, duringEvent, duringEvent)
+		require.NoError(t, err)
+		assert.Equal(t, 1, requests)
+	})
+	t.Run("previous calendar is used if refresh fails", func(t *testing.T) {
+		fail = true
+		now = now.Add(loader.expiration)
+
+		events, err := loader.GetEvents(calendar, time.UTC, duringEvent, duringEvent)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Release freeze"}, summaries(events))
+		assert.Equal(t, 2, requests)
+	})
+	t.Run("error if calendar was never loaded", func(t *testing.T) {
+		_, err := NewLoader(nil, "argocd").GetEvents(calendar, time.UTC, duringEvent, duringEvent)
+		require.ErrorContains(t, err, "unexpected response status 503")
+	})
+	t.Run("configmaps are not supported without client", func(t *testing.T) {
+		_, err := loader.GetEvents(&v1alpha1.SyncWindowCalendar{
+			ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{ConfigMapName: "holidays", Key: "calendar.ics"},
+		}, time.UTC, duringEvent, duringEvent)
+		require.ErrorContains(t, err, "not supported")
+	})
+}